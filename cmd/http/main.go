@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	_ "github.com/lib/pq"
@@ -13,11 +14,15 @@ import (
 	countryRepository "go-tonify-backend/internal/domain/country/repository"
 	countryUsecase "go-tonify-backend/internal/domain/country/usecase"
 	"go-tonify-backend/internal/domain/provider/transaction"
+	staffRepository "go-tonify-backend/internal/domain/staff/repository"
+	staffUsecase "go-tonify-backend/internal/domain/staff/usecase"
 	taskRepository "go-tonify-backend/internal/domain/task/repository"
 	taskUsecase "go-tonify-backend/internal/domain/task/usecase"
 	"go-tonify-backend/internal/infrastructure/config"
 	"go-tonify-backend/internal/infrastructure/filestorage/s3"
+	"go-tonify-backend/internal/job"
 	"go-tonify-backend/pkg/logger"
+	"go-tonify-backend/pkg/telegram/bot"
 	"log"
 )
 
@@ -39,15 +44,48 @@ func main() {
 	countryRep := countryRepository.NewCountry()
 	taskRep := taskRepository.NewTask(cont.GetDBConnection())
 	tagRep := accountRepository.NewTag(cont.GetDBConnection())
+	refreshTokenRep := accountRepository.NewRefreshToken(cont.GetDBConnection())
+	sessionRep := accountRepository.NewSession(cont.GetDBConnection())
 	categoryRep := categoryRepository.NewCategory(cont.GetDBConnection())
+	staffRep := staffRepository.NewStaff(cont.GetDBConnection())
+	sanctionRep := accountRepository.NewSanction(cont.GetDBConnection())
+	privacyRep := accountRepository.NewPrivacy(cont.GetDBConnection())
+	exportRep := accountRepository.NewExport(cont.GetDBConnection())
+	portfolioRep := accountRepository.NewPortfolio(cont.GetDBConnection())
+	walletRep := accountRepository.NewWallet(cont.GetDBConnection())
+	auditRep := accountRepository.NewAudit(cont.GetDBConnection())
+	matchRep := accountRepository.NewMatch(cont.GetDBConnection())
+	reportRep := accountRepository.NewReport(cont.GetDBConnection())
+	matchPreferenceRep := accountRepository.NewMatchPreference(cont.GetDBConnection())
 
-	accountUc := accountUsecase.NewAccount(cont, fileStorage, accountRep, attachmentRep, tagRep, categoryRep, transactionProvider)
-	matchUC := accountUsecase.NewMatch(cont, transactionProvider, accountRep, tagRep, categoryRep)
+	accountUc := accountUsecase.NewAccount(cont, fileStorage, accountRep, attachmentRep, tagRep, refreshTokenRep, sessionRep, privacyRep, portfolioRep, categoryRep, transactionProvider, exportRep, reportRep)
+	sessionUc := accountUsecase.NewSession(cont, transactionProvider, sessionRep)
+	matchUC := accountUsecase.NewMatch(cont, transactionProvider, accountRep, tagRep, privacyRep, portfolioRep, categoryRep, matchRep, matchPreferenceRep)
 	countryUc := countryUsecase.NewCountry(cont, countryRep)
 	taskUc := taskUsecase.NewTask(cont, taskRep)
 	categoryUc := categoryUsecase.NewCategory(cont, categoryRep)
+	staffUc := staffUsecase.NewStaff(cont, staffRep, accountRep)
+	sanctionUc := accountUsecase.NewSanction(cont, transactionProvider, sanctionRep, staffRep)
+	privacyUc := accountUsecase.NewPrivacy(cont, privacyRep)
+	verificationUc := accountUsecase.NewVerification(cont, accountRep)
+	portfolioUc := accountUsecase.NewPortfolio(cont, fileStorage, transactionProvider, portfolioRep)
+	walletUc := accountUsecase.NewWallet(cont, transactionProvider, walletRep, accountRep)
+	auditUc := accountUsecase.NewAudit(cont, auditRep)
+	reportUc := accountUsecase.NewReport(cont, fileStorage, transactionProvider, reportRep, accountRep)
+	exportUc := accountUsecase.NewExport(cont, fileStorage, bot.NewClient(cont.GetTelegramBotToken()), exportRep, accountRep, tagRep, categoryRep, taskRep, portfolioRep, matchRep, reportRep, sessionRep, sanctionRep, privacyRep, matchPreferenceRep)
 
-	handler := v1.NewHandler(cont, accountUc, matchUC, countryUc, taskUc, categoryUc)
+	if initialAdminTelegramID := cont.GetStaffConfig().InitialAdminTelegramID; initialAdminTelegramID != nil {
+		if err := staffUc.BootstrapAdmin(context.Background(), *initialAdminTelegramID); err != nil {
+			cont.GetLogger().Warn("fail to bootstrap initial admin, sign up with the telegram account and restart", logger.FError(err))
+		}
+	}
+
+	accountPurgeJob := job.NewAccountPurge(cont, accountUc)
+	go accountPurgeJob.Run(context.Background())
+	accountExportJob := job.NewAccountExport(cont, exportUc)
+	go accountExportJob.Run(context.Background())
+
+	handler := v1.NewHandler(cont, accountUc, sessionUc, matchUC, countryUc, taskUc, categoryUc, staffUc, sanctionUc, privacyUc, exportUc, verificationUc, portfolioUc, walletUc, auditUc, reportUc)
 
 	if err := handler.Run(); err != nil {
 		log.Fatalln("fail to run handler", err)
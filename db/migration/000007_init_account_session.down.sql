@@ -0,0 +1 @@
+DROP TABLE IF EXISTS account_session;
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS account_session (
+    id VARCHAR(64) PRIMARY KEY,
+    account_id INT NOT NULL,
+    device TEXT NOT NULL,
+    platform VARCHAR(64),
+    user_agent TEXT,
+    ip VARCHAR(64),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_active_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP,
+    CONSTRAINT fk_account_id FOREIGN KEY (account_id) REFERENCES account(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS account_session_account_id_idx ON account_session (account_id);
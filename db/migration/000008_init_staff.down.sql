@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS account_staff_role;
+DROP TABLE IF EXISTS staff_role_permission;
+DROP TABLE IF EXISTS staff_role;
\ No newline at end of file
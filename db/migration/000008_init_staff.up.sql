@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS staff_role (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(64) UNIQUE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS staff_role_permission (
+    id SERIAL PRIMARY KEY,
+    role_id INT NOT NULL,
+    permission VARCHAR(64) NOT NULL,
+    CONSTRAINT staff_role_permission_unique_relationship UNIQUE (role_id, permission),
+    CONSTRAINT fk_role_id FOREIGN KEY (role_id) REFERENCES staff_role(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS account_staff_role (
+    id SERIAL PRIMARY KEY,
+    account_id INT NOT NULL,
+    role_id INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT account_staff_role_unique_relationship UNIQUE (account_id, role_id),
+    CONSTRAINT fk_account_id FOREIGN KEY (account_id) REFERENCES account(id) ON DELETE CASCADE,
+    CONSTRAINT fk_role_id FOREIGN KEY (role_id) REFERENCES staff_role(id) ON DELETE CASCADE
+);
+
+INSERT INTO staff_role (name) VALUES ('admin'), ('moderator') ON CONFLICT DO NOTHING;
+
+INSERT INTO staff_role_permission (role_id, permission)
+SELECT staff_role.id, permission.name
+FROM staff_role, (VALUES ('account:ban'), ('category:write'), ('task:moderate'), ('staff:manage')) AS permission(name)
+WHERE staff_role.name = 'admin'
+ON CONFLICT DO NOTHING;
+
+INSERT INTO staff_role_permission (role_id, permission)
+SELECT staff_role.id, permission.name
+FROM staff_role, (VALUES ('account:ban'), ('task:moderate')) AS permission(name)
+WHERE staff_role.name = 'moderator'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS account_sanction;
+ALTER TABLE account DROP COLUMN IF EXISTS status_reason;
+ALTER TABLE account DROP COLUMN IF EXISTS suspended_until;
+ALTER TABLE account DROP COLUMN IF EXISTS status;
\ No newline at end of file
@@ -0,0 +1,17 @@
+ALTER TABLE account ADD COLUMN IF NOT EXISTS status VARCHAR(32) NOT NULL DEFAULT 'active';
+ALTER TABLE account ADD COLUMN IF NOT EXISTS suspended_until TIMESTAMP;
+ALTER TABLE account ADD COLUMN IF NOT EXISTS status_reason TEXT;
+
+CREATE TABLE IF NOT EXISTS account_sanction (
+    id SERIAL PRIMARY KEY,
+    account_id INT NOT NULL,
+    staff_account_id INT,
+    action VARCHAR(32) NOT NULL,
+    reason TEXT NOT NULL,
+    suspended_until TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_account_id FOREIGN KEY (account_id) REFERENCES account(id) ON DELETE CASCADE,
+    CONSTRAINT fk_staff_account_id FOREIGN KEY (staff_account_id) REFERENCES account(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS account_sanction_account_id_idx ON account_sanction (account_id);
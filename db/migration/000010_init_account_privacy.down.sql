@@ -0,0 +1 @@
+DROP TABLE IF EXISTS account_privacy;
\ No newline at end of file
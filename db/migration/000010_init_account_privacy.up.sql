@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS account_privacy (
+    account_id INT PRIMARY KEY,
+    last_name VARCHAR(16) NOT NULL DEFAULT 'everyone',
+    location VARCHAR(16) NOT NULL DEFAULT 'everyone',
+    company VARCHAR(16) NOT NULL DEFAULT 'everyone',
+    document_attachment VARCHAR(16) NOT NULL DEFAULT 'matches',
+    telegram_id VARCHAR(16) NOT NULL DEFAULT 'matches',
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_account_id FOREIGN KEY (account_id) REFERENCES account(id) ON DELETE CASCADE
+);
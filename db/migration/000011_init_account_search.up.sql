@@ -0,0 +1,12 @@
+ALTER TABLE account ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    to_tsvector(
+        'simple',
+        coalesce(first_name, '') || ' ' ||
+        coalesce(middle_name, '') || ' ' ||
+        coalesce(last_name, '') || ' ' ||
+        coalesce(nickname, '') || ' ' ||
+        coalesce(about_me, '')
+    )
+) STORED;
+
+CREATE INDEX IF NOT EXISTS account_search_vector_idx ON account USING GIN (search_vector);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS account_export;
\ No newline at end of file
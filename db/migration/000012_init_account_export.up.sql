@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS account_export (
+    id SERIAL PRIMARY KEY,
+    account_id INT NOT NULL,
+    status VARCHAR(32) NOT NULL DEFAULT 'pending',
+    file_name TEXT,
+    failure_reason TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    expires_at TIMESTAMP,
+    CONSTRAINT fk_account_id FOREIGN KEY (account_id) REFERENCES account(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS account_export_account_id_idx ON account_export (account_id);
+CREATE INDEX IF NOT EXISTS account_export_status_idx ON account_export (status);
@@ -0,0 +1,9 @@
+DELETE FROM staff_role_permission WHERE permission = 'account:verify';
+
+DROP INDEX IF EXISTS account_verification_status_idx;
+
+ALTER TABLE account DROP CONSTRAINT IF EXISTS fk_verification_reviewer_id;
+ALTER TABLE account DROP COLUMN IF EXISTS verification_updated_at;
+ALTER TABLE account DROP COLUMN IF EXISTS verification_reviewer_id;
+ALTER TABLE account DROP COLUMN IF EXISTS verification_reason;
+ALTER TABLE account DROP COLUMN IF EXISTS verification_status;
\ No newline at end of file
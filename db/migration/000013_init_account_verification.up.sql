@@ -0,0 +1,18 @@
+ALTER TABLE account ADD COLUMN IF NOT EXISTS verification_status VARCHAR(32) NOT NULL DEFAULT 'unverified';
+ALTER TABLE account ADD COLUMN IF NOT EXISTS verification_reason TEXT;
+ALTER TABLE account ADD COLUMN IF NOT EXISTS verification_reviewer_id INT;
+ALTER TABLE account ADD COLUMN IF NOT EXISTS verification_updated_at TIMESTAMP;
+ALTER TABLE account ADD CONSTRAINT fk_verification_reviewer_id FOREIGN KEY (verification_reviewer_id) REFERENCES account(id) ON DELETE SET NULL;
+
+UPDATE account SET
+    verification_status = 'pending',
+    verification_updated_at = COALESCE(updated_at, created_at)
+WHERE document_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS account_verification_status_idx ON account (verification_status, verification_updated_at);
+
+INSERT INTO staff_role_permission (role_id, permission)
+SELECT staff_role.id, 'account:verify'
+FROM staff_role
+WHERE staff_role.name IN ('admin', 'moderator')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS account_portfolio_item;
\ No newline at end of file
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS account_portfolio_item (
+    id SERIAL PRIMARY KEY,
+    account_id INT NOT NULL,
+    attachment_id INT NOT NULL,
+    title VARCHAR(128) NOT NULL,
+    description TEXT,
+    external_link TEXT,
+    sort_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    CONSTRAINT fk_account_id FOREIGN KEY (account_id) REFERENCES account(id) ON DELETE CASCADE,
+    CONSTRAINT fk_attachment_id FOREIGN KEY (attachment_id) REFERENCES attachment(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS account_portfolio_item_account_id_sort_order_idx ON account_portfolio_item (account_id, sort_order);
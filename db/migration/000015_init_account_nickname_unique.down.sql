@@ -0,0 +1 @@
+DROP INDEX IF EXISTS account_nickname_unique_idx;
\ No newline at end of file
@@ -0,0 +1,9 @@
+UPDATE account SET nickname = account.nickname || '_' || account.id
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY lower(nickname) ORDER BY created_at, id) AS position
+    FROM account
+    WHERE deleted_at IS NULL
+) AS duplicate
+WHERE account.id = duplicate.id AND duplicate.position > 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS account_nickname_unique_idx ON account (lower(nickname)) WHERE deleted_at IS NULL;
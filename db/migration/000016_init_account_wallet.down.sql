@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS account_wallet_nonce;
+
+DROP INDEX IF EXISTS account_wallet_address_unique_idx;
+
+ALTER TABLE account DROP COLUMN IF EXISTS wallet_linked_at;
+ALTER TABLE account DROP COLUMN IF EXISTS wallet_address;
\ No newline at end of file
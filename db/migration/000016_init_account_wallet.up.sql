@@ -0,0 +1,14 @@
+ALTER TABLE account ADD COLUMN IF NOT EXISTS wallet_address TEXT;
+ALTER TABLE account ADD COLUMN IF NOT EXISTS wallet_linked_at TIMESTAMP;
+
+CREATE UNIQUE INDEX IF NOT EXISTS account_wallet_address_unique_idx ON account (wallet_address) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS account_wallet_nonce (
+    id SERIAL PRIMARY KEY,
+    account_id INT NOT NULL,
+    payload VARCHAR(128) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    CONSTRAINT fk_account_id FOREIGN KEY (account_id) REFERENCES account(id) ON DELETE CASCADE
+);
@@ -0,0 +1,3 @@
+DELETE FROM staff_role_permission WHERE permission = 'account:audit';
+
+DROP TABLE IF EXISTS account_audit;
\ No newline at end of file
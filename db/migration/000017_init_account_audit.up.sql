@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS account_audit (
+    id SERIAL PRIMARY KEY,
+    account_id INT NOT NULL,
+    actor_account_id INT,
+    action VARCHAR(32) NOT NULL,
+    diff JSONB NOT NULL DEFAULT '{}',
+    source_ip VARCHAR(64),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_account_id FOREIGN KEY (account_id) REFERENCES account(id) ON DELETE CASCADE,
+    CONSTRAINT fk_actor_account_id FOREIGN KEY (actor_account_id) REFERENCES account(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS account_audit_account_id_created_at_idx ON account_audit (account_id, created_at);
+
+INSERT INTO staff_role_permission (role_id, permission)
+SELECT staff_role.id, 'account:audit'
+FROM staff_role
+WHERE staff_role.name IN ('admin', 'moderator')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,15 @@
+ALTER TABLE task DROP COLUMN IF EXISTS owner_role;
+
+DELETE FROM dislike_account AS duplicate USING dislike_account AS kept
+WHERE duplicate.disliker_id = kept.disliker_id AND duplicate.disliked_id = kept.disliked_id AND duplicate.id > kept.id;
+ALTER TABLE dislike_account DROP CONSTRAINT IF EXISTS unique_dislike;
+ALTER TABLE dislike_account ADD CONSTRAINT unique_dislike UNIQUE (disliker_id, disliked_id);
+ALTER TABLE dislike_account DROP COLUMN IF EXISTS disliker_role;
+
+DELETE FROM like_account AS duplicate USING like_account AS kept
+WHERE duplicate.liker_id = kept.liker_id AND duplicate.liked_id = kept.liked_id AND duplicate.id > kept.id;
+ALTER TABLE like_account DROP CONSTRAINT IF EXISTS unique_like;
+ALTER TABLE like_account ADD CONSTRAINT unique_like UNIQUE (liker_id, liked_id);
+ALTER TABLE like_account DROP COLUMN IF EXISTS liker_role;
+
+DROP TABLE IF EXISTS account_role;
\ No newline at end of file
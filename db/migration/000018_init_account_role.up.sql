@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS account_role (
+    id SERIAL PRIMARY KEY,
+    account_id INT NOT NULL,
+    role VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT account_role_unique_relationship UNIQUE (account_id, role),
+    CONSTRAINT fk_account_id FOREIGN KEY (account_id) REFERENCES account(id) ON DELETE CASCADE
+);
+
+INSERT INTO account_role (account_id, role, created_at)
+SELECT account.id, account.role, account.created_at
+FROM account
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE like_account ADD COLUMN IF NOT EXISTS liker_role VARCHAR(32);
+UPDATE like_account SET liker_role = account.role FROM account WHERE account.id = like_account.liker_id;
+ALTER TABLE like_account ALTER COLUMN liker_role SET NOT NULL;
+ALTER TABLE like_account DROP CONSTRAINT IF EXISTS unique_like;
+ALTER TABLE like_account ADD CONSTRAINT unique_like UNIQUE (liker_id, liked_id, liker_role);
+
+ALTER TABLE dislike_account ADD COLUMN IF NOT EXISTS disliker_role VARCHAR(32);
+UPDATE dislike_account SET disliker_role = account.role FROM account WHERE account.id = dislike_account.disliker_id;
+ALTER TABLE dislike_account ALTER COLUMN disliker_role SET NOT NULL;
+ALTER TABLE dislike_account DROP CONSTRAINT IF EXISTS unique_dislike;
+ALTER TABLE dislike_account ADD CONSTRAINT unique_dislike UNIQUE (disliker_id, disliked_id, disliker_role);
+
+ALTER TABLE task ADD COLUMN IF NOT EXISTS owner_role VARCHAR(32) NOT NULL DEFAULT 'client';
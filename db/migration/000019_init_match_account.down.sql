@@ -0,0 +1 @@
+DROP TABLE IF EXISTS match_account;
\ No newline at end of file
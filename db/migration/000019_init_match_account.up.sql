@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS match_account (
+    id SERIAL PRIMARY KEY,
+    client_id INT NOT NULL,
+    freelancer_id INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    unmatched_at TIMESTAMP,
+    unmatched_by INT,
+    CONSTRAINT unique_match UNIQUE (client_id, freelancer_id),
+    CONSTRAINT fk_client_id FOREIGN KEY (client_id) REFERENCES account(id) ON DELETE CASCADE,
+    CONSTRAINT fk_freelancer_id FOREIGN KEY (freelancer_id) REFERENCES account(id) ON DELETE CASCADE,
+    CONSTRAINT fk_unmatched_by FOREIGN KEY (unmatched_by) REFERENCES account(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS match_account_freelancer_id_idx ON match_account (freelancer_id);
+
+INSERT INTO match_account (client_id, freelancer_id, created_at)
+SELECT forward.liker_id, forward.liked_id, GREATEST(forward.created_at, backward.created_at)
+FROM like_account AS forward
+JOIN like_account AS backward
+    ON backward.liker_id = forward.liked_id
+    AND backward.liked_id = forward.liker_id
+    AND backward.liker_role = 'freelancer'
+WHERE forward.liker_role = 'client'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS match_rewind;
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS match_rewind (
+    id SERIAL PRIMARY KEY,
+    account_id INT NOT NULL,
+    target_id INT NOT NULL,
+    action VARCHAR(16) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_account_id FOREIGN KEY (account_id) REFERENCES account(id) ON DELETE CASCADE,
+    CONSTRAINT fk_target_id FOREIGN KEY (target_id) REFERENCES account(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS match_rewind_account_id_created_at_idx ON match_rewind (account_id, created_at);
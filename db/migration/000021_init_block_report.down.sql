@@ -0,0 +1,5 @@
+DELETE FROM staff_role_permission WHERE permission = 'report:review';
+
+DROP TABLE IF EXISTS report;
+
+DROP TABLE IF EXISTS block_account;
\ No newline at end of file
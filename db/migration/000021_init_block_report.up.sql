@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS block_account (
+    id SERIAL PRIMARY KEY,
+    blocker_id INT NOT NULL,
+    blocked_id INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_block UNIQUE (blocker_id, blocked_id),
+    CONSTRAINT fk_blocker_id FOREIGN KEY (blocker_id) REFERENCES account(id) ON DELETE CASCADE,
+    CONSTRAINT fk_blocked_id FOREIGN KEY (blocked_id) REFERENCES account(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS block_account_blocked_id_idx ON block_account (blocked_id);
+
+CREATE TABLE IF NOT EXISTS report (
+    id SERIAL PRIMARY KEY,
+    reporter_id INT,
+    reported_id INT NOT NULL,
+    reason VARCHAR(32) NOT NULL,
+    description TEXT,
+    attachment_id INT,
+    status VARCHAR(32) NOT NULL DEFAULT 'pending',
+    reviewer_id INT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    reviewed_at TIMESTAMP,
+    CONSTRAINT fk_reporter_id FOREIGN KEY (reporter_id) REFERENCES account(id) ON DELETE SET NULL,
+    CONSTRAINT fk_reported_id FOREIGN KEY (reported_id) REFERENCES account(id) ON DELETE CASCADE,
+    CONSTRAINT fk_attachment_id FOREIGN KEY (attachment_id) REFERENCES attachment(id) ON DELETE SET NULL,
+    CONSTRAINT fk_reviewer_id FOREIGN KEY (reviewer_id) REFERENCES account(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS report_status_created_at_idx ON report (status, created_at);
+
+INSERT INTO staff_role_permission (role_id, permission)
+SELECT staff_role.id, 'report:review'
+FROM staff_role
+WHERE staff_role.name IN ('admin', 'moderator')
+ON CONFLICT DO NOTHING;
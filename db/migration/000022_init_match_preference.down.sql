@@ -0,0 +1 @@
+DROP TABLE IF EXISTS match_preference;
\ No newline at end of file
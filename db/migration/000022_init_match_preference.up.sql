@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS match_preference (
+    account_id INT PRIMARY KEY,
+    countries TEXT[] NOT NULL DEFAULT '{}',
+    category_ids INT[] NOT NULL DEFAULT '{}',
+    required_tags TEXT[] NOT NULL DEFAULT '{}',
+    verified_only BOOLEAN NOT NULL DEFAULT FALSE,
+    has_company BOOLEAN,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_account_id FOREIGN KEY (account_id) REFERENCES account(id) ON DELETE CASCADE
+);
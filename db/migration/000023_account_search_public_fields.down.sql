@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS account_search_vector_idx;
+ALTER TABLE account DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE account ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    to_tsvector(
+        'simple',
+        coalesce(first_name, '') || ' ' ||
+        coalesce(middle_name, '') || ' ' ||
+        coalesce(last_name, '') || ' ' ||
+        coalesce(nickname, '') || ' ' ||
+        coalesce(about_me, '')
+    )
+) STORED;
+
+CREATE INDEX IF NOT EXISTS account_search_vector_idx ON account USING GIN (search_vector);
\ No newline at end of file
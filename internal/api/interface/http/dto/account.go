@@ -5,22 +5,28 @@ import (
 )
 
 type Account struct {
-	ID                 int64              `json:"id" example:"1"`
-	TelegramID         int64              `json:"telegram_id" example:"5443222678"`
-	FirstName          string             `json:"first_name" example:"Pavel"`
-	MiddleName         *string            `json:"middle_name" example:"Michailovich"`
-	LastName           string             `json:"last_name" example:"Melnyk"`
-	Role               string             `json:"role" example:"client"`
-	Nickname           *string            `json:"nickname" example:"@melnyk"`
-	AboutMe            *string            `json:"about_me" example:"like when everything good done."`
-	Gender             string             `json:"gender" example:"male"`
-	Country            *string            `json:"country" example:"Ukraine"`
-	Location           *string            `json:"location" example:"Kyiv"`
-	Tags               *[]Tag             `json:"tags"`
-	Categories         *[]Category        `json:"categories"`
-	Company            *Company           `json:"company"`
-	AvatarAttachment   *Attachment        `json:"avatar_attachment"`
-	DocumentAttachment *Attachment        `json:"document_attachment"`
-	CreatedAt          *datetime.Datetime `json:"created_at" example:"2024-12-07T19:51:48.130157Z"`
-	UpdatedAt          *datetime.Datetime `json:"updated_at" example:"2024-12-07T19:51:48.130157Z"`
+	ID                          int64              `json:"id" example:"1"`
+	TelegramID                  *int64             `json:"telegram_id" example:"5443222678"`
+	FirstName                   string             `json:"first_name" example:"Pavel"`
+	MiddleName                  *string            `json:"middle_name" example:"Michailovich"`
+	LastName                    *string            `json:"last_name" example:"Melnyk"`
+	Role                        string             `json:"role" example:"client"`
+	Roles                       *[]Role            `json:"roles" example:"client,freelancer"`
+	Nickname                    *string            `json:"nickname" example:"@melnyk"`
+	AboutMe                     *string            `json:"about_me" example:"like when everything good done."`
+	Gender                      string             `json:"gender" example:"male"`
+	Country                     *string            `json:"country" example:"Ukraine"`
+	Location                    *string            `json:"location" example:"Kyiv"`
+	Tags                        *[]Tag             `json:"tags"`
+	Categories                  *[]Category        `json:"categories"`
+	Company                     *Company           `json:"company"`
+	AvatarAttachment            *Attachment        `json:"avatar_attachment"`
+	DocumentAttachment          *Attachment        `json:"document_attachment"`
+	Portfolio                   *[]PortfolioItem   `json:"portfolio"`
+	Verified                    bool               `json:"verified" example:"true"`
+	VerificationStatus          *string            `json:"verification_status" example:"rejected" enums:"unverified,pending,verified,rejected"`
+	VerificationRejectionReason *string            `json:"verification_rejection_reason" example:"the document photo is blurry"`
+	WalletAddress               *string            `json:"wallet_address" example:"0:83dfd552e63729b472fcbcc8c45ebcc6691702558b68ec7527e1ba403a0f31a8"`
+	CreatedAt                   *datetime.Datetime `json:"created_at" example:"2024-12-07T19:51:48.130157Z"`
+	UpdatedAt                   *datetime.Datetime `json:"updated_at" example:"2024-12-07T19:51:48.130157Z"`
 }
@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"encoding/json"
+	"go-tonify-backend/pkg/datetime"
+)
+
+type AccountAudit struct {
+	ID             int64              `json:"id" example:"1"`
+	AccountID      int64              `json:"account_id" example:"1"`
+	ActorAccountID *int64             `json:"actor_account_id" example:"1"`
+	Action         string             `json:"action" example:"edit" enums:"create,edit,role_change,delete"`
+	Diff           json.RawMessage    `json:"diff" swaggertype:"object"`
+	SourceIP       *string            `json:"source_ip" example:"203.0.113.7"`
+	CreatedAt      *datetime.Datetime `json:"created_at" example:"2024-12-07T19:51:48Z"`
+}
+
+type GetAccountAudit struct {
+	Offset int64 `form:"offset" example:"0"`
+	Limit  int64 `form:"limit" example:"20" binding:"required"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+import "go-tonify-backend/pkg/datetime"
+
+type AccountExport struct {
+	ID          int64              `json:"id" example:"1"`
+	Status      string             `json:"status" example:"ready" enums:"pending,processing,ready,failed,expired"`
+	CreatedAt   *datetime.Datetime `json:"created_at" example:"2024-12-07T19:51:48Z"`
+	CompletedAt *datetime.Datetime `json:"completed_at" example:"2024-12-07T19:52:48Z"`
+	ExpiresAt   *datetime.Datetime `json:"expires_at" example:"2024-12-14T19:52:48Z"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+type AddRole struct {
+	Role Role `json:"role" binding:"required,enum_validate" example:"freelancer"`
+}
+
+type URIRole struct {
+	Role Role `uri:"role" binding:"required,enum_validate" example:"freelancer"`
+}
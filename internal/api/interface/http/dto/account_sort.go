@@ -0,0 +1,18 @@
+package dto
+
+type AccountSort string
+
+const (
+	RelevanceAccountSort AccountSort = "relevance"
+	NewestAccountSort    AccountSort = "newest"
+	OldestAccountSort    AccountSort = "oldest"
+)
+
+func (s AccountSort) Valid() bool {
+	switch s {
+	case RelevanceAccountSort, NewestAccountSort, OldestAccountSort:
+		return true
+	default:
+		return false
+	}
+}
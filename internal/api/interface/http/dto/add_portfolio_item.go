@@ -0,0 +1,7 @@
+package dto
+
+type AddPortfolioItem struct {
+	Title        string  `form:"title" binding:"required,max=128"`
+	Description  *string `form:"description"`
+	ExternalLink *string `form:"external_link" binding:"omitempty,url"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type ChangeStaffRole struct {
+	AccountID int64  `json:"account_id" binding:"required" example:"1"`
+	Role      string `json:"role" binding:"required" example:"moderator"`
+}
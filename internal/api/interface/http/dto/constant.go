@@ -3,4 +3,13 @@ package dto
 const (
 	AuthorizationHeaderKey string = "Authorization"
 	AccountIDKey           string = "AccountIDKey"
+	SessionIDKey           string = "SessionIDKey"
+	ActiveRoleKey          string = "ActiveRoleKey"
+	ActiveRoleHeaderKey    string = "X-Active-Role"
+	TelegramPlatformKey    string = "X-Telegram-Platform"
+	UserAgentHeaderKey     string = "User-Agent"
+	RetryAfterHeaderKey    string = "Retry-After"
+	RateLimitHeaderKey     string = "X-RateLimit-Limit"
+	RateRemainingHeaderKey string = "X-RateLimit-Remaining"
+	RateResetHeaderKey     string = "X-RateLimit-Reset"
 )
@@ -0,0 +1,7 @@
+package dto
+
+type CreateReport struct {
+	AccountID   int64        `form:"account_id" binding:"required"`
+	Reason      ReportReason `form:"reason" binding:"required,enum_validate"`
+	Description *string      `form:"description" binding:"omitempty,max=2000"`
+}
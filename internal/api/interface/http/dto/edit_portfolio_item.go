@@ -0,0 +1,7 @@
+package dto
+
+type EditPortfolioItem struct {
+	Title        string  `json:"title" binding:"required,max=128" example:"Landing page for a coffee shop"`
+	Description  *string `json:"description" example:"Design and frontend of the landing page"`
+	ExternalLink *string `json:"external_link" binding:"omitempty,url" example:"https://dribbble.com/shots/1"`
+}
@@ -9,10 +9,52 @@ var (
 	FailProcessRequestError             = errors.New("the request could not be processed due to an error")
 	MissingAuthorizationTokenError      = errors.New("authorization token is missing from the request")
 	RoleExpectedError                   = errors.New("role expected error")
+	StaffExpectedError                  = errors.New("the account is not a staff member")
+	PermissionExpectedError             = errors.New("the account lacks the required permission")
+	UnknownStaffRoleError               = errors.New("unknown staff role")
 	MissingAccountIDError               = errors.New("missing account id")
 	ModelNotFoundError                  = errors.New("model not found")
 	CastTypeError                       = errors.New("failed to cast the value to the specified type")
 	ParseValidateTokenError             = errors.New("failed to parse / validate token")
 	DuplicateAccountWithTelegramIDError = errors.New("an account with the specified telegram id already exists")
 	CreateTaskLimitError                = errors.New("exceeded the maximum task limit")
+	InvalidRefreshTokenError            = errors.New("refresh token is invalid, expired or already used")
+	InvalidTelegramInitDataError        = errors.New("telegram initialization data is malformed or has an invalid signature")
+	ExpiredTelegramInitDataError        = errors.New("telegram initialization data has expired, reopen the mini app")
+	InvalidTelegramLoginWidgetError     = errors.New("telegram login widget payload has an invalid hash")
+	ExpiredTelegramLoginWidgetError     = errors.New("telegram login widget payload has expired, log in again")
+	RestorableAccountError              = errors.New("the account has been deleted, it can be restored with /v1/auth/restore")
+	RestorePeriodExpiredError           = errors.New("the account restore period has expired")
+	RestoreNicknameTakenError           = errors.New("the nickname of the account has been taken by another account, retry restoring")
+	RestoreWalletTakenError             = errors.New("the wallet of the account has been linked to another account, retry restoring")
+	SessionRevokedError                 = errors.New("session has been revoked")
+	MissingSessionIDError               = errors.New("missing session id")
+	MissingActiveRoleError              = errors.New("missing active role")
+	AccountSuspendedError               = errors.New("the account is suspended")
+	AccountBannedError                  = errors.New("the account is banned")
+	InvalidAccountPatchError            = errors.New("the patch is invalid: required fields can't be null or empty, gender, role and nickname must be valid, a new company needs a name")
+	TooManyRequestsError                = errors.New("too many requests, retry later")
+	InvalidSanctionError                = errors.New("the sanction is invalid: a reason is required, a suspension must end in the future and a lift needs an active sanction")
+	SanctionStaffRankError              = errors.New("staff can't sanction an account of equal or higher staff rank")
+	InvalidSearchFilterError            = errors.New("the search filter is invalid: role, gender and sort must be valid")
+	ExportNotReadyError                 = errors.New("the export is not ready yet or has failed, check its status")
+	ExportExpiredError                  = errors.New("the export has expired, request a new one")
+	VerificationNotPendingError         = errors.New("the account has no verification waiting for a review")
+	InvalidVerificationReviewError      = errors.New("the review is invalid: a rejection needs a reason and staff can't review their own account")
+	PortfolioLimitError                 = errors.New("exceeded the maximum portfolio items limit, remove an item first")
+	InvalidPortfolioOrderError          = errors.New("the order is invalid: it must list every portfolio item of the account exactly once")
+	NicknameUnavailableError            = errors.New("the nickname is already taken or reserved, choose another one")
+	InvalidWalletProofError             = errors.New("the wallet proof is invalid or expired, request a new nonce and sign it again")
+	WalletAlreadyLinkedError            = errors.New("the wallet is already linked to another account")
+	ActiveRoleNotHeldError              = errors.New("the account doesn't hold the active role, add the role first or pick another one in X-Active-Role")
+	InvalidActiveRoleError              = errors.New("the active role is invalid, X-Active-Role must be client or freelancer")
+	LastRoleError                       = errors.New("the last role of the account can't be removed")
+	RoleNotHeldError                    = errors.New("the account doesn't hold the role")
+	UnmatchedPairError                  = errors.New("the accounts have unmatched and can't like each other again")
+	NothingToRewindError                = errors.New("there is no like or dislike to rewind in the active role")
+	RewindLimitError                    = errors.New("exceeded the daily rewind limit, retry tomorrow")
+	RewindEndedMatchError               = errors.New("the dislike ended a match with the account and can't be rewound")
+	InvalidBlockError                   = errors.New("an account can't block itself")
+	InvalidReportError                  = errors.New("the report is invalid: an account can't report itself and the other reason needs a description")
+	ReportNotPendingError               = errors.New("the report has already been reviewed")
 )
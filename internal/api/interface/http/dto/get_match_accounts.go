@@ -1,5 +1,7 @@
 package dto
 
 type GetMatchAccounts struct {
-	Limit int64 `form:"limit" example:"5" binding:"required"`
+	Offset       int64 `form:"offset" example:"0" binding:"min=0"`
+	Limit        int64 `form:"limit" example:"5" binding:"required"`
+	VerifiedOnly bool  `form:"verified_only" example:"true"`
 }
@@ -0,0 +1,6 @@
+package dto
+
+type GetMatches struct {
+	Offset int64 `form:"offset" example:"0"`
+	Limit  int64 `form:"limit" example:"10" binding:"required"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type GetReports struct {
+	Offset int64 `form:"offset" example:"0"`
+	Limit  int64 `form:"limit" example:"20" binding:"required"`
+}
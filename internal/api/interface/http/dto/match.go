@@ -0,0 +1,9 @@
+package dto
+
+import "go-tonify-backend/pkg/datetime"
+
+type Match struct {
+	ID        int64              `json:"id" example:"1"`
+	Account   *Account           `json:"account"`
+	CreatedAt *datetime.Datetime `json:"created_at" example:"2024-12-07T19:51:48.130157Z"`
+}
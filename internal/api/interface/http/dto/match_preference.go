@@ -0,0 +1,9 @@
+package dto
+
+type MatchPreference struct {
+	Countries    []string `json:"countries" binding:"max=50" example:"UA,PL"`
+	CategoryIDs  []int64  `json:"category_ids" binding:"max=50" example:"1,2"`
+	RequiredTags []string `json:"required_tags" binding:"max=20" example:"golang"`
+	VerifiedOnly bool     `json:"verified_only" example:"false"`
+	HasCompany   *bool    `json:"has_company" example:"true"`
+}
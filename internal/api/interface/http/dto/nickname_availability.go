@@ -0,0 +1,10 @@
+package dto
+
+type GetNicknameAvailability struct {
+	Nickname string `form:"nickname" binding:"required,nickname"`
+}
+
+type NicknameAvailability struct {
+	Nickname  string `json:"nickname" example:"@melnyk"`
+	Available bool   `json:"available" example:"true"`
+}
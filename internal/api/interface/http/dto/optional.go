@@ -0,0 +1,23 @@
+package dto
+
+import "encoding/json"
+
+// Optional tells an absent json field apart from an explicit null, as RFC 7396 merge patch requires.
+type Optional[T any] struct {
+	Set   bool
+	Value *T
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Set = true
+	if string(data) == "null" {
+		o.Value = nil
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	o.Value = &value
+	return nil
+}
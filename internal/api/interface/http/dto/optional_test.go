@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOptionalUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		payload     string
+		expectSet   bool
+		expectValue *string
+	}{
+		{name: "absent field", payload: `{}`},
+		{name: "null field", payload: `{"about_me":null}`, expectSet: true},
+		{name: "value field", payload: `{"about_me":"hi"}`, expectSet: true, expectValue: func() *string { v := "hi"; return &v }()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patchAccount PatchAccount
+			if err := json.Unmarshal([]byte(tt.payload), &patchAccount); err != nil {
+				t.Fatal("fail to unmarshal patch", err)
+			}
+			aboutMe := patchAccount.AboutMe
+			if aboutMe.Set != tt.expectSet {
+				t.Error("unexpected set flag", aboutMe.Set)
+			}
+			if (aboutMe.Value == nil) != (tt.expectValue == nil) ||
+				(aboutMe.Value != nil && *aboutMe.Value != *tt.expectValue) {
+				t.Error("unexpected value", aboutMe.Value)
+			}
+			if patchAccount.FirstName.Set {
+				t.Error("untouched field must stay unset")
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package dto
+
+type PatchAccount struct {
+	FirstName   Optional[string]       `json:"first_name" swaggertype:"string" example:"Pavel"`
+	MiddleName  Optional[string]       `json:"middle_name" swaggertype:"string" example:"Michailovich"`
+	LastName    Optional[string]       `json:"last_name" swaggertype:"string" example:"Melnyk"`
+	Role        Optional[string]       `json:"role" swaggertype:"string" enums:"client,freelancer" example:"client"`
+	Nickname    Optional[string]       `json:"nickname" swaggertype:"string" example:"@melnyk"`
+	AboutMe     Optional[string]       `json:"about_me" swaggertype:"string" example:"like when everything good done."`
+	Gender      Optional[string]       `json:"gender" swaggertype:"string" enums:"male,female,other" example:"male"`
+	Country     Optional[string]       `json:"country" swaggertype:"string" example:"Ukraine"`
+	Location    Optional[string]       `json:"location" swaggertype:"string" example:"Kyiv"`
+	Company     Optional[PatchCompany] `json:"company" swaggertype:"object"`
+	Tags        Optional[[]string]     `json:"tags" swaggertype:"array,string" example:"golang,backend"`
+	CategoryIDs Optional[[]int64]      `json:"category_ids" swaggertype:"array,integer" example:"1,2"`
+}
+
+type PatchCompany struct {
+	Name        Optional[string] `json:"name" swaggertype:"string" example:"Tonify"`
+	Description Optional[string] `json:"description" swaggertype:"string" example:"freelance marketplace"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+type Permission string
+
+var (
+	AccountBanPermission    Permission = "account:ban"
+	AccountVerifyPermission Permission = "account:verify"
+	AccountAuditPermission  Permission = "account:audit"
+	CategoryWritePermission Permission = "category:write"
+	TaskModeratePermission  Permission = "task:moderate"
+	StaffManagePermission   Permission = "staff:manage"
+	ReportReviewPermission  Permission = "report:review"
+)
@@ -0,0 +1,14 @@
+package dto
+
+import "go-tonify-backend/pkg/datetime"
+
+type PortfolioItem struct {
+	ID           int64              `json:"id" example:"1"`
+	Attachment   *Attachment        `json:"attachment"`
+	Title        string             `json:"title" example:"Landing page for a coffee shop"`
+	Description  *string            `json:"description" example:"Design and frontend of the landing page"`
+	ExternalLink *string            `json:"external_link" example:"https://dribbble.com/shots/1"`
+	SortOrder    int64              `json:"sort_order" example:"0"`
+	CreatedAt    *datetime.Datetime `json:"created_at" example:"2024-12-07T19:51:48.130157Z"`
+	UpdatedAt    *datetime.Datetime `json:"updated_at" example:"2024-12-07T19:51:48.130157Z"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+type Privacy struct {
+	LastName           Visibility `json:"last_name" binding:"required,enum_validate" example:"everyone" enums:"everyone,matches,nobody"`
+	Location           Visibility `json:"location" binding:"required,enum_validate" example:"everyone" enums:"everyone,matches,nobody"`
+	Company            Visibility `json:"company" binding:"required,enum_validate" example:"everyone" enums:"everyone,matches,nobody"`
+	DocumentAttachment Visibility `json:"document_attachment" binding:"required,enum_validate" example:"matches" enums:"everyone,matches,nobody"`
+	TelegramID         Visibility `json:"telegram_id" binding:"required,enum_validate" example:"matches" enums:"everyone,matches,nobody"`
+}
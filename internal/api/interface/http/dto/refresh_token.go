@@ -0,0 +1,5 @@
+package dto
+
+type RefreshToken struct {
+	RefreshToken string `json:"refresh_token" binding:"required" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJJRCI6NX0.RyRk7Jsx8-2zRbm7HAeAAExLPrLAdtgPmQgViF61Y7Y"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type ReorderPortfolio struct {
+	ItemIDs []int64 `json:"item_ids" binding:"required,unique" example:"3,1,2"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+import "go-tonify-backend/pkg/datetime"
+
+type Report struct {
+	ID          int64              `json:"id" example:"1"`
+	ReporterID  *int64             `json:"reporter_id" example:"1"`
+	ReportedID  int64              `json:"reported_id" example:"2"`
+	Reason      ReportReason       `json:"reason" example:"harassment"`
+	Description *string            `json:"description" example:"sends insulting messages after I declined the offer"`
+	Attachment  *Attachment        `json:"attachment"`
+	Status      string             `json:"status" example:"pending" enums:"pending,resolved,dismissed"`
+	ReviewerID  *int64             `json:"reviewer_id" example:"3"`
+	CreatedAt   *datetime.Datetime `json:"created_at" example:"2024-12-07T19:51:48Z"`
+	ReviewedAt  *datetime.Datetime `json:"reviewed_at" example:"2024-12-08T10:02:11Z"`
+}
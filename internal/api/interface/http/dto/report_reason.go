@@ -0,0 +1,26 @@
+package dto
+
+type ReportReason string
+
+const (
+	SpamReportReason                 ReportReason = "spam"
+	HarassmentReportReason           ReportReason = "harassment"
+	FakeProfileReportReason          ReportReason = "fake_profile"
+	ScamReportReason                 ReportReason = "scam"
+	InappropriateContentReportReason ReportReason = "inappropriate_content"
+	OtherReportReason                ReportReason = "other"
+)
+
+func (r ReportReason) Valid() bool {
+	switch r {
+	case SpamReportReason,
+		HarassmentReportReason,
+		FakeProfileReportReason,
+		ScamReportReason,
+		InappropriateContentReportReason,
+		OtherReportReason:
+		return true
+	default:
+		return false
+	}
+}
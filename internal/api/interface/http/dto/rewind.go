@@ -0,0 +1,6 @@
+package dto
+
+type Rewind struct {
+	Action  MatchAction `json:"action" example:"like"`
+	Account *Account    `json:"account"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"go-tonify-backend/pkg/datetime"
+)
+
+type Sanction struct {
+	ID             int64              `json:"id" example:"1"`
+	AccountID      int64              `json:"account_id" example:"1"`
+	StaffAccountID *int64             `json:"staff_account_id" example:"2"`
+	Action         string             `json:"action" example:"suspend" enums:"suspend,ban,lift"`
+	Reason         string             `json:"reason" example:"spam in task descriptions"`
+	SuspendedUntil *datetime.Datetime `json:"suspended_until" example:"2024-12-14T19:51:48Z"`
+	CreatedAt      *datetime.Datetime `json:"created_at" example:"2024-12-07T19:51:48Z"`
+}
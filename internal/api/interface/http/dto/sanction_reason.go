@@ -0,0 +1,5 @@
+package dto
+
+type SanctionReason struct {
+	Reason string `json:"reason" binding:"required" example:"repeated harassment reports"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+type SearchAccounts struct {
+	Query       string      `form:"q" example:"golang developer"`
+	Role        Role        `form:"role" binding:"omitempty,enum_validate" example:"freelancer"`
+	Tags        []string    `form:"tags" example:"golang"`
+	CategoryIDs []int64     `form:"category_ids" example:"1"`
+	Country     string      `form:"country" example:"UA"`
+	Gender      Gender      `form:"gender" binding:"omitempty,enum_validate" example:"male"`
+	HasCompany  *bool       `form:"has_company" example:"true"`
+	Sort        AccountSort `form:"sort" binding:"omitempty,enum_validate" example:"relevance"`
+	Offset      int64       `form:"offset" example:"0"`
+	Limit       int64       `form:"limit" example:"10" binding:"required"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"go-tonify-backend/pkg/datetime"
+)
+
+type Session struct {
+	ID           string             `json:"id" example:"3f1c2a4e-6b1d-4c8e-9f0a-2d5b7e8c1a90"`
+	Device       string             `json:"device" example:"iPhone, Telegram iOS"`
+	Platform     *string            `json:"platform" example:"ios"`
+	UserAgent    *string            `json:"user_agent" example:"Mozilla/5.0 (iPhone; CPU iPhone OS 17_5 like Mac OS X)"`
+	IP           *string            `json:"ip" example:"192.0.2.1"`
+	Current      bool               `json:"current" example:"true"`
+	CreatedAt    *datetime.Datetime `json:"created_at" example:"2024-12-07T19:51:48.130157Z"`
+	LastActiveAt *datetime.Datetime `json:"last_active_at" example:"2024-12-07T19:51:48.130157Z"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type StaffRole struct {
+	Name        string       `json:"name" example:"moderator"`
+	Permissions []Permission `json:"permissions" example:"account:ban,task:moderate"`
+}
@@ -0,0 +1,10 @@
+package dto
+
+import (
+	"go-tonify-backend/pkg/datetime"
+)
+
+type SuspendAccount struct {
+	Until  datetime.Datetime `json:"until" binding:"required" example:"2024-12-14T19:51:48Z"`
+	Reason string            `json:"reason" binding:"required" example:"spam in task descriptions"`
+}
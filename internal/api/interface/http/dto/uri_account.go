@@ -0,0 +1,5 @@
+package dto
+
+type URIAccount struct {
+	ID int64 `uri:"id" binding:"required" example:"1"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type URIPortfolioItem struct {
+	ID int64 `uri:"id" binding:"required" example:"1"`
+}
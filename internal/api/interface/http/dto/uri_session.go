@@ -0,0 +1,5 @@
+package dto
+
+type URISession struct {
+	ID string `uri:"id" binding:"required" example:"3f1c2a4e-6b1d-4c8e-9f0a-2d5b7e8c1a90"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type VerificationRejection struct {
+	Reason string `json:"reason" binding:"required" example:"the document photo is blurry"`
+}
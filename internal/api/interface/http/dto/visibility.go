@@ -0,0 +1,18 @@
+package dto
+
+type Visibility string
+
+const (
+	EveryoneVisibility Visibility = "everyone"
+	MatchesVisibility  Visibility = "matches"
+	NobodyVisibility   Visibility = "nobody"
+)
+
+func (v Visibility) Valid() bool {
+	switch v {
+	case EveryoneVisibility, MatchesVisibility, NobodyVisibility:
+		return true
+	default:
+		return false
+	}
+}
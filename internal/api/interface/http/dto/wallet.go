@@ -0,0 +1,30 @@
+package dto
+
+import "go-tonify-backend/pkg/datetime"
+
+type WalletNonce struct {
+	Payload   string            `json:"payload" example:"4f0f6a3cb2d6e1c9a5b7d8e9f0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3"`
+	ExpiresAt datetime.Datetime `json:"expires_at" example:"2024-12-07T20:06:48Z"`
+}
+
+type TonProofDomain struct {
+	LengthBytes uint32 `json:"length_bytes" binding:"required" example:"21"`
+	Value       string `json:"value" binding:"required" example:"tonify.example.com"`
+}
+
+type TonProof struct {
+	Timestamp uint64         `json:"timestamp" binding:"required" example:"1733601108"`
+	Domain    TonProofDomain `json:"domain" binding:"required"`
+	Payload   string         `json:"payload" binding:"required" example:"4f0f6a3cb2d6e1c9a5b7d8e9f0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3"`
+	Signature string         `json:"signature" binding:"required,base64" example:"oJ0H0h0y3v2Nn7r3fW0u1c7k9hV4xQ2b3zJ8s6Zp1mE0yT4gK8lR2dF6cX9vB1nM5aS7qW3eR6tY8uI0oP2aDw=="`
+	StateInit string         `json:"state_init" binding:"required,base64" example:"te6cckECFgEAAwQAAgE0AQIBFP8A9KQT9LzyyAsDAFEAAAAAKamjF..."`
+}
+
+type LinkWallet struct {
+	Address string   `json:"address" binding:"required" example:"0:83dfd552e63729b472fcbcc8c45ebcc6691702558b68ec7527e1ba403a0f31a8"`
+	Proof   TonProof `json:"proof" binding:"required"`
+}
+
+type Wallet struct {
+	Address string `json:"address" example:"0:83dfd552e63729b472fcbcc8c45ebcc6691702558b68ec7527e1ba403a0f31a8"`
+}
@@ -0,0 +1,11 @@
+package dto
+
+type WidgetCredential struct {
+	ID        int64   `json:"id" binding:"required" example:"5443222678"`
+	FirstName string  `json:"first_name" binding:"required" example:"Pavel"`
+	LastName  *string `json:"last_name" example:"Melnyk"`
+	Username  *string `json:"username" example:"melnyk"`
+	PhotoURL  *string `json:"photo_url" example:"https://t.me/i/userpic/320/melnyk.jpg"`
+	AuthDate  uint    `json:"auth_date" binding:"required" example:"1732181719"`
+	Hash      string  `json:"hash" binding:"required" example:"fb2ba5f31223c7829fe5d5de4faa6d7614d01866a300c16c887eeb6843f4453f"`
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"go-tonify-backend/internal/api/interface/http/dto"
 	"go-tonify-backend/internal/container"
+	"go-tonify-backend/internal/domain/account/model"
 	"go-tonify-backend/internal/domain/account/usecase"
 	"go-tonify-backend/pkg/logger"
 	"net/http"
@@ -30,18 +31,28 @@ func (a *Auth) Authorization() gin.HandlerFunc {
 			abortWithResponse(ctx, http.StatusUnauthorized, dto.MissingAuthorizationTokenError)
 			return
 		}
-		accountID, err := a.accountUsecase.ParseAccessToken(tokenText)
+		authorization, err := a.accountUsecase.Authorize(ctx, tokenText)
 		if err != nil {
 			log.Error("fail to parse / validate parse access token", logger.FError(err))
-			abortWithResponse(ctx, http.StatusUnauthorized, dto.ParseValidateTokenError)
+			switch err {
+			case model.SessionRevokedError:
+				abortWithResponse(ctx, http.StatusUnauthorized, dto.SessionRevokedError)
+			case model.AccountSuspendedError:
+				abortWithResponse(ctx, http.StatusForbidden, dto.AccountSuspendedError)
+			case model.AccountBannedError:
+				abortWithResponse(ctx, http.StatusForbidden, dto.AccountBannedError)
+			default:
+				abortWithResponse(ctx, http.StatusUnauthorized, dto.ParseValidateTokenError)
+			}
 			return
 		}
-		if accountID == nil {
-			log.Error("account id has nil value", logger.FError(err))
+		if authorization == nil {
+			log.Error("authorization has nil value", logger.FError(err))
 			abortWithResponse(ctx, http.StatusUnauthorized, dto.NilError)
 			return
 		}
-		ctx.Set(dto.AccountIDKey, *accountID)
+		ctx.Set(dto.AccountIDKey, authorization.AccountID)
+		ctx.Set(dto.SessionIDKey, authorization.SessionID)
 		ctx.Next()
 	}
 }
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/api/interface/http/v1/converter"
+	"go-tonify-backend/internal/container"
+	"go-tonify-backend/internal/domain/staff/usecase"
+	"go-tonify-backend/pkg/logger"
+	"net/http"
+)
+
+type Permission struct {
+	container    container.Container
+	staffUsecase usecase.Staff
+}
+
+func NewPermission(container container.Container, staffUsecase usecase.Staff) *Permission {
+	return &Permission{
+		container:    container,
+		staffUsecase: staffUsecase,
+	}
+}
+
+// Staff lets through accounts that have at least one staff role.
+func (p *Permission) Staff() gin.HandlerFunc {
+	log := p.container.GetLogger()
+	return func(ctx *gin.Context) {
+		accountID, err := getAccountID(ctx)
+		if err != nil {
+			log.Error("fail to get account id", logger.FError(err))
+			abortWithResponse(ctx, http.StatusUnauthorized, err)
+			return
+		}
+		isStaff, err := p.staffUsecase.IsStaff(ctx, *accountID)
+		if err != nil {
+			log.Error("fail to check staff roles of account", logger.FError(err), logger.F("account_id", *accountID))
+			abortWithResponse(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		if !isStaff {
+			log.Error("account is not a staff member", logger.F("account_id", *accountID))
+			abortWithResponse(ctx, http.StatusForbidden, dto.StaffExpectedError)
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// Authorization lets through accounts that have every listed permission.
+func (p *Permission) Authorization(permissions ...dto.Permission) gin.HandlerFunc {
+	log := p.container.GetLogger()
+	permissionModels := converter.ConvertDtos2PermissionsModel(permissions)
+	return func(ctx *gin.Context) {
+		accountID, err := getAccountID(ctx)
+		if err != nil {
+			log.Error("fail to get account id", logger.FError(err))
+			abortWithResponse(ctx, http.StatusUnauthorized, err)
+			return
+		}
+		hasPermissions, err := p.staffUsecase.HasPermissions(ctx, *accountID, permissionModels...)
+		if err != nil {
+			log.Error("fail to check permissions of account", logger.FError(err), logger.F("account_id", *accountID))
+			abortWithResponse(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		if !hasPermissions {
+			log.Error("account lacks permissions", logger.F("account_id", *accountID), logger.F("permissions", permissions))
+			abortWithResponse(ctx, http.StatusForbidden, dto.PermissionExpectedError)
+			return
+		}
+		ctx.Next()
+	}
+}
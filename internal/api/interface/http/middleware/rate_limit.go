@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/container"
+	"go-tonify-backend/pkg/logger"
+	"go-tonify-backend/pkg/ratelimit"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type RateLimit struct {
+	container container.Container
+	limiter   ratelimit.Limiter
+}
+
+func NewRateLimit(container container.Container, limiter ratelimit.Limiter) *RateLimit {
+	return &RateLimit{
+		container: container,
+		limiter:   limiter,
+	}
+}
+
+// Limit throttles requests of the group by the authorized account,
+// requests without an account are throttled by the client ip.
+// The limiter failing lets the request through, it must not take the api down.
+func (r *RateLimit) Limit(group string, limit ratelimit.Limit) gin.HandlerFunc {
+	log := r.container.GetLogger()
+	return func(ctx *gin.Context) {
+		if limit.Disabled() {
+			ctx.Next()
+			return
+		}
+		key := group + ":ip:" + ctx.ClientIP()
+		if accountID, err := getAccountID(ctx); err == nil {
+			key = group + ":account:" + strconv.FormatInt(*accountID, 10)
+		}
+		result, err := r.limiter.Allow(ctx, key, limit)
+		if err != nil {
+			log.Error("fail to check rate limit", logger.FError(err), logger.F("key", key))
+			ctx.Next()
+			return
+		}
+		ctx.Header(dto.RateLimitHeaderKey, strconv.Itoa(result.Limit))
+		ctx.Header(dto.RateRemainingHeaderKey, strconv.Itoa(result.Remaining))
+		ctx.Header(dto.RateResetHeaderKey, formatSeconds(result.ResetAfter))
+		if !result.Allowed {
+			log.Warn("rate limit exceeded", logger.F("key", key))
+			ctx.Header(dto.RetryAfterHeaderKey, formatSeconds(result.RetryAfter))
+			abortWithResponse(ctx, http.StatusTooManyRequests, dto.TooManyRequestsError)
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// formatSeconds rounds up, so a client waiting the advertised time is not rejected again.
+func formatSeconds(duration time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(duration.Seconds())), 10)
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"go-tonify-backend/internal/container"
+	"go-tonify-backend/pkg/logger"
+	"go-tonify-backend/pkg/ratelimit"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type fakeContainer struct {
+	container.Container
+}
+
+func (f *fakeContainer) GetLogger() logger.Logger {
+	return logger.NewLogger(logger.DEV, logger.LevelFatal)
+}
+
+func TestRateLimitIgnoresForwardedHeader(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	if err := r.SetTrustedProxies(nil); err != nil {
+		t.Fatal("fail to set trusted proxies", err)
+	}
+	rateLimit := NewRateLimit(&fakeContainer{}, ratelimit.NewMemoryLimiter())
+	r.GET("/", rateLimit.Limit("test", ratelimit.Limit{Requests: 1, Period: time.Minute}), func(ctx *gin.Context) {
+		ctx.Status(http.StatusOK)
+	})
+	expectedStatusCodes := []int{http.StatusOK, http.StatusTooManyRequests, http.StatusTooManyRequests}
+	for i, forwardedFor := range []string{"198.51.100.1", "198.51.100.2", "198.51.100.3"} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = "203.0.113.7:40000"
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		resp := httptest.NewRecorder()
+		r.ServeHTTP(resp, req)
+		if resp.Code != expectedStatusCodes[i] {
+			t.Errorf("request with X-Forwarded-For %s: expected %d, got %d", forwardedFor, expectedStatusCodes[i], resp.Code)
+		}
+	}
+}
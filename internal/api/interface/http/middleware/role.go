@@ -5,6 +5,7 @@ import (
 	"go-tonify-backend/internal/api/interface/http/dto"
 	"go-tonify-backend/internal/api/interface/http/v1/converter"
 	"go-tonify-backend/internal/container"
+	"go-tonify-backend/internal/domain/account/model"
 	"go-tonify-backend/internal/domain/account/usecase"
 	"go-tonify-backend/pkg/logger"
 	"net/http"
@@ -32,6 +33,15 @@ func (r *Role) Authorization(role dto.Role) gin.HandlerFunc {
 			return
 		}
 		role := converter.ConvertDto2RoleModel(role)
+		if activeRole, ok := getActiveRole(ctx); ok {
+			if activeRole != role {
+				log.Error("expected other active role", logger.F("expected_role", role), logger.F("active_role", activeRole))
+				abortWithResponse(ctx, http.StatusForbidden, dto.RoleExpectedError)
+				return
+			}
+			ctx.Next()
+			return
+		}
 		hasExpectedRole, err := r.accountUsecase.AccountHasRole(ctx, *accountID, role)
 		if err != nil {
 			log.Error(
@@ -50,3 +60,61 @@ func (r *Role) Authorization(role dto.Role) gin.HandlerFunc {
 		ctx.Next()
 	}
 }
+
+// ActiveRole resolves the role the account acts in for the request: the X-Active-Role header
+// or the default role of the account. The account must hold the role.
+func (r *Role) ActiveRole() gin.HandlerFunc {
+	log := r.container.GetLogger()
+	return func(ctx *gin.Context) {
+		accountID, err := getAccountID(ctx)
+		if err != nil {
+			log.Error("fail to get account id", logger.FError(err))
+			abortWithResponse(ctx, http.StatusUnauthorized, err)
+			return
+		}
+		var activeRole model.Role
+		if header := ctx.GetHeader(dto.ActiveRoleHeaderKey); len(header) > 0 {
+			roleDto := dto.Role(header)
+			if !roleDto.Valid() {
+				log.Error("unknown active role", logger.F("active_role", header))
+				abortWithResponse(ctx, http.StatusBadRequest, dto.InvalidActiveRoleError)
+				return
+			}
+			activeRole = converter.ConvertDto2RoleModel(roleDto)
+		} else {
+			defaultRole, err := r.accountUsecase.DefaultRole(ctx, *accountID)
+			if err != nil {
+				log.Error("fail to get default role of account", logger.FError(err), logger.F("account_id", *accountID))
+				abortWithResponse(ctx, http.StatusInternalServerError, dto.InternalServerError)
+				return
+			}
+			activeRole = defaultRole
+		}
+		hasActiveRole, err := r.accountUsecase.AccountHasRole(ctx, *accountID, activeRole)
+		if err != nil {
+			log.Error(
+				"fail to check role of account by id",
+				logger.FError(err),
+				logger.F("account_id", *accountID),
+			)
+			abortWithResponse(ctx, http.StatusInternalServerError, err)
+			return
+		}
+		if !hasActiveRole {
+			log.Error("account doesn't hold the active role", logger.F("active_role", activeRole))
+			abortWithResponse(ctx, http.StatusForbidden, dto.ActiveRoleNotHeldError)
+			return
+		}
+		ctx.Set(dto.ActiveRoleKey, activeRole)
+		ctx.Next()
+	}
+}
+
+func getActiveRole(ctx *gin.Context) (model.Role, bool) {
+	activeRoleValue, exist := ctx.Get(dto.ActiveRoleKey)
+	if !exist {
+		return model.UnknownRole, false
+	}
+	activeRole, ok := activeRoleValue.(model.Role)
+	return activeRole, ok
+}
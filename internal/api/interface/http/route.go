@@ -16,8 +16,10 @@ import (
 	accountUsecase "go-tonify-backend/internal/domain/account/usecase"
 	categoryUsecase "go-tonify-backend/internal/domain/category/usecase"
 	countryUsecase "go-tonify-backend/internal/domain/country/usecase"
+	staffUsecase "go-tonify-backend/internal/domain/staff/usecase"
 	taskUsecase "go-tonify-backend/internal/domain/task/usecase"
 	"go-tonify-backend/pkg/datetime"
+	"go-tonify-backend/pkg/ratelimit"
 	"time"
 )
 
@@ -32,34 +34,67 @@ import (
 //	@license.url	http://www.apache.org/licenses/LICENSE-2.0.html
 
 type Handler struct {
-	container       container.Container
-	accountUsecase  accountUsecase.Account
-	matchUsecase    accountUsecase.Match
-	countryUsecase  countryUsecase.Country
-	taskUsecase     taskUsecase.Task
-	categoryUsecase categoryUsecase.Category
+	container           container.Container
+	accountUsecase      accountUsecase.Account
+	sessionUsecase      accountUsecase.Session
+	matchUsecase        accountUsecase.Match
+	countryUsecase      countryUsecase.Country
+	taskUsecase         taskUsecase.Task
+	categoryUsecase     categoryUsecase.Category
+	staffUsecase        staffUsecase.Staff
+	sanctionUsecase     accountUsecase.Sanction
+	privacyUsecase      accountUsecase.Privacy
+	exportUsecase       accountUsecase.Export
+	verificationUsecase accountUsecase.Verification
+	portfolioUsecase    accountUsecase.Portfolio
+	walletUsecase       accountUsecase.Wallet
+	auditUsecase        accountUsecase.Audit
+	reportUsecase       accountUsecase.Report
 }
 
 func NewHandler(
 	container container.Container,
 	accountUsecase accountUsecase.Account,
+	sessionUsecase accountUsecase.Session,
 	matchUsecase accountUsecase.Match,
 	countryUsecase countryUsecase.Country,
 	taskUsecase taskUsecase.Task,
 	categoryUsecase categoryUsecase.Category,
+	staffUsecase staffUsecase.Staff,
+	sanctionUsecase accountUsecase.Sanction,
+	privacyUsecase accountUsecase.Privacy,
+	exportUsecase accountUsecase.Export,
+	verificationUsecase accountUsecase.Verification,
+	portfolioUsecase accountUsecase.Portfolio,
+	walletUsecase accountUsecase.Wallet,
+	auditUsecase accountUsecase.Audit,
+	reportUsecase accountUsecase.Report,
 ) *Handler {
 	return &Handler{
-		container:       container,
-		accountUsecase:  accountUsecase,
-		matchUsecase:    matchUsecase,
-		countryUsecase:  countryUsecase,
-		taskUsecase:     taskUsecase,
-		categoryUsecase: categoryUsecase,
+		container:           container,
+		accountUsecase:      accountUsecase,
+		sessionUsecase:      sessionUsecase,
+		matchUsecase:        matchUsecase,
+		countryUsecase:      countryUsecase,
+		taskUsecase:         taskUsecase,
+		categoryUsecase:     categoryUsecase,
+		staffUsecase:        staffUsecase,
+		sanctionUsecase:     sanctionUsecase,
+		privacyUsecase:      privacyUsecase,
+		exportUsecase:       exportUsecase,
+		verificationUsecase: verificationUsecase,
+		portfolioUsecase:    portfolioUsecase,
+		walletUsecase:       walletUsecase,
+		auditUsecase:        auditUsecase,
+		reportUsecase:       reportUsecase,
 	}
 }
 
 func (h *Handler) Run() error {
 	r := gin.Default()
+	if err := r.SetTrustedProxies(h.container.GetServerConfig().TrustedProxies); err != nil {
+		return err
+	}
 
 	validation, err := h.configureAndInitValidation()
 	if err != nil {
@@ -69,7 +104,10 @@ func (h *Handler) Run() error {
 	corsMiddleware := middleware.NewCORS(h.container)
 	authMiddleware := middleware.NewAuth(h.container, h.accountUsecase)
 	roleMiddleware := middleware.NewRole(h.container, h.accountUsecase)
+	permissionMiddleware := middleware.NewPermission(h.container, h.staffUsecase)
 	multipartFormMiddleware := middleware.NewMultipartForm(h.container)
+	rateLimitMiddleware := middleware.NewRateLimit(h.container, ratelimit.NewMemoryLimiter())
+	rateLimitConf := h.container.GetRateLimitConfig()
 
 	r.Use(corsMiddleware.CORS())
 	r.Use(loggerMiddleware.Logging())
@@ -81,45 +119,109 @@ func (h *Handler) Run() error {
 	authHandler := h.composeAuthHandler(validation)
 
 	authGroup := v1.Group("auth")
+	authGroup.Use(rateLimitMiddleware.Limit("auth", rateLimitConf.Auth))
 	{
-		authGroup.POST("/sign-up", multipartFormMiddleware.Limit(50<<20), authHandler.SignUp)
+		authGroup.POST("/sign-up", rateLimitMiddleware.Limit("sign-up", rateLimitConf.SignUp), multipartFormMiddleware.Limit(50<<20), authHandler.SignUp)
 		authGroup.POST("/sign-in", authHandler.SignIn)
+		authGroup.POST("/sign-in/widget", authHandler.SignInWidget)
+		authGroup.POST("/restore", authHandler.Restore)
+		authGroup.POST("/refresh", authHandler.Refresh)
+		authGroup.POST("/logout", authMiddleware.Authorization(), authHandler.Logout)
 	}
 	accountHandler := h.composeAccount(validation)
+	sessionHandler := h.composeSession(validation)
+	exportHandler := h.composeExport(validation)
+	portfolioHandler := h.composePortfolio(validation)
+	walletHandler := h.composeWallet(validation)
+	v1.GET("/account/nickname/available", rateLimitMiddleware.Limit("common", rateLimitConf.Common), accountHandler.NicknameAvailability)
 	accountGroup := v1.Group("account")
-	accountGroup.Use(authMiddleware.Authorization())
+	accountGroup.Use(authMiddleware.Authorization(), rateLimitMiddleware.Limit("account", rateLimitConf.Account))
 	{
 		accountGroup.GET("/my", accountHandler.GetMy)
+		accountGroup.PATCH("", accountHandler.PatchMy)
 		accountGroup.PATCH("/edit", multipartFormMiddleware.Limit(50<<20), accountHandler.EditMy)
 		accountGroup.PATCH("/change/role", accountHandler.ChangeRole)
+		accountGroup.POST("/roles", accountHandler.AddRole)
+		accountGroup.DELETE("/roles/:role", accountHandler.RemoveRole)
 		accountGroup.DELETE("/delete", accountHandler.DeleteMy)
+		accountGroup.GET("/sessions", sessionHandler.GetAll)
+		accountGroup.DELETE("/sessions/:id", sessionHandler.Delete)
+		accountGroup.GET("/privacy", accountHandler.GetPrivacy)
+		accountGroup.PUT("/privacy", accountHandler.UpdatePrivacy)
+		accountGroup.GET("/search", accountHandler.Search)
+		accountGroup.POST("/export", exportHandler.Request)
+		accountGroup.GET("/export/:id", exportHandler.Get)
+		accountGroup.GET("/export/:id/download", exportHandler.Download)
+		accountGroup.GET("/portfolio", portfolioHandler.GetAll)
+		accountGroup.POST("/portfolio", roleMiddleware.ActiveRole(), roleMiddleware.Authorization(dto.FreelancerRole), multipartFormMiddleware.Limit(50<<20), portfolioHandler.Add)
+		accountGroup.PUT("/portfolio/order", portfolioHandler.Reorder)
+		accountGroup.PUT("/portfolio/:id", portfolioHandler.Edit)
+		accountGroup.DELETE("/portfolio/:id", portfolioHandler.Remove)
+		accountGroup.POST("/wallet/nonce", walletHandler.Nonce)
+		accountGroup.POST("/wallet", walletHandler.Link)
+		accountGroup.DELETE("/wallet", walletHandler.Unlink)
+		accountGroup.GET("/:id", accountHandler.GetByID)
 	}
 	matchHandler := h.composeMatch(validation)
 	matchGroup := v1.Group("match")
-	matchGroup.Use(authMiddleware.Authorization())
+	matchGroup.Use(authMiddleware.Authorization(), rateLimitMiddleware.Limit("match", rateLimitConf.Match), roleMiddleware.ActiveRole())
 	{
 		matchGroup.GET("/matchable/accounts", matchHandler.MatchableAccounts)
 		matchGroup.GET("/likers", matchHandler.AccountLikers)
-		matchGroup.POST("/action/:action", matchHandler.MatchAction)
+		matchGroup.POST("/action/:action", rateLimitMiddleware.Limit("match-action", rateLimitConf.MatchAction), matchHandler.MatchAction)
+		matchGroup.GET("/matches", matchHandler.Matches)
+		matchGroup.DELETE("/matches/:id", matchHandler.Unmatch)
+		matchGroup.POST("/rewind", matchHandler.Rewind)
+		matchGroup.POST("/block/:id", matchHandler.Block)
+		matchGroup.GET("/preferences", matchHandler.GetPreferences)
+		matchGroup.PUT("/preferences", matchHandler.UpdatePreferences)
+	}
+	reportHandler := h.composeReport(validation)
+	reportGroup := v1.Group("report")
+	reportGroup.Use(authMiddleware.Authorization(), rateLimitMiddleware.Limit("report", rateLimitConf.Report))
+	{
+		reportGroup.POST("", multipartFormMiddleware.Limit(50<<20), reportHandler.Create)
 	}
 	taskHandler := h.composeTask(validation)
 	taskGroup := v1.Group("task")
-	taskGroup.Use(authMiddleware.Authorization())
+	taskGroup.Use(authMiddleware.Authorization(), rateLimitMiddleware.Limit("task", rateLimitConf.Task))
 	{
-		taskGroup.POST("/create", roleMiddleware.Authorization(dto.ClientRole), taskHandler.CreateTask)
+		taskGroup.POST("/create", roleMiddleware.ActiveRole(), roleMiddleware.Authorization(dto.ClientRole), taskHandler.CreateTask)
 		taskGroup.GET("/list", taskHandler.GetListTask)
 	}
 	commonHandler := h.composeCommon()
 	commonGroup := v1.Group("/common")
+	commonGroup.Use(rateLimitMiddleware.Limit("common", rateLimitConf.Common))
 	{
 		commonGroup.GET("/ping", commonHandler.Ping)
 		commonGroup.GET("/countries", commonHandler.Countries)
 	}
 	categoryHandler := h.composeCategory(validation)
 	categoryGroup := v1.Group("/category")
+	categoryGroup.Use(rateLimitMiddleware.Limit("common", rateLimitConf.Common))
 	{
 		categoryGroup.GET("/all", categoryHandler.GetAll)
 	}
+	adminHandler := h.composeAdmin(validation)
+	adminGroup := v1.Group("/admin")
+	adminGroup.Use(authMiddleware.Authorization(), rateLimitMiddleware.Limit("admin", rateLimitConf.Admin), permissionMiddleware.Staff())
+	{
+		adminGroup.GET("/staff/my", adminHandler.GetMyStaffRoles)
+		adminGroup.GET("/staff/roles", permissionMiddleware.Authorization(dto.StaffManagePermission), adminHandler.GetStaffRoles)
+		adminGroup.POST("/staff/roles/assign", permissionMiddleware.Authorization(dto.StaffManagePermission), adminHandler.AssignStaffRole)
+		adminGroup.POST("/staff/roles/revoke", permissionMiddleware.Authorization(dto.StaffManagePermission), adminHandler.RevokeStaffRole)
+		adminGroup.POST("/accounts/:id/suspend", permissionMiddleware.Authorization(dto.AccountBanPermission), adminHandler.SuspendAccount)
+		adminGroup.POST("/accounts/:id/ban", permissionMiddleware.Authorization(dto.AccountBanPermission), adminHandler.BanAccount)
+		adminGroup.POST("/accounts/:id/lift", permissionMiddleware.Authorization(dto.AccountBanPermission), adminHandler.LiftSanction)
+		adminGroup.GET("/accounts/:id/sanctions", permissionMiddleware.Authorization(dto.AccountBanPermission), adminHandler.GetSanctions)
+		adminGroup.GET("/accounts/:id/audit", permissionMiddleware.Authorization(dto.AccountAuditPermission), adminHandler.GetAccountAudit)
+		adminGroup.GET("/verifications", permissionMiddleware.Authorization(dto.AccountVerifyPermission), adminHandler.GetVerificationQueue)
+		adminGroup.POST("/accounts/:id/verification/approve", permissionMiddleware.Authorization(dto.AccountVerifyPermission), adminHandler.ApproveVerification)
+		adminGroup.POST("/accounts/:id/verification/reject", permissionMiddleware.Authorization(dto.AccountVerifyPermission), adminHandler.RejectVerification)
+		adminGroup.GET("/reports", permissionMiddleware.Authorization(dto.ReportReviewPermission), adminHandler.GetReportQueue)
+		adminGroup.POST("/reports/:id/resolve", permissionMiddleware.Authorization(dto.ReportReviewPermission), adminHandler.ResolveReport)
+		adminGroup.POST("/reports/:id/dismiss", permissionMiddleware.Authorization(dto.ReportReviewPermission), adminHandler.DismissReport)
+	}
 
 	telegramBotHandler := h.composeTelegramBot()
 	telegramBotGroup := r.Group("/telegram/bot")
@@ -157,11 +259,31 @@ func (h *Handler) runHttpsServer(r *gin.Engine) error {
 }
 
 func (h *Handler) composeAuthHandler(validation validator.HttpValidator) *v1.AuthHandler {
-	return v1.NewAuthHandler(h.container, validation, h.accountUsecase)
+	return v1.NewAuthHandler(h.container, validation, h.accountUsecase, h.sessionUsecase)
 }
 
 func (h *Handler) composeAccount(validation validator.HttpValidator) *v1.AccountHandler {
-	return v1.NewAccountHandler(h.container, validation, h.accountUsecase)
+	return v1.NewAccountHandler(h.container, validation, h.accountUsecase, h.privacyUsecase)
+}
+
+func (h *Handler) composeSession(validation validator.HttpValidator) *v1.SessionHandler {
+	return v1.NewSessionHandler(h.container, validation, h.sessionUsecase)
+}
+
+func (h *Handler) composeExport(validation validator.HttpValidator) *v1.ExportHandler {
+	return v1.NewExportHandler(h.container, validation, h.exportUsecase)
+}
+
+func (h *Handler) composePortfolio(validation validator.HttpValidator) *v1.PortfolioHandler {
+	return v1.NewPortfolioHandler(h.container, validation, h.portfolioUsecase)
+}
+
+func (h *Handler) composeWallet(validation validator.HttpValidator) *v1.WalletHandler {
+	return v1.NewWalletHandler(h.container, validation, h.walletUsecase)
+}
+
+func (h *Handler) composeReport(validation validator.HttpValidator) *v1.ReportHandler {
+	return v1.NewReportHandler(h.container, validation, h.reportUsecase)
 }
 
 func (h *Handler) composeCommon() *v1.CommonHandler {
@@ -184,6 +306,10 @@ func (h *Handler) composeCategory(validator validator.HttpValidator) *v1.Categor
 	return v1.NewCategoryHandler(h.container, validator, h.categoryUsecase)
 }
 
+func (h *Handler) composeAdmin(validator validator.HttpValidator) *v1.AdminHandler {
+	return v1.NewAdminHandler(h.container, validator, h.staffUsecase, h.sanctionUsecase, h.verificationUsecase, h.auditUsecase, h.reportUsecase)
+}
+
 func (h *Handler) configureAndInitValidation() (validator.HttpValidator, error) {
 	validationEngine, ok := binding.Validator.Engine().(*v.Validate)
 	if !ok {
@@ -17,17 +17,20 @@ type AccountHandler struct {
 	container      container.Container
 	validation     validator.HttpValidator
 	accountUsecase usecase.Account
+	privacyUsecase usecase.Privacy
 }
 
 func NewAccountHandler(
 	container container.Container,
 	validation validator.HttpValidator,
 	accountUsecase usecase.Account,
+	privacyUsecase usecase.Privacy,
 ) *AccountHandler {
 	return &AccountHandler{
 		container:      container,
 		validation:     validation,
 		accountUsecase: accountUsecase,
+		privacyUsecase: privacyUsecase,
 	}
 }
 
@@ -68,10 +71,286 @@ func (a *AccountHandler) GetMy(ctx *gin.Context) {
 	successResponse(ctx, http.StatusOK, account)
 }
 
+// GetByID godoc
+//
+//	@Summary		Get an account
+//	@Description	Get the public profile of an account. Last name, location, company, document attachment and telegram id are null when the privacy settings of the account hide them from the authenticated user
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			id				path		int									true	"account id"
+//	@Success		200				{object}	dto.Response{response=dto.Account}	"account details"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}	"account does not exist or is not available"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/account/{id} [get]
+//	@Security		ApiKeyAuth
+func (a *AccountHandler) GetByID(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var uriAccount dto.URIAccount
+	if err := ctx.ShouldBindUri(&uriAccount); err != nil {
+		log.Error("fail to bind uri account", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	accountModel, err := a.accountUsecase.GetPublicAccount(ctx, *accountID, uriAccount.ID)
+	if err != nil {
+		log.Error("fail to get public account by id", logger.F("account_id", uriAccount.ID), logger.FError(err))
+		switch err {
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	account := converter.ConvertModel2AccountResponse(accountModel)
+	successResponse(ctx, http.StatusOK, account)
+}
+
+// Search godoc
+//
+//	@Summary		Search accounts
+//	@Description	Search active accounts by a full-text query over the name, nickname and about me, narrowed by role, tags, categories, country, gender and company presence.
+//	@Description	Without a query the relevance sort falls back to the newest accounts first. Fields hidden by the privacy settings are null
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization	header		string													true	"account's access token"
+//	@Param			q				query		string													false	"full-text query"
+//	@Param			role			query		dto.Role												false	"account role"
+//	@Param			tags			query		[]string												false	"tag titles, an account matches any of them"	collectionFormat(multi)
+//	@Param			category_ids	query		[]int													false	"category ids, an account matches any of them"	collectionFormat(multi)
+//	@Param			country			query		string													false	"country code, case-insensitive"
+//	@Param			gender			query		dto.Gender												false	"gender"
+//	@Param			has_company		query		bool													false	"whether the account has a company"
+//	@Param			sort			query		dto.AccountSort											false	"sort order, relevance by default"
+//	@Param			offset			query		int														false	"pagination offset"
+//	@Param			limit			query		int														true	"pagination limit"
+//	@Success		200				{object}	dto.Response{response=dto.Pagination{data=[]dto.Account}}	"found accounts"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}						"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}						"the authorization token is invalid/expired/missing"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}						"detailed error message"
+//	@Router			/v1/account/search [get]
+//	@Security		ApiKeyAuth
+func (a *AccountHandler) Search(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var searchAccounts dto.SearchAccounts
+	if err := ctx.ShouldBindQuery(&searchAccounts); err != nil {
+		log.Error("fail to bind search accounts", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	searchAccountsModel := converter.ConvertDto2SearchAccountsModel(&searchAccounts)
+	paginationModel, err := a.accountUsecase.SearchAccounts(ctx, *accountID, *searchAccountsModel)
+	if err != nil {
+		log.Error("fail to search accounts", logger.FError(err))
+		switch err {
+		case model.InvalidSearchFilterError:
+			failResponse(ctx, http.StatusBadRequest, dto.InvalidSearchFilterError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	accounts := converter.ConvertModels2AccountResponses(paginationModel.Data)
+	pagination := dto.Pagination{
+		Offset: paginationModel.Offset,
+		Limit:  paginationModel.Limit,
+		Total:  paginationModel.Total,
+		Data:   accounts,
+	}
+	successResponse(ctx, http.StatusOK, pagination)
+}
+
+// NicknameAvailability godoc
+//
+//	@Summary		Check nickname availability
+//	@Description	Check whether a nickname can be taken on sign up or edit. Nicknames are unique regardless of the case, some of them are reserved
+//	@Tags			account
+//	@Produce		json
+//	@Param			nickname	query		string											true	"nickname starting with @"
+//	@Success		200			{object}	dto.Response{response=dto.NicknameAvailability}	"nickname availability"
+//	@Failure		400			{object}	dto.Response{response=dto.Empty}				"detailed error message"
+//	@Failure		500			{object}	dto.Response{response=dto.Empty}				"detailed error message"
+//	@Router			/v1/account/nickname/available [get]
+func (a *AccountHandler) NicknameAvailability(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	var getNicknameAvailability dto.GetNicknameAvailability
+	if err := ctx.ShouldBindQuery(&getNicknameAvailability); err != nil {
+		log.Error("fail to bind get nickname availability", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	available, err := a.accountUsecase.IsNicknameAvailable(ctx, getNicknameAvailability.Nickname)
+	if err != nil {
+		log.Error("fail to check nickname availability", logger.FError(err))
+		switch err {
+		case model.InvalidNicknameError:
+			failResponse(ctx, http.StatusBadRequest, dto.BadRequestError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	nicknameAvailability := dto.NicknameAvailability{
+		Nickname:  getNicknameAvailability.Nickname,
+		Available: available,
+	}
+	successResponse(ctx, http.StatusOK, nicknameAvailability)
+}
+
+// GetPrivacy godoc
+//
+//	@Summary		Get my privacy settings
+//	@Description	Get who can see the last name, location, company, document attachment and telegram id of the authenticated user's account
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Success		200				{object}	dto.Response{response=dto.Privacy}	"privacy settings"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/account/privacy [get]
+//	@Security		ApiKeyAuth
+func (a *AccountHandler) GetPrivacy(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	privacyModel, err := a.privacyUsecase.GetPrivacy(ctx, *accountID)
+	if err != nil {
+		log.Error("fail to get privacy", logger.F("account_id", *accountID), logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	privacy := converter.ConvertModel2PrivacyResponse(privacyModel)
+	successResponse(ctx, http.StatusOK, privacy)
+}
+
+// UpdatePrivacy godoc
+//
+//	@Summary		Update my privacy settings
+//	@Description	Set who can see each field: everyone, matched accounts only or nobody
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			request			body		dto.Privacy							true	"privacy settings"
+//	@Success		200				{object}	dto.Response{response=dto.Privacy}	"privacy settings"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/account/privacy [put]
+//	@Security		ApiKeyAuth
+func (a *AccountHandler) UpdatePrivacy(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var privacyRequest dto.Privacy
+	if err := ctx.ShouldBindJSON(&privacyRequest); err != nil {
+		log.Error("fail to parse/validate request model", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	privacyModel := converter.ConvertDto2PrivacyModel(&privacyRequest)
+	if err := a.privacyUsecase.UpdatePrivacy(ctx, *accountID, *privacyModel); err != nil {
+		log.Error("fail to update privacy", logger.F("account_id", *accountID), logger.FError(err))
+		switch err {
+		case model.UnknownVisibilityError:
+			failResponse(ctx, http.StatusBadRequest, dto.BadRequestError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	successResponse(ctx, http.StatusOK, privacyRequest)
+}
+
+// PatchMy godoc
+//
+//	@Summary		Patch my account
+//	@Description	Partially update the authenticated user's account with a JSON merge patch (RFC 7396). Absent fields stay unchanged, null clears middle_name, about_me, company, tags and category_ids. Tags and categories are replaced by the given lists. Files are changed with the multipart /v1/account/edit
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			request			body		dto.PatchAccount					true	"merge patch"
+//	@Success		200				{object}	dto.Response{response=dto.Account}	"account details"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		409				{object}	dto.Response{response=dto.Empty}	"nickname is taken or reserved"
+//	@Failure		410				{object}	dto.Response{response=dto.Empty}	"account does not exist or has been deleted"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/account [patch]
+//	@Security		ApiKeyAuth
+func (a *AccountHandler) PatchMy(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var patchAccountRequest dto.PatchAccount
+	if err := ctx.ShouldBindJSON(&patchAccountRequest); err != nil {
+		log.Error("fail to parse/validate request model", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	patchAccount := converter.ConvertDto2PatchAccountModel(*accountID, &patchAccountRequest)
+	if err := a.accountUsecase.PatchAccount(ctx, *patchAccount, getAuditSource(ctx, accountID)); err != nil {
+		log.Error("fail to patch account", logger.F("account_id", *accountID), logger.FError(err))
+		switch err {
+		case model.InvalidAccountPatchError:
+			failResponse(ctx, http.StatusBadRequest, dto.InvalidAccountPatchError, err)
+		case model.NicknameUnavailableError:
+			failResponse(ctx, http.StatusConflict, dto.NicknameUnavailableError, err)
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusGone, dto.ModelNotFoundError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	accountModel, err := a.accountUsecase.GetDetailsAccount(ctx, *accountID)
+	if err != nil {
+		log.Error("fail to get account by id", logger.F("account_id", accountID), logger.FError(err))
+		switch err {
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusGone, dto.ModelNotFoundError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	account := converter.ConvertModel2AccountResponse(accountModel)
+	successResponse(ctx, http.StatusOK, account)
+}
+
 // EditMy godoc
 //
 //	@Summary		Edit my account
-//	@Description	Edit the details of the authenticated user's account
+//	@Description	Replace the details of the authenticated user's account and upload avatar or document files. Use PATCH /v1/account to change single fields
 //	@Tags			account
 //	@Accept			multipart/form-data
 //	@Produce		json
@@ -92,6 +371,7 @@ func (a *AccountHandler) GetMy(ctx *gin.Context) {
 //	@Success		200					{object}	dto.Response{response=dto.Account}	"account details"
 //	@Failure		400					{object}	dto.Response{response=dto.Empty}	"detailed error message"
 //	@Failure		401					{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		409					{object}	dto.Response{response=dto.Empty}	"nickname is taken or reserved"
 //	@Failure		410					{object}	dto.Response{response=dto.Empty}	"account does not exist or has been deleted"
 //	@Failure		500					{object}	dto.Response{response=dto.Empty}	"detailed error message"
 //	@Router			/v1/account/edit [patch]
@@ -148,10 +428,12 @@ func (a *AccountHandler) EditMy(ctx *gin.Context) {
 		AvatarFileHeader:   avatarFileHeader,
 		DocumentFileHeader: documentFileHeader,
 	}
-	err = a.accountUsecase.EditAccount(ctx, editAccount)
+	err = a.accountUsecase.EditAccount(ctx, editAccount, getAuditSource(ctx, accountID))
 	if err != nil {
 		log.Error("fail process edit account", logger.FError(err))
 		switch err {
+		case model.NicknameUnavailableError:
+			failResponse(ctx, http.StatusConflict, dto.NicknameUnavailableError, err)
 		case model.EntityNotFoundError:
 			failResponse(ctx, http.StatusGone, dto.ModelNotFoundError, err)
 		default:
@@ -176,7 +458,8 @@ func (a *AccountHandler) EditMy(ctx *gin.Context) {
 // ChangeRole godoc
 //
 //	@Summary		Change role for my account
-//	@Description	A user will change their own role
+//	@Description	A user will change their own default role, the role is added to the account when it doesn't hold it yet.
+//	@Description	The other role is kept together with its likes, dislikes and tasks.
 //	@Tags			account
 //	@Accept			json
 //	@Produce		json
@@ -204,7 +487,7 @@ func (a *AccountHandler) ChangeRole(ctx *gin.Context) {
 		return
 	}
 	role := converter.ConvertDto2RoleModel(changeRole.NewRole)
-	if err := a.accountUsecase.ChangeRole(ctx, *accountID, role); err != nil {
+	if err := a.accountUsecase.ChangeRole(ctx, *accountID, role, getAuditSource(ctx, accountID)); err != nil {
 		log.Error(
 			"fail to change role for account",
 			logger.F("account_id", *accountID),
@@ -228,6 +511,110 @@ func (a *AccountHandler) ChangeRole(ctx *gin.Context) {
 	successResponse(ctx, http.StatusOK, account)
 }
 
+// AddRole godoc
+//
+//	@Summary		Add a role to my account
+//	@Description	The account holds both roles afterwards, the role to act in is chosen per request with the X-Active-Role header
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"account's access token"
+//	@Param			request			body		dto.AddRole				true	"role to add"
+//	@Success		200	{object}	dto.Response{response=dto.Account}	"updated account details"
+//	@Failure		400	{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401	{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		410	{object}	dto.Response{response=dto.Empty}	"account does not exist or has been deleted"
+//	@Failure		500	{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/account/roles [post]
+//	@Security		ApiKeyAuth
+func (a *AccountHandler) AddRole(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusUnauthorized, err, nil)
+		return
+	}
+	var addRole dto.AddRole
+	if err := ctx.ShouldBindJSON(&addRole); err != nil {
+		log.Error("fail to bind request model", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	role := converter.ConvertDto2RoleModel(addRole.Role)
+	if err := a.accountUsecase.AddRole(ctx, *accountID, role, getAuditSource(ctx, accountID)); err != nil {
+		log.Error("fail to add role to account", logger.F("account_id", *accountID), logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	a.respondAccountDetails(ctx, *accountID)
+}
+
+// RemoveRole godoc
+//
+//	@Summary		Remove a role from my account
+//	@Description	The last role of the account can't be removed. When the default role is removed, the remaining role becomes the default one.
+//	@Description	Likes, dislikes and tasks made in the removed role are kept and come back when the role is added again
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"account's access token"
+//	@Param			role			path		string					true	"role to remove"	Enums(client, freelancer)
+//	@Success		200	{object}	dto.Response{response=dto.Account}	"updated account details"
+//	@Failure		400	{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401	{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		404	{object}	dto.Response{response=dto.Empty}	"the account doesn't hold the role"
+//	@Failure		409	{object}	dto.Response{response=dto.Empty}	"the role is the last role of the account"
+//	@Failure		410	{object}	dto.Response{response=dto.Empty}	"account does not exist or has been deleted"
+//	@Failure		500	{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/account/roles/{role} [delete]
+//	@Security		ApiKeyAuth
+func (a *AccountHandler) RemoveRole(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusUnauthorized, err, nil)
+		return
+	}
+	var uriRole dto.URIRole
+	if err := ctx.ShouldBindUri(&uriRole); err != nil {
+		log.Error("fail to bind uri role", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	role := converter.ConvertDto2RoleModel(uriRole.Role)
+	if err := a.accountUsecase.RemoveRole(ctx, *accountID, role, getAuditSource(ctx, accountID)); err != nil {
+		log.Error("fail to remove role of account", logger.F("account_id", *accountID), logger.FError(err))
+		switch err {
+		case model.RoleNotHeldError:
+			failResponse(ctx, http.StatusNotFound, dto.RoleNotHeldError, err)
+		case model.LastRoleError:
+			failResponse(ctx, http.StatusConflict, dto.LastRoleError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	a.respondAccountDetails(ctx, *accountID)
+}
+
+func (a *AccountHandler) respondAccountDetails(ctx *gin.Context, accountID int64) {
+	log := a.container.GetLogger()
+	accountModel, err := a.accountUsecase.GetDetailsAccount(ctx, accountID)
+	if err != nil {
+		log.Error("fail to get account by id", logger.F("account_id", accountID), logger.FError(err))
+		switch err {
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusGone, dto.ModelNotFoundError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	account := converter.ConvertModel2AccountResponse(accountModel)
+	successResponse(ctx, http.StatusOK, account)
+}
+
 // DeleteMy godoc
 //
 //	@Summary		Delete my account
@@ -250,7 +637,7 @@ func (a *AccountHandler) DeleteMy(ctx *gin.Context) {
 		failResponse(ctx, http.StatusBadRequest, err, nil)
 		return
 	}
-	if err := a.accountUsecase.DeleteAccount(ctx, *accountID); err != nil {
+	if err := a.accountUsecase.DeleteAccount(ctx, *accountID, getAuditSource(ctx, accountID)); err != nil {
 		log.Error("fail to process delete account", logger.FError(err))
 		switch err {
 		case model.EntityNotFoundError:
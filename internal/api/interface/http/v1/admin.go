@@ -0,0 +1,662 @@
+package v1
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/api/interface/http/v1/converter"
+	"go-tonify-backend/internal/api/interface/http/validator"
+	"go-tonify-backend/internal/container"
+	accountModel "go-tonify-backend/internal/domain/account/model"
+	accountUsecase "go-tonify-backend/internal/domain/account/usecase"
+	"go-tonify-backend/internal/domain/staff/model"
+	"go-tonify-backend/internal/domain/staff/usecase"
+	"go-tonify-backend/pkg/logger"
+	"net/http"
+	"time"
+)
+
+type AdminHandler struct {
+	container           container.Container
+	validation          validator.HttpValidator
+	staffUsecase        usecase.Staff
+	sanctionUsecase     accountUsecase.Sanction
+	verificationUsecase accountUsecase.Verification
+	auditUsecase        accountUsecase.Audit
+	reportUsecase       accountUsecase.Report
+}
+
+func NewAdminHandler(
+	container container.Container,
+	validation validator.HttpValidator,
+	staffUsecase usecase.Staff,
+	sanctionUsecase accountUsecase.Sanction,
+	verificationUsecase accountUsecase.Verification,
+	auditUsecase accountUsecase.Audit,
+	reportUsecase accountUsecase.Report,
+) *AdminHandler {
+	return &AdminHandler{
+		container:           container,
+		validation:          validation,
+		staffUsecase:        staffUsecase,
+		sanctionUsecase:     sanctionUsecase,
+		verificationUsecase: verificationUsecase,
+		auditUsecase:        auditUsecase,
+		reportUsecase:       reportUsecase,
+	}
+}
+
+// GetMyStaffRoles godoc
+//
+//	@Summary		Get my staff roles
+//	@Description	Get the staff roles and permissions of the authenticated user's account
+//	@Tags			admin
+//	@Produce		json
+//	@Param			Authorization	header		string									true	"account's access token"
+//	@Success		200				{object}	dto.Response{response=[]dto.StaffRole}	"staff roles"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}		"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}		"the account is not a staff member"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Router			/v1/admin/staff/my [get]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) GetMyStaffRoles(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	staffRoleModels, err := a.staffUsecase.GetAccountRoles(ctx, *accountID)
+	if err != nil {
+		log.Error("fail to get staff roles of account", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	staffRoles := converter.ConvertModels2StaffRolesResponse(staffRoleModels)
+	successResponse(ctx, http.StatusOK, staffRoles)
+}
+
+// GetStaffRoles godoc
+//
+//	@Summary		Get staff roles
+//	@Description	Get all staff roles with their permissions. Requires the staff:manage permission
+//	@Tags			admin
+//	@Produce		json
+//	@Param			Authorization	header		string									true	"account's access token"
+//	@Success		200				{object}	dto.Response{response=[]dto.StaffRole}	"staff roles"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}		"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}		"the account lacks the required permission"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Router			/v1/admin/staff/roles [get]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) GetStaffRoles(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	staffRoleModels, err := a.staffUsecase.GetRoles(ctx)
+	if err != nil {
+		log.Error("fail to get staff roles", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	staffRoles := converter.ConvertModels2StaffRolesResponse(staffRoleModels)
+	successResponse(ctx, http.StatusOK, staffRoles)
+}
+
+// AssignStaffRole godoc
+//
+//	@Summary		Assign a staff role
+//	@Description	Grant a staff role to an account. Requires the staff:manage permission
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			request			body		dto.ChangeStaffRole					true	"account and staff role"
+//	@Success		200				{object}	dto.Response{response=string}		"returns ok string"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}	"the account lacks the required permission"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}	"account does not exist"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/admin/staff/roles/assign [post]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) AssignStaffRole(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	var changeStaffRoleRequest dto.ChangeStaffRole
+	if err := ctx.ShouldBindJSON(&changeStaffRoleRequest); err != nil {
+		log.Error("fail to parse/validate request model", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	err := a.staffUsecase.AssignRole(ctx, changeStaffRoleRequest.AccountID, changeStaffRoleRequest.Role)
+	if err != nil {
+		log.Error("fail to assign staff role", logger.FError(err))
+		a.changeStaffRoleFailResponse(ctx, err)
+		return
+	}
+	successResponse(ctx, http.StatusOK, "ok")
+}
+
+// RevokeStaffRole godoc
+//
+//	@Summary		Revoke a staff role
+//	@Description	Take a staff role away from an account. Requires the staff:manage permission
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			request			body		dto.ChangeStaffRole					true	"account and staff role"
+//	@Success		200				{object}	dto.Response{response=string}		"returns ok string"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}	"the account lacks the required permission"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/admin/staff/roles/revoke [post]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) RevokeStaffRole(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	var changeStaffRoleRequest dto.ChangeStaffRole
+	if err := ctx.ShouldBindJSON(&changeStaffRoleRequest); err != nil {
+		log.Error("fail to parse/validate request model", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	err := a.staffUsecase.RevokeRole(ctx, changeStaffRoleRequest.AccountID, changeStaffRoleRequest.Role)
+	if err != nil {
+		log.Error("fail to revoke staff role", logger.FError(err))
+		a.changeStaffRoleFailResponse(ctx, err)
+		return
+	}
+	successResponse(ctx, http.StatusOK, "ok")
+}
+
+// SuspendAccount godoc
+//
+//	@Summary		Suspend an account
+//	@Description	Suspend an account until the given time. A suspended account can't sign in or call the api and is hidden from matching. Requires the account:ban permission
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			id				path		int									true	"account id"
+//	@Param			request			body		dto.SuspendAccount					true	"suspension end and reason"
+//	@Success		200				{object}	dto.Response{response=string}		"returns ok string"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}	"the account lacks the required permission or the target is staff of equal or higher rank"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}	"account does not exist"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/admin/accounts/{id}/suspend [post]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) SuspendAccount(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	staffAccountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var uriAccount dto.URIAccount
+	if err := ctx.ShouldBindUri(&uriAccount); err != nil {
+		log.Error("fail to bind uri account", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	var suspendAccountRequest dto.SuspendAccount
+	if err := ctx.ShouldBindJSON(&suspendAccountRequest); err != nil {
+		log.Error("fail to parse/validate request model", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	err = a.sanctionUsecase.Suspend(
+		ctx,
+		*staffAccountID,
+		uriAccount.ID,
+		time.Time(suspendAccountRequest.Until),
+		suspendAccountRequest.Reason,
+	)
+	if err != nil {
+		log.Error("fail to suspend account", logger.FError(err))
+		a.sanctionFailResponse(ctx, err)
+		return
+	}
+	successResponse(ctx, http.StatusOK, "ok")
+}
+
+// BanAccount godoc
+//
+//	@Summary		Ban an account
+//	@Description	Ban an account until the ban is lifted. A banned account can't sign in or call the api and is hidden from matching. Requires the account:ban permission
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			id				path		int									true	"account id"
+//	@Param			request			body		dto.SanctionReason					true	"ban reason"
+//	@Success		200				{object}	dto.Response{response=string}		"returns ok string"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}	"the account lacks the required permission or the target is staff of equal or higher rank"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}	"account does not exist"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/admin/accounts/{id}/ban [post]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) BanAccount(ctx *gin.Context) {
+	a.applyReasonSanction(ctx, a.sanctionUsecase.Ban)
+}
+
+// LiftSanction godoc
+//
+//	@Summary		Lift a sanction
+//	@Description	Lift the active suspension or ban of an account. Requires the account:ban permission
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			id				path		int									true	"account id"
+//	@Param			request			body		dto.SanctionReason					true	"lift reason"
+//	@Success		200				{object}	dto.Response{response=string}		"returns ok string"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}	"the account lacks the required permission or the target is staff of equal or higher rank"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}	"account does not exist"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/admin/accounts/{id}/lift [post]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) LiftSanction(ctx *gin.Context) {
+	a.applyReasonSanction(ctx, a.sanctionUsecase.Lift)
+}
+
+// GetSanctions godoc
+//
+//	@Summary		Get sanctions of an account
+//	@Description	Get the audit trail of sanctions applied to and lifted from an account, newest first. Requires the account:ban permission
+//	@Tags			admin
+//	@Produce		json
+//	@Param			Authorization	header		string												true	"account's access token"
+//	@Param			id				path		int													true	"account id"
+//	@Param			request			query		dto.GetSanctions									true	"pagination"
+//	@Success		200				{object}	dto.Response{response=dto.Pagination{data=[]dto.Sanction}}	"sanctions"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}					"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}					"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}					"the account lacks the required permission"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}					"detailed error message"
+//	@Router			/v1/admin/accounts/{id}/sanctions [get]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) GetSanctions(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	var uriAccount dto.URIAccount
+	if err := ctx.ShouldBindUri(&uriAccount); err != nil {
+		log.Error("fail to bind uri account", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	var getSanctions dto.GetSanctions
+	if err := ctx.ShouldBindQuery(&getSanctions); err != nil {
+		log.Error("fail to bind get sanctions", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	paginationModel, err := a.sanctionUsecase.GetSanctions(ctx, uriAccount.ID, getSanctions.Offset, getSanctions.Limit)
+	if err != nil {
+		log.Error("fail to get account sanctions", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	pagination := dto.Pagination{
+		Offset: paginationModel.Offset,
+		Limit:  paginationModel.Limit,
+		Total:  paginationModel.Total,
+		Data:   converter.ConvertModels2SanctionsResponse(paginationModel.Data),
+	}
+	successResponse(ctx, http.StatusOK, pagination)
+}
+
+// GetAccountAudit godoc
+//
+//	@Summary		Get change history of an account
+//	@Description	Get the audit log of an account: creation, edits, role changes and deletion with the changed fields, the actor and the source ip, newest first.
+//	@Description	Requires the account:audit permission
+//	@Tags			admin
+//	@Produce		json
+//	@Param			Authorization	header		string													true	"account's access token"
+//	@Param			id				path		int														true	"account id"
+//	@Param			request			query		dto.GetAccountAudit										true	"pagination"
+//	@Success		200				{object}	dto.Response{response=dto.Pagination{data=[]dto.AccountAudit}}	"audit log entries"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}						"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}						"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}						"the account lacks the required permission"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}						"detailed error message"
+//	@Router			/v1/admin/accounts/{id}/audit [get]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) GetAccountAudit(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	var uriAccount dto.URIAccount
+	if err := ctx.ShouldBindUri(&uriAccount); err != nil {
+		log.Error("fail to bind uri account", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	var getAccountAudit dto.GetAccountAudit
+	if err := ctx.ShouldBindQuery(&getAccountAudit); err != nil {
+		log.Error("fail to bind get account audit", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	paginationModel, err := a.auditUsecase.GetAccountAudit(ctx, uriAccount.ID, getAccountAudit.Offset, getAccountAudit.Limit)
+	if err != nil {
+		log.Error("fail to get account audit", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	pagination := dto.Pagination{
+		Offset: paginationModel.Offset,
+		Limit:  paginationModel.Limit,
+		Total:  paginationModel.Total,
+		Data:   converter.ConvertModels2AuditsResponse(paginationModel.Data),
+	}
+	successResponse(ctx, http.StatusOK, pagination)
+}
+
+// GetVerificationQueue godoc
+//
+//	@Summary		Get the verification queue
+//	@Description	Get the accounts whose identity document waits for a review with the document attachment, the oldest submission first. Requires the account:verify permission
+//	@Tags			admin
+//	@Produce		json
+//	@Param			Authorization	header		string												true	"account's access token"
+//	@Param			request			query		dto.GetVerifications								true	"pagination"
+//	@Success		200				{object}	dto.Response{response=dto.Pagination{data=[]dto.Account}}	"accounts waiting for a review"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}					"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}					"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}					"the account lacks the required permission"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}					"detailed error message"
+//	@Router			/v1/admin/verifications [get]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) GetVerificationQueue(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	var getVerifications dto.GetVerifications
+	if err := ctx.ShouldBindQuery(&getVerifications); err != nil {
+		log.Error("fail to bind get verifications", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	paginationModel, err := a.verificationUsecase.GetQueue(ctx, getVerifications.Offset, getVerifications.Limit)
+	if err != nil {
+		log.Error("fail to get verification queue", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	pagination := dto.Pagination{
+		Offset: paginationModel.Offset,
+		Limit:  paginationModel.Limit,
+		Total:  paginationModel.Total,
+		Data:   converter.ConvertModels2AccountResponses(paginationModel.Data),
+	}
+	successResponse(ctx, http.StatusOK, pagination)
+}
+
+// ApproveVerification godoc
+//
+//	@Summary		Approve a verification
+//	@Description	Approve the pending identity document of an account, the account gets the verified badge. Requires the account:verify permission
+//	@Tags			admin
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			id				path		int									true	"account id"
+//	@Success		200				{object}	dto.Response{response=string}		"returns ok string"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}	"the account lacks the required permission"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}	"account does not exist"
+//	@Failure		409				{object}	dto.Response{response=dto.Empty}	"the verification is not pending"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/admin/accounts/{id}/verification/approve [post]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) ApproveVerification(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	staffAccountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var uriAccount dto.URIAccount
+	if err := ctx.ShouldBindUri(&uriAccount); err != nil {
+		log.Error("fail to bind uri account", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	if err := a.verificationUsecase.Approve(ctx, *staffAccountID, uriAccount.ID); err != nil {
+		log.Error("fail to approve verification", logger.FError(err))
+		a.verificationFailResponse(ctx, err)
+		return
+	}
+	successResponse(ctx, http.StatusOK, "ok")
+}
+
+// RejectVerification godoc
+//
+//	@Summary		Reject a verification
+//	@Description	Reject the pending identity document of an account with a reason shown to the account owner, a new document upload puts the account back in the queue. Requires the account:verify permission
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			id				path		int									true	"account id"
+//	@Param			request			body		dto.VerificationRejection			true	"rejection reason"
+//	@Success		200				{object}	dto.Response{response=string}		"returns ok string"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}	"the account lacks the required permission"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}	"account does not exist"
+//	@Failure		409				{object}	dto.Response{response=dto.Empty}	"the verification is not pending"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/admin/accounts/{id}/verification/reject [post]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) RejectVerification(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	staffAccountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var uriAccount dto.URIAccount
+	if err := ctx.ShouldBindUri(&uriAccount); err != nil {
+		log.Error("fail to bind uri account", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	var verificationRejection dto.VerificationRejection
+	if err := ctx.ShouldBindJSON(&verificationRejection); err != nil {
+		log.Error("fail to parse/validate request model", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	if err := a.verificationUsecase.Reject(ctx, *staffAccountID, uriAccount.ID, verificationRejection.Reason); err != nil {
+		log.Error("fail to reject verification", logger.FError(err))
+		a.verificationFailResponse(ctx, err)
+		return
+	}
+	successResponse(ctx, http.StatusOK, "ok")
+}
+
+// GetReportQueue godoc
+//
+//	@Summary		Get the report queue
+//	@Description	Get the pending reports with their attachments, the oldest report first. Requires the report:review permission
+//	@Tags			admin
+//	@Produce		json
+//	@Param			Authorization	header		string												true	"account's access token"
+//	@Param			request			query		dto.GetReports										true	"pagination"
+//	@Success		200				{object}	dto.Response{response=dto.Pagination{data=[]dto.Report}}	"reports waiting for a review"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}					"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}					"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}					"the account lacks the required permission"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}					"detailed error message"
+//	@Router			/v1/admin/reports [get]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) GetReportQueue(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	var getReports dto.GetReports
+	if err := ctx.ShouldBindQuery(&getReports); err != nil {
+		log.Error("fail to bind get reports", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	paginationModel, err := a.reportUsecase.GetQueue(ctx, getReports.Offset, getReports.Limit)
+	if err != nil {
+		log.Error("fail to get report queue", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	pagination := dto.Pagination{
+		Offset: paginationModel.Offset,
+		Limit:  paginationModel.Limit,
+		Total:  paginationModel.Total,
+		Data:   converter.ConvertModels2ReportsResponse(paginationModel.Data),
+	}
+	successResponse(ctx, http.StatusOK, pagination)
+}
+
+// ResolveReport godoc
+//
+//	@Summary		Resolve a report
+//	@Description	Close a pending report as acted upon, a sanction is applied separately. Requires the report:review permission
+//	@Tags			admin
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			id				path		int									true	"report id"
+//	@Success		200				{object}	dto.Response{response=string}		"returns ok string"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}	"the account lacks the required permission"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}	"report does not exist"
+//	@Failure		409				{object}	dto.Response{response=dto.Empty}	"the report is not pending"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/admin/reports/{id}/resolve [post]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) ResolveReport(ctx *gin.Context) {
+	a.reviewReport(ctx, a.reportUsecase.Resolve)
+}
+
+// DismissReport godoc
+//
+//	@Summary		Dismiss a report
+//	@Description	Close a pending report without an action. Requires the report:review permission
+//	@Tags			admin
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			id				path		int									true	"report id"
+//	@Success		200				{object}	dto.Response{response=string}		"returns ok string"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}	"the account lacks the required permission"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}	"report does not exist"
+//	@Failure		409				{object}	dto.Response{response=dto.Empty}	"the report is not pending"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/admin/reports/{id}/dismiss [post]
+//	@Security		ApiKeyAuth
+func (a *AdminHandler) DismissReport(ctx *gin.Context) {
+	a.reviewReport(ctx, a.reportUsecase.Dismiss)
+}
+
+func (a *AdminHandler) reviewReport(
+	ctx *gin.Context,
+	review func(ctx context.Context, staffAccountID int64, id int64) error,
+) {
+	log := a.container.GetLogger()
+	staffAccountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var uriReport dto.URIReport
+	if err := ctx.ShouldBindUri(&uriReport); err != nil {
+		log.Error("fail to bind uri report", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	if err := review(ctx, *staffAccountID, uriReport.ID); err != nil {
+		log.Error("fail to review report", logger.FError(err))
+		switch err {
+		case accountModel.EntityNotFoundError:
+			failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+		case accountModel.ReportNotPendingError:
+			failResponse(ctx, http.StatusConflict, dto.ReportNotPendingError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	successResponse(ctx, http.StatusOK, "ok")
+}
+
+func (a *AdminHandler) applyReasonSanction(
+	ctx *gin.Context,
+	apply func(ctx context.Context, staffAccountID int64, accountID int64, reason string) error,
+) {
+	log := a.container.GetLogger()
+	staffAccountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var uriAccount dto.URIAccount
+	if err := ctx.ShouldBindUri(&uriAccount); err != nil {
+		log.Error("fail to bind uri account", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	var sanctionReasonRequest dto.SanctionReason
+	if err := ctx.ShouldBindJSON(&sanctionReasonRequest); err != nil {
+		log.Error("fail to parse/validate request model", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	if err := apply(ctx, *staffAccountID, uriAccount.ID, sanctionReasonRequest.Reason); err != nil {
+		log.Error("fail to apply sanction", logger.FError(err))
+		a.sanctionFailResponse(ctx, err)
+		return
+	}
+	successResponse(ctx, http.StatusOK, "ok")
+}
+
+func (a *AdminHandler) sanctionFailResponse(ctx *gin.Context, err error) {
+	switch err {
+	case accountModel.InvalidSanctionError:
+		failResponse(ctx, http.StatusBadRequest, dto.InvalidSanctionError, err)
+	case accountModel.SanctionStaffRankError:
+		failResponse(ctx, http.StatusForbidden, dto.SanctionStaffRankError, err)
+	case accountModel.EntityNotFoundError:
+		failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+	default:
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+	}
+}
+
+func (a *AdminHandler) verificationFailResponse(ctx *gin.Context, err error) {
+	switch err {
+	case accountModel.InvalidVerificationReviewError:
+		failResponse(ctx, http.StatusBadRequest, dto.InvalidVerificationReviewError, err)
+	case accountModel.EntityNotFoundError:
+		failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+	case accountModel.VerificationNotPendingError:
+		failResponse(ctx, http.StatusConflict, dto.VerificationNotPendingError, err)
+	default:
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+	}
+}
+
+func (a *AdminHandler) changeStaffRoleFailResponse(ctx *gin.Context, err error) {
+	switch err {
+	case model.UnknownStaffRoleError:
+		failResponse(ctx, http.StatusBadRequest, dto.UnknownStaffRoleError, err)
+	case model.EntityNotFoundError:
+		failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+	default:
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+	}
+}
@@ -17,17 +17,20 @@ type AuthHandler struct {
 	container      container.Container
 	validation     validator.HttpValidator
 	accountUsecase usecase.Account
+	sessionUsecase usecase.Session
 }
 
 func NewAuthHandler(
 	container container.Container,
 	validation validator.HttpValidator,
 	accountUsecase usecase.Account,
+	sessionUsecase usecase.Session,
 ) *AuthHandler {
 	return &AuthHandler{
 		container:      container,
 		validation:     validation,
 		accountUsecase: accountUsecase,
+		sessionUsecase: sessionUsecase,
 	}
 }
 
@@ -56,6 +59,7 @@ func NewAuthHandler(
 //	@Param			document			formData	file									false	"document file"
 //	@Success		201					{object}	dto.Response{response=dto.PairToken}	"access & refresh tokens"
 //	@Failure		400					{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Failure		401					{object}	dto.Response{response=dto.Empty}		"telegram init data is invalid or has expired"
 //	@Failure		409					{object}	dto.Response{response=dto.Empty}		"detailed error message, provided data already exist"
 //	@Failure		500					{object}	dto.Response{response=dto.Empty}		"detailed error message"
 //	@Router			/v1/auth/sign-up [post]
@@ -107,13 +111,21 @@ func (a *AuthHandler) SignUp(ctx *gin.Context) {
 		AvatarFileHeader:   avatarFileHeader,
 		DocumentFileHeader: documentFileHeader,
 	}
-	accountID, err := a.accountUsecase.CreateAccount(ctx, createAccount)
+	accountID, err := a.accountUsecase.CreateAccount(ctx, createAccount, getAuditSource(ctx, nil))
 	if err != nil {
 		switch err {
 		case model.NilError:
 			failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
 		case model.DuplicateAccountWithTelegramIDError:
 			failResponse(ctx, http.StatusConflict, dto.DuplicateAccountWithTelegramIDError, err)
+		case model.RestorableAccountError:
+			failResponse(ctx, http.StatusConflict, dto.RestorableAccountError, err)
+		case model.NicknameUnavailableError:
+			failResponse(ctx, http.StatusConflict, dto.NicknameUnavailableError, err)
+		case model.DecodeTelegramInitDataError, model.InvalidTelegramInitDataError:
+			failResponse(ctx, http.StatusUnauthorized, dto.InvalidTelegramInitDataError, err)
+		case model.ExpiredTelegramInitDataError:
+			failResponse(ctx, http.StatusUnauthorized, dto.ExpiredTelegramInitDataError, err)
 		default:
 			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
 		}
@@ -124,7 +136,7 @@ func (a *AuthHandler) SignUp(ctx *gin.Context) {
 		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
 		return
 	}
-	pairTokenModel, err := a.accountUsecase.GeneratePairToken(*accountID)
+	pairTokenModel, err := a.accountUsecase.GeneratePairToken(ctx, *accountID, getDevice(ctx))
 	if err != nil {
 		log.Error("fail to generate pair token", logger.FError(err))
 		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
@@ -149,6 +161,9 @@ func (a *AuthHandler) SignUp(ctx *gin.Context) {
 //	@Param			request	body		dto.Credential							true	"credential"
 //	@Success		200		{object}	dto.Response{response=dto.PairToken}	"pair token"
 //	@Failure		400		{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Failure		401		{object}	dto.Response{response=dto.Empty}		"telegram init data is invalid or has expired"
+//	@Failure		409		{object}	dto.Response{response=dto.Empty}		"account has been deleted and can be restored"
+//	@Failure		403		{object}	dto.Response{response=dto.Empty}		"account is suspended or banned"
 //	@Failure		410		{object}	dto.Response{response=dto.Empty}	"account does not exist or has been deleted"
 //	@Failure		500		{object}	dto.Response{response=dto.Empty}		"detailed error message"
 //	@Router			/v1/auth/sign-in [post]
@@ -166,6 +181,16 @@ func (a *AuthHandler) SignIn(ctx *gin.Context) {
 		switch err {
 		case model.EntityNotFoundError:
 			failResponse(ctx, http.StatusGone, dto.ModelNotFoundError, err)
+		case model.RestorableAccountError:
+			failResponse(ctx, http.StatusConflict, dto.RestorableAccountError, err)
+		case model.AccountSuspendedError:
+			failResponse(ctx, http.StatusForbidden, dto.AccountSuspendedError, err)
+		case model.AccountBannedError:
+			failResponse(ctx, http.StatusForbidden, dto.AccountBannedError, err)
+		case model.DecodeTelegramInitDataError, model.InvalidTelegramInitDataError:
+			failResponse(ctx, http.StatusUnauthorized, dto.InvalidTelegramInitDataError, err)
+		case model.ExpiredTelegramInitDataError:
+			failResponse(ctx, http.StatusUnauthorized, dto.ExpiredTelegramInitDataError, err)
 		default:
 			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
 		}
@@ -176,7 +201,7 @@ func (a *AuthHandler) SignIn(ctx *gin.Context) {
 		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
 		return
 	}
-	pairTokenModel, err := a.accountUsecase.GeneratePairToken(*accountID)
+	pairTokenModel, err := a.accountUsecase.GeneratePairToken(ctx, *accountID, getDevice(ctx))
 	if err != nil {
 		log.Error("fail to generate pair token", logger.FError(err))
 		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
@@ -190,3 +215,227 @@ func (a *AuthHandler) SignIn(ctx *gin.Context) {
 	pairToken := converter.ConvertModel2PairTokenResponse(*pairTokenModel)
 	successResponse(ctx, http.StatusOK, pairToken)
 }
+
+// SignInWidget godoc
+//
+//	@Summary		Sign in an existing user with the Telegram Login Widget
+//	@Description	The web client provides the payload received from the Telegram Login Widget. If the payload is valid, access and refresh tokens of the account with the same telegram id are returned
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.WidgetCredential					true	"telegram login widget payload"
+//	@Success		200		{object}	dto.Response{response=dto.PairToken}	"pair token"
+//	@Failure		400		{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Failure		401		{object}	dto.Response{response=dto.Empty}		"telegram login widget payload is invalid or has expired"
+//	@Failure		409		{object}	dto.Response{response=dto.Empty}		"account has been deleted and can be restored"
+//	@Failure		403		{object}	dto.Response{response=dto.Empty}		"account is suspended or banned"
+//	@Failure		410		{object}	dto.Response{response=dto.Empty}		"account does not exist or has been deleted"
+//	@Failure		500		{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Router			/v1/auth/sign-in/widget [post]
+func (a *AuthHandler) SignInWidget(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	var widgetCredentialRequest dto.WidgetCredential
+	if err := ctx.ShouldBindJSON(&widgetCredentialRequest); err != nil {
+		log.Error("fail to parse/validate request model", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	telegramLoginWidget := model.TelegramLoginWidget{
+		ID:        widgetCredentialRequest.ID,
+		FirstName: widgetCredentialRequest.FirstName,
+		LastName:  widgetCredentialRequest.LastName,
+		Username:  widgetCredentialRequest.Username,
+		PhotoURL:  widgetCredentialRequest.PhotoURL,
+		AuthDate:  widgetCredentialRequest.AuthDate,
+		Hash:      widgetCredentialRequest.Hash,
+	}
+	accountID, err := a.accountUsecase.AuthenticationTelegramWidget(ctx, telegramLoginWidget)
+	if err != nil {
+		log.Error("fail to authentication account by telegram login widget", logger.FError(err))
+		switch err {
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusGone, dto.ModelNotFoundError, err)
+		case model.RestorableAccountError:
+			failResponse(ctx, http.StatusConflict, dto.RestorableAccountError, err)
+		case model.AccountSuspendedError:
+			failResponse(ctx, http.StatusForbidden, dto.AccountSuspendedError, err)
+		case model.AccountBannedError:
+			failResponse(ctx, http.StatusForbidden, dto.AccountBannedError, err)
+		case model.InvalidTelegramInitDataError:
+			failResponse(ctx, http.StatusUnauthorized, dto.InvalidTelegramLoginWidgetError, err)
+		case model.ExpiredTelegramInitDataError:
+			failResponse(ctx, http.StatusUnauthorized, dto.ExpiredTelegramLoginWidgetError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	if accountID == nil {
+		log.Error("account id has nil value", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
+		return
+	}
+	pairTokenModel, err := a.accountUsecase.GeneratePairToken(ctx, *accountID, getDevice(ctx))
+	if err != nil {
+		log.Error("fail to generate pair token", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
+		return
+	}
+	if pairTokenModel == nil {
+		log.Error("pair token has nil value")
+		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
+		return
+	}
+	pairToken := converter.ConvertModel2PairTokenResponse(*pairTokenModel)
+	successResponse(ctx, http.StatusOK, pairToken)
+}
+
+// Restore godoc
+//
+//	@Summary		Restore a deleted account
+//	@Description	Restore the account deleted by the user while the deletion grace period lasts. If successful, access and refresh tokens are returned
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.Credential							true	"credential"
+//	@Success		200		{object}	dto.Response{response=dto.PairToken}	"pair token"
+//	@Failure		400		{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Failure		401		{object}	dto.Response{response=dto.Empty}		"telegram init data is invalid or has expired"
+//	@Failure		404		{object}	dto.Response{response=dto.Empty}		"there is no deleted account"
+//	@Failure		409		{object}	dto.Response{response=dto.Empty}		"the nickname or the wallet of the account has been taken meanwhile"
+//	@Failure		410		{object}	dto.Response{response=dto.Empty}		"the restore period has expired"
+//	@Failure		500		{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Router			/v1/auth/restore [post]
+func (a *AuthHandler) Restore(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	var credentialRequest dto.Credential
+	if err := ctx.ShouldBindJSON(&credentialRequest); err != nil {
+		log.Error("fail to parse/validate request model", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	accountID, err := a.accountUsecase.RestoreAccount(ctx, credentialRequest.TelegramInitData)
+	if err != nil {
+		log.Error("fail to restore account", logger.FError(err))
+		switch err {
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+		case model.RestorePeriodExpiredError:
+			failResponse(ctx, http.StatusGone, dto.RestorePeriodExpiredError, err)
+		case model.RestoreNicknameTakenError:
+			failResponse(ctx, http.StatusConflict, dto.RestoreNicknameTakenError, err)
+		case model.RestoreWalletTakenError:
+			failResponse(ctx, http.StatusConflict, dto.RestoreWalletTakenError, err)
+		case model.DecodeTelegramInitDataError, model.InvalidTelegramInitDataError:
+			failResponse(ctx, http.StatusUnauthorized, dto.InvalidTelegramInitDataError, err)
+		case model.ExpiredTelegramInitDataError:
+			failResponse(ctx, http.StatusUnauthorized, dto.ExpiredTelegramInitDataError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	if accountID == nil {
+		log.Error("account id has nil value", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
+		return
+	}
+	pairTokenModel, err := a.accountUsecase.GeneratePairToken(ctx, *accountID, getDevice(ctx))
+	if err != nil {
+		log.Error("fail to generate pair token", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
+		return
+	}
+	if pairTokenModel == nil {
+		log.Error("pair token has nil value")
+		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
+		return
+	}
+	pairToken := converter.ConvertModel2PairTokenResponse(*pairTokenModel)
+	successResponse(ctx, http.StatusOK, pairToken)
+}
+
+// Refresh godoc
+//
+//	@Summary		Refresh a pair token
+//	@Description	Exchange a refresh token for a new access / refresh pair. Every refresh token can be used only once.
+//	@Description	Presenting an already used refresh token revokes all tokens issued from the same sign in
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.RefreshToken						true	"refresh token"
+//	@Success		200		{object}	dto.Response{response=dto.PairToken}	"pair token"
+//	@Failure		400		{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Failure		401		{object}	dto.Response{response=dto.Empty}		"the refresh token is invalid/expired/already used"
+//	@Failure		403		{object}	dto.Response{response=dto.Empty}		"account is suspended or banned"
+//	@Failure		410		{object}	dto.Response{response=dto.Empty}		"account does not exist or has been deleted"
+//	@Failure		500		{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Router			/v1/auth/refresh [post]
+func (a *AuthHandler) Refresh(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	var refreshTokenRequest dto.RefreshToken
+	if err := ctx.ShouldBindJSON(&refreshTokenRequest); err != nil {
+		log.Error("fail to parse/validate request model", logger.FError(err))
+		badRequestResponse(ctx, a.validation, dto.BadRequestError, err)
+		return
+	}
+	pairTokenModel, err := a.accountUsecase.RefreshPairToken(ctx, refreshTokenRequest.RefreshToken)
+	if err != nil {
+		log.Error("fail to refresh pair token", logger.FError(err))
+		switch err {
+		case model.InvalidRefreshTokenError, model.RefreshTokenReusedError:
+			failResponse(ctx, http.StatusUnauthorized, dto.InvalidRefreshTokenError, err)
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusGone, dto.ModelNotFoundError, err)
+		case model.AccountSuspendedError:
+			failResponse(ctx, http.StatusForbidden, dto.AccountSuspendedError, err)
+		case model.AccountBannedError:
+			failResponse(ctx, http.StatusForbidden, dto.AccountBannedError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	if pairTokenModel == nil {
+		log.Error("pair token has nil value")
+		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
+		return
+	}
+	pairToken := converter.ConvertModel2PairTokenResponse(*pairTokenModel)
+	successResponse(ctx, http.StatusOK, pairToken)
+}
+
+// Logout godoc
+//
+//	@Summary		Log out
+//	@Description	End the session of the provided access token. Access and refresh tokens of the session stop working
+//	@Tags			auth
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Success		200				{object}	dto.Response{response=string}		"returns ok string"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/auth/logout [post]
+//	@Security		ApiKeyAuth
+func (a *AuthHandler) Logout(ctx *gin.Context) {
+	log := a.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	sessionID, err := getSessionID(ctx)
+	if err != nil {
+		log.Error("fail to get session id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	if err := a.sessionUsecase.RevokeSession(ctx, *accountID, *sessionID); err != nil {
+		log.Error("fail to revoke session", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	successResponse(ctx, http.StatusOK, "ok")
+}
@@ -6,29 +6,54 @@ import (
 	"go-tonify-backend/pkg/datetime"
 )
 
+// ConvertModel2AccountResponse leaves fields hidden by the account privacy settings as null,
+// an account without an audience is shown as to a stranger.
 func ConvertModel2AccountResponse(accountModel *model.Account) *dto.Account {
 	account := dto.Account{
-		ID:         accountModel.ID,
-		TelegramID: accountModel.TelegramID,
-		FirstName:  accountModel.FirstName,
-		MiddleName: accountModel.MiddleName,
-		LastName:   accountModel.LastName,
-		Role:       accountModel.Role,
-		Nickname:   accountModel.Nickname,
-		AboutMe:    accountModel.AboutMe,
-		Gender:     accountModel.Gender,
-		Country:    accountModel.Country,
-		Location:   accountModel.Location,
+		ID:            accountModel.ID,
+		FirstName:     accountModel.FirstName,
+		MiddleName:    accountModel.MiddleName,
+		Role:          accountModel.Role,
+		Nickname:      accountModel.Nickname,
+		AboutMe:       accountModel.AboutMe,
+		Gender:        accountModel.Gender,
+		Country:       accountModel.Country,
+		Verified:      accountModel.VerificationStatus == model.VerifiedVerificationStatus,
+		WalletAddress: accountModel.WalletAddress,
+	}
+	privacy := accountModel.Privacy
+	if privacy == nil {
+		defaultPrivacy := model.DefaultPrivacy()
+		privacy = &defaultPrivacy
+	}
+	visible := func(visibility model.Visibility) bool {
+		return visibility.VisibleTo(accountModel.Audience)
+	}
+	if visible(privacy.TelegramID) {
+		telegramID := accountModel.TelegramID
+		account.TelegramID = &telegramID
+	}
+	if visible(privacy.LastName) {
+		lastName := accountModel.LastName
+		account.LastName = &lastName
+	}
+	if visible(privacy.Location) {
+		account.Location = accountModel.Location
+	}
+	if accountModel.Audience == model.OwnerAudience || accountModel.Audience == model.StaffAudience {
+		verificationStatus := string(accountModel.VerificationStatus)
+		account.VerificationStatus = &verificationStatus
+		account.VerificationRejectionReason = accountModel.VerificationReason
 	}
 	if createdAt := accountModel.CreatedAt; createdAt != nil {
 		dt := datetime.Datetime(*createdAt)
 		account.CreatedAt = &dt
 	}
-	if updatedAt := accountModel.CreatedAt; updatedAt != nil {
+	if updatedAt := accountModel.UpdatedAt; updatedAt != nil {
 		dt := datetime.Datetime(*updatedAt)
-		account.CreatedAt = &dt
+		account.UpdatedAt = &dt
 	}
-	if accountModel.Company != nil {
+	if accountModel.Company != nil && visible(privacy.Company) {
 		company := ConvertModel2CompanyResponse(accountModel.Company)
 		account.Company = company
 	}
@@ -36,10 +61,18 @@ func ConvertModel2AccountResponse(accountModel *model.Account) *dto.Account {
 		avatarAttachment := ConvertModel2AttachmentResponse(accountModel.AvatarAttachment)
 		account.AvatarAttachment = avatarAttachment
 	}
-	if accountModel.DocumentAttachment != nil {
+	if accountModel.DocumentAttachment != nil && visible(privacy.DocumentAttachment) {
 		documentAttachment := ConvertModel2AttachmentResponse(accountModel.DocumentAttachment)
 		account.DocumentAttachment = documentAttachment
 	}
+	if accountModel.Portfolio != nil {
+		portfolio := ConvertModels2PortfolioItemResponses(*accountModel.Portfolio)
+		account.Portfolio = &portfolio
+	}
+	if accountModel.Roles != nil {
+		roles := ConvertModels2RolesResponse(*accountModel.Roles)
+		account.Roles = &roles
+	}
 	if accountModel.Tags != nil {
 		tags := ConvertModels2TagsResponse(*accountModel.Tags)
 		account.Tags = &tags
@@ -0,0 +1,101 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/domain/account/model"
+	"testing"
+)
+
+func TestConvertModel2AccountResponsePrivacy(t *testing.T) {
+	location := "Kyiv"
+	privacy := model.Privacy{
+		LastName:           model.EveryoneVisibility,
+		Location:           model.MatchesVisibility,
+		Company:            model.NobodyVisibility,
+		DocumentAttachment: model.MatchesVisibility,
+		TelegramID:         model.NobodyVisibility,
+	}
+	tests := []struct {
+		name           string
+		privacy        *model.Privacy
+		audience       model.Audience
+		expectLocation bool
+		expectCompany  bool
+		expectTelegram bool
+		expectLastName bool
+		expectDocument bool
+	}{
+		{name: "owner sees every field", privacy: nil, audience: model.OwnerAudience, expectLocation: true, expectCompany: true, expectTelegram: true, expectLastName: true, expectDocument: true},
+		{name: "match sees matches fields", privacy: &privacy, audience: model.MatchAudience, expectLocation: true, expectLastName: true, expectDocument: true},
+		{name: "stranger sees everyone fields", privacy: &privacy, audience: model.StrangerAudience, expectLastName: true},
+		{name: "nil privacy falls back to default for stranger", privacy: nil, audience: model.StrangerAudience, expectLocation: true, expectCompany: true, expectLastName: true},
+		{name: "nil privacy and audience falls back to default for stranger", privacy: nil, expectLocation: true, expectCompany: true, expectLastName: true},
+		{name: "staff sees every field", privacy: &privacy, audience: model.StaffAudience, expectLocation: true, expectCompany: true, expectTelegram: true, expectLastName: true, expectDocument: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := ConvertModel2AccountResponse(&model.Account{
+				ID:                 1,
+				TelegramID:         5443222678,
+				LastName:           "Melnyk",
+				Location:           &location,
+				Company:            &model.Company{Name: "Tonify"},
+				DocumentAttachment: &model.Attachment{},
+				Privacy:            tt.privacy,
+				Audience:           tt.audience,
+			})
+			if (account.Location != nil) != tt.expectLocation {
+				t.Error("unexpected location visibility", account.Location)
+			}
+			if (account.Company != nil) != tt.expectCompany {
+				t.Error("unexpected company visibility", account.Company)
+			}
+			if (account.TelegramID != nil) != tt.expectTelegram {
+				t.Error("unexpected telegram id visibility", account.TelegramID)
+			}
+			if (account.LastName != nil) != tt.expectLastName {
+				t.Error("unexpected last name visibility", account.LastName)
+			}
+			if (account.DocumentAttachment != nil) != tt.expectDocument {
+				t.Error("unexpected document attachment visibility", account.DocumentAttachment)
+			}
+		})
+	}
+}
+
+func TestConvertModel2AccountResponseVerification(t *testing.T) {
+	reason := "the document photo is blurry"
+	tests := []struct {
+		name           string
+		status         model.VerificationStatus
+		privacy        *model.Privacy
+		audience       model.Audience
+		expectVerified bool
+		expectStatus   bool
+	}{
+		{name: "owner sees rejection", status: model.RejectedVerificationStatus, privacy: nil, audience: model.OwnerAudience, expectStatus: true},
+		{name: "stranger sees badge only", status: model.VerifiedVerificationStatus, privacy: &model.Privacy{}, audience: model.StrangerAudience, expectVerified: true},
+		{name: "match does not see pending status", status: model.PendingVerificationStatus, privacy: &model.Privacy{}, audience: model.MatchAudience},
+		{name: "stranger without privacy does not see rejection", status: model.RejectedVerificationStatus, privacy: nil, audience: model.StrangerAudience},
+		{name: "staff sees pending status", status: model.PendingVerificationStatus, privacy: nil, audience: model.StaffAudience, expectStatus: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := ConvertModel2AccountResponse(&model.Account{
+				ID:                 1,
+				VerificationStatus: tt.status,
+				VerificationReason: &reason,
+				Privacy:            tt.privacy,
+				Audience:           tt.audience,
+			})
+			if account.Verified != tt.expectVerified {
+				t.Error("unexpected verified badge", account.Verified)
+			}
+			if (account.VerificationStatus != nil) != tt.expectStatus {
+				t.Error("unexpected verification status visibility", account.VerificationStatus)
+			}
+			if (account.VerificationRejectionReason != nil) != tt.expectStatus {
+				t.Error("unexpected rejection reason visibility", account.VerificationRejectionReason)
+			}
+		})
+	}
+}
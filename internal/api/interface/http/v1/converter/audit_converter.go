@@ -0,0 +1,31 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/pkg/datetime"
+)
+
+func ConvertModel2AuditResponse(auditModel *model.AccountAudit) *dto.AccountAudit {
+	audit := dto.AccountAudit{
+		ID:             auditModel.ID,
+		AccountID:      auditModel.AccountID,
+		ActorAccountID: auditModel.ActorAccountID,
+		Action:         string(auditModel.Action),
+		Diff:           auditModel.Diff,
+		SourceIP:       auditModel.SourceIP,
+	}
+	if createdAt := auditModel.CreatedAt; createdAt != nil {
+		dt := datetime.Datetime(*createdAt)
+		audit.CreatedAt = &dt
+	}
+	return &audit
+}
+
+func ConvertModels2AuditsResponse(auditModels []model.AccountAudit) []dto.AccountAudit {
+	var audits = make([]dto.AccountAudit, 0, len(auditModels))
+	for _, auditModel := range auditModels {
+		audits = append(audits, *ConvertModel2AuditResponse(&auditModel))
+	}
+	return audits
+}
@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/pkg/datetime"
+)
+
+func ConvertModel2ExportResponse(exportModel *model.AccountExport) *dto.AccountExport {
+	export := dto.AccountExport{
+		ID:     exportModel.ID,
+		Status: string(exportModel.Status),
+	}
+	if createdAt := exportModel.CreatedAt; createdAt != nil {
+		dt := datetime.Datetime(*createdAt)
+		export.CreatedAt = &dt
+	}
+	if completedAt := exportModel.CompletedAt; completedAt != nil {
+		dt := datetime.Datetime(*completedAt)
+		export.CompletedAt = &dt
+	}
+	if expiresAt := exportModel.ExpiresAt; expiresAt != nil {
+		dt := datetime.Datetime(*expiresAt)
+		export.ExpiresAt = &dt
+	}
+	return &export
+}
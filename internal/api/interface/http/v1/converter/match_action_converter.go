@@ -15,3 +15,12 @@ func ConvertDto2MatchActionModel(matchAction dto.MatchAction) model.MatchAction
 		return model.UnknownMatchAction
 	}
 }
+
+func ConvertModel2MatchActionDto(matchAction model.MatchAction) dto.MatchAction {
+	switch matchAction {
+	case model.LikeMatchAction:
+		return dto.LikeMatchAction
+	default:
+		return dto.DislikeMatchAction
+	}
+}
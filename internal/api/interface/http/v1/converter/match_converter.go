@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/pkg/datetime"
+)
+
+func ConvertModel2MatchResponse(matchModel *model.Match) *dto.Match {
+	match := dto.Match{
+		ID: matchModel.ID,
+	}
+	if matchModel.Account != nil {
+		match.Account = ConvertModel2AccountResponse(matchModel.Account)
+	}
+	if createdAt := matchModel.CreatedAt; createdAt != nil {
+		dt := datetime.Datetime(*createdAt)
+		match.CreatedAt = &dt
+	}
+	return &match
+}
+
+func ConvertModels2MatchesResponse(matchModels []model.Match) []dto.Match {
+	matches := make([]dto.Match, 0, len(matchModels))
+	for _, matchModel := range matchModels {
+		matches = append(matches, *ConvertModel2MatchResponse(&matchModel))
+	}
+	return matches
+}
+
+func ConvertModel2RewindResponse(rewindModel *model.Rewind) *dto.Rewind {
+	rewind := dto.Rewind{
+		Action: ConvertModel2MatchActionDto(rewindModel.Action),
+	}
+	if rewindModel.Account != nil {
+		rewind.Account = ConvertModel2AccountResponse(rewindModel.Account)
+	}
+	return &rewind
+}
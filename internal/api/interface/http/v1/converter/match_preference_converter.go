@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/domain/account/model"
+)
+
+func ConvertModel2MatchPreferenceResponse(preferenceModel *model.MatchPreference) *dto.MatchPreference {
+	return &dto.MatchPreference{
+		Countries:    preferenceModel.Countries,
+		CategoryIDs:  preferenceModel.CategoryIDs,
+		RequiredTags: preferenceModel.RequiredTags,
+		VerifiedOnly: preferenceModel.VerifiedOnly,
+		HasCompany:   preferenceModel.HasCompany,
+	}
+}
+
+func ConvertDto2MatchPreferenceModel(preference *dto.MatchPreference) *model.MatchPreference {
+	return &model.MatchPreference{
+		Countries:    preference.Countries,
+		CategoryIDs:  preference.CategoryIDs,
+		RequiredTags: preference.RequiredTags,
+		VerifiedOnly: preference.VerifiedOnly,
+		HasCompany:   preference.HasCompany,
+	}
+}
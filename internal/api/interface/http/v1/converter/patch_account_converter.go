@@ -0,0 +1,32 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/domain/account/model"
+	commonModel "go-tonify-backend/internal/domain/model"
+)
+
+func ConvertDto2PatchAccountModel(accountID int64, patchAccount *dto.PatchAccount) *model.PatchAccount {
+	patchAccountModel := model.PatchAccount{
+		ID:          accountID,
+		FirstName:   commonModel.Optional[string](patchAccount.FirstName),
+		MiddleName:  commonModel.Optional[string](patchAccount.MiddleName),
+		LastName:    commonModel.Optional[string](patchAccount.LastName),
+		Role:        commonModel.Optional[string](patchAccount.Role),
+		Nickname:    commonModel.Optional[string](patchAccount.Nickname),
+		AboutMe:     commonModel.Optional[string](patchAccount.AboutMe),
+		Gender:      commonModel.Optional[string](patchAccount.Gender),
+		Country:     commonModel.Optional[string](patchAccount.Country),
+		Location:    commonModel.Optional[string](patchAccount.Location),
+		Tags:        commonModel.Optional[[]string](patchAccount.Tags),
+		CategoryIDs: commonModel.Optional[[]int64](patchAccount.CategoryIDs),
+	}
+	patchAccountModel.Company.Set = patchAccount.Company.Set
+	if patchCompany := patchAccount.Company.Value; patchCompany != nil {
+		patchAccountModel.Company.Value = &model.PatchCompany{
+			Name:        commonModel.Optional[string](patchCompany.Name),
+			Description: commonModel.Optional[string](patchCompany.Description),
+		}
+	}
+	return &patchAccountModel
+}
@@ -0,0 +1,18 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/domain/staff/model"
+)
+
+func ConvertDto2PermissionModel(permission dto.Permission) model.Permission {
+	return model.Permission(permission)
+}
+
+func ConvertDtos2PermissionsModel(permissions []dto.Permission) []model.Permission {
+	var permissionModels = make([]model.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		permissionModels = append(permissionModels, ConvertDto2PermissionModel(permission))
+	}
+	return permissionModels
+}
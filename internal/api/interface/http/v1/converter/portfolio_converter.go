@@ -0,0 +1,37 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/pkg/datetime"
+)
+
+func ConvertModel2PortfolioItemResponse(portfolioItemModel *model.PortfolioItem) *dto.PortfolioItem {
+	portfolioItem := dto.PortfolioItem{
+		ID:           portfolioItemModel.ID,
+		Title:        portfolioItemModel.Title,
+		Description:  portfolioItemModel.Description,
+		ExternalLink: portfolioItemModel.ExternalLink,
+		SortOrder:    portfolioItemModel.SortOrder,
+	}
+	if portfolioItemModel.Attachment != nil {
+		portfolioItem.Attachment = ConvertModel2AttachmentResponse(portfolioItemModel.Attachment)
+	}
+	if createdAt := portfolioItemModel.CreatedAt; createdAt != nil {
+		dt := datetime.Datetime(*createdAt)
+		portfolioItem.CreatedAt = &dt
+	}
+	if updatedAt := portfolioItemModel.UpdatedAt; updatedAt != nil {
+		dt := datetime.Datetime(*updatedAt)
+		portfolioItem.UpdatedAt = &dt
+	}
+	return &portfolioItem
+}
+
+func ConvertModels2PortfolioItemResponses(portfolioItemModels []model.PortfolioItem) []dto.PortfolioItem {
+	portfolioItems := make([]dto.PortfolioItem, 0, len(portfolioItemModels))
+	for _, portfolioItemModel := range portfolioItemModels {
+		portfolioItems = append(portfolioItems, *ConvertModel2PortfolioItemResponse(&portfolioItemModel))
+	}
+	return portfolioItems
+}
@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/domain/account/model"
+)
+
+func ConvertModel2PrivacyResponse(privacyModel *model.Privacy) *dto.Privacy {
+	return &dto.Privacy{
+		LastName:           dto.Visibility(privacyModel.LastName),
+		Location:           dto.Visibility(privacyModel.Location),
+		Company:            dto.Visibility(privacyModel.Company),
+		DocumentAttachment: dto.Visibility(privacyModel.DocumentAttachment),
+		TelegramID:         dto.Visibility(privacyModel.TelegramID),
+	}
+}
+
+func ConvertDto2PrivacyModel(privacy *dto.Privacy) *model.Privacy {
+	return &model.Privacy{
+		LastName:           model.Visibility(privacy.LastName),
+		Location:           model.Visibility(privacy.Location),
+		Company:            model.Visibility(privacy.Company),
+		DocumentAttachment: model.Visibility(privacy.DocumentAttachment),
+		TelegramID:         model.Visibility(privacy.TelegramID),
+	}
+}
@@ -0,0 +1,43 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/pkg/datetime"
+)
+
+func ConvertDto2ReportReasonModel(reason dto.ReportReason) model.ReportReason {
+	return model.ReportReason(reason)
+}
+
+func ConvertModel2ReportResponse(reportModel *model.Report) *dto.Report {
+	report := dto.Report{
+		ID:          reportModel.ID,
+		ReporterID:  reportModel.ReporterID,
+		ReportedID:  reportModel.ReportedID,
+		Reason:      dto.ReportReason(reportModel.Reason),
+		Description: reportModel.Description,
+		Status:      string(reportModel.Status),
+		ReviewerID:  reportModel.ReviewerID,
+	}
+	if reportModel.Attachment != nil {
+		report.Attachment = ConvertModel2AttachmentResponse(reportModel.Attachment)
+	}
+	if createdAt := reportModel.CreatedAt; createdAt != nil {
+		dt := datetime.Datetime(*createdAt)
+		report.CreatedAt = &dt
+	}
+	if reviewedAt := reportModel.ReviewedAt; reviewedAt != nil {
+		dt := datetime.Datetime(*reviewedAt)
+		report.ReviewedAt = &dt
+	}
+	return &report
+}
+
+func ConvertModels2ReportsResponse(reportModels []model.Report) []dto.Report {
+	reports := make([]dto.Report, 0, len(reportModels))
+	for _, reportModel := range reportModels {
+		reports = append(reports, *ConvertModel2ReportResponse(&reportModel))
+	}
+	return reports
+}
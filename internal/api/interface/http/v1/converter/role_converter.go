@@ -15,3 +15,22 @@ func ConvertDto2RoleModel(role dto.Role) model.Role {
 		return model.UnknownRole
 	}
 }
+
+func ConvertModel2RoleResponse(role model.Role) dto.Role {
+	switch role {
+	case model.ClientRole:
+		return dto.ClientRole
+	case model.FreelancerRole:
+		return dto.FreelancerRole
+	default:
+		return dto.Role(role)
+	}
+}
+
+func ConvertModels2RolesResponse(roles []model.Role) []dto.Role {
+	var roleResponses = make([]dto.Role, 0, len(roles))
+	for _, role := range roles {
+		roleResponses = append(roleResponses, ConvertModel2RoleResponse(role))
+	}
+	return roleResponses
+}
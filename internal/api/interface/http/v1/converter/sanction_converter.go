@@ -0,0 +1,34 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/pkg/datetime"
+)
+
+func ConvertModel2SanctionResponse(sanctionModel *model.Sanction) *dto.Sanction {
+	sanction := dto.Sanction{
+		ID:             sanctionModel.ID,
+		AccountID:      sanctionModel.AccountID,
+		StaffAccountID: sanctionModel.StaffAccountID,
+		Action:         string(sanctionModel.Action),
+		Reason:         sanctionModel.Reason,
+	}
+	if suspendedUntil := sanctionModel.SuspendedUntil; suspendedUntil != nil {
+		dt := datetime.Datetime(*suspendedUntil)
+		sanction.SuspendedUntil = &dt
+	}
+	if createdAt := sanctionModel.CreatedAt; createdAt != nil {
+		dt := datetime.Datetime(*createdAt)
+		sanction.CreatedAt = &dt
+	}
+	return &sanction
+}
+
+func ConvertModels2SanctionsResponse(sanctionModels []model.Sanction) []dto.Sanction {
+	var sanctions = make([]dto.Sanction, 0, len(sanctionModels))
+	for _, sanctionModel := range sanctionModels {
+		sanctions = append(sanctions, *ConvertModel2SanctionResponse(&sanctionModel))
+	}
+	return sanctions
+}
@@ -0,0 +1,36 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/domain/account/model"
+	"strings"
+)
+
+func ConvertDto2SearchAccountsModel(searchAccounts *dto.SearchAccounts) *model.SearchAccounts {
+	searchAccountsModel := model.SearchAccounts{
+		Tags:        searchAccounts.Tags,
+		CategoryIDs: searchAccounts.CategoryIDs,
+		HasCompany:  searchAccounts.HasCompany,
+		Sort:        model.RelevanceAccountSort,
+		Offset:      searchAccounts.Offset,
+		Limit:       searchAccounts.Limit,
+	}
+	if query := strings.TrimSpace(searchAccounts.Query); len(query) > 0 {
+		searchAccountsModel.Query = &query
+	}
+	if len(searchAccounts.Role) > 0 {
+		role := model.Role(searchAccounts.Role)
+		searchAccountsModel.Role = &role
+	}
+	if country := strings.TrimSpace(searchAccounts.Country); len(country) > 0 {
+		searchAccountsModel.Country = &country
+	}
+	if len(searchAccounts.Gender) > 0 {
+		gender := string(searchAccounts.Gender)
+		searchAccountsModel.Gender = &gender
+	}
+	if len(searchAccounts.Sort) > 0 {
+		searchAccountsModel.Sort = model.AccountSort(searchAccounts.Sort)
+	}
+	return &searchAccountsModel
+}
@@ -0,0 +1,35 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/pkg/datetime"
+)
+
+func ConvertModel2SessionResponse(sessionModel *model.Session) *dto.Session {
+	session := dto.Session{
+		ID:        sessionModel.ID,
+		Device:    sessionModel.Device,
+		Platform:  sessionModel.Platform,
+		UserAgent: sessionModel.UserAgent,
+		IP:        sessionModel.IP,
+		Current:   sessionModel.Current,
+	}
+	if createdAt := sessionModel.CreatedAt; createdAt != nil {
+		dt := datetime.Datetime(*createdAt)
+		session.CreatedAt = &dt
+	}
+	if lastActiveAt := sessionModel.LastActiveAt; lastActiveAt != nil {
+		dt := datetime.Datetime(*lastActiveAt)
+		session.LastActiveAt = &dt
+	}
+	return &session
+}
+
+func ConvertModels2SessionsResponse(sessionModels []model.Session) []dto.Session {
+	var sessions = make([]dto.Session, 0, len(sessionModels))
+	for _, sessionModel := range sessionModels {
+		sessions = append(sessions, *ConvertModel2SessionResponse(&sessionModel))
+	}
+	return sessions
+}
@@ -0,0 +1,25 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/domain/staff/model"
+)
+
+func ConvertModel2StaffRoleResponse(staffRoleModel *model.StaffRole) *dto.StaffRole {
+	permissions := make([]dto.Permission, 0, len(staffRoleModel.Permissions))
+	for _, permission := range staffRoleModel.Permissions {
+		permissions = append(permissions, dto.Permission(permission))
+	}
+	return &dto.StaffRole{
+		Name:        staffRoleModel.Name,
+		Permissions: permissions,
+	}
+}
+
+func ConvertModels2StaffRolesResponse(staffRoleModels []model.StaffRole) []dto.StaffRole {
+	var staffRoles = make([]dto.StaffRole, 0, len(staffRoleModels))
+	for _, staffRoleModel := range staffRoleModels {
+		staffRoles = append(staffRoles, *ConvertModel2StaffRoleResponse(&staffRoleModel))
+	}
+	return staffRoles
+}
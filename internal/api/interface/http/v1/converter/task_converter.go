@@ -9,6 +9,7 @@ import (
 func ConvertModel2TaskResponse(taskModel *model.Task) *dto.Task {
 	var task = dto.Task{
 		OwnerID:     taskModel.OwnerID,
+		OwnerRole:   taskModel.OwnerRole,
 		Title:       taskModel.Title,
 		Description: taskModel.Description,
 	}
@@ -0,0 +1,14 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/pkg/datetime"
+)
+
+func ConvertModel2WalletNonceResponse(walletNonceModel *model.WalletNonce) *dto.WalletNonce {
+	return &dto.WalletNonce{
+		Payload:   walletNonceModel.Payload,
+		ExpiresAt: datetime.Datetime(walletNonceModel.ExpiresAt),
+	}
+}
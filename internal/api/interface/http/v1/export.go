@@ -0,0 +1,163 @@
+package v1
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/api/interface/http/v1/converter"
+	"go-tonify-backend/internal/api/interface/http/validator"
+	"go-tonify-backend/internal/container"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/account/usecase"
+	"go-tonify-backend/pkg/logger"
+	"net/http"
+)
+
+const exportContentType = "application/zip"
+
+type ExportHandler struct {
+	container     container.Container
+	validation    validator.HttpValidator
+	exportUsecase usecase.Export
+}
+
+func NewExportHandler(
+	container container.Container,
+	validation validator.HttpValidator,
+	exportUsecase usecase.Export,
+) *ExportHandler {
+	return &ExportHandler{
+		container:     container,
+		validation:    validation,
+		exportUsecase: exportUsecase,
+	}
+}
+
+// Request godoc
+//
+//	@Summary		Request a personal data export
+//	@Description	Queue an archive of everything tied to the authenticated user's account: profile, company, tags, categories, tasks, likes given and received, dislikes and attachments.
+//	@Description	The archive is built in the background, the telegram bot sends a message when it is ready. An export that is still in progress is returned instead of queueing another one
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization	header		string										true	"account's access token"
+//	@Success		202				{object}	dto.Response{response=dto.AccountExport}	"queued export"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}			"the authorization token is invalid/expired/missing"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Router			/v1/account/export [post]
+//	@Security		ApiKeyAuth
+func (e *ExportHandler) Request(ctx *gin.Context) {
+	log := e.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	exportModel, err := e.exportUsecase.RequestExport(ctx, *accountID)
+	if err != nil {
+		log.Error("fail to request export", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	successResponse(ctx, http.StatusAccepted, converter.ConvertModel2ExportResponse(exportModel))
+}
+
+// Get godoc
+//
+//	@Summary		Get a personal data export
+//	@Description	Get the status of a personal data export of the authenticated user's account
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization	header		string										true	"account's access token"
+//	@Param			id				path		int											true	"export id"
+//	@Success		200				{object}	dto.Response{response=dto.AccountExport}	"export"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}			"the authorization token is invalid/expired/missing"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}			"export does not exist"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Router			/v1/account/export/{id} [get]
+//	@Security		ApiKeyAuth
+func (e *ExportHandler) Get(ctx *gin.Context) {
+	log := e.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var uriExport dto.URIExport
+	if err := ctx.ShouldBindUri(&uriExport); err != nil {
+		log.Error("fail to bind uri export", logger.FError(err))
+		badRequestResponse(ctx, e.validation, dto.BadRequestError, err)
+		return
+	}
+	exportModel, err := e.exportUsecase.GetExport(ctx, *accountID, uriExport.ID)
+	if err != nil {
+		log.Error("fail to get export", logger.FError(err), logger.F("export_id", uriExport.ID))
+		switch err {
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	successResponse(ctx, http.StatusOK, converter.ConvertModel2ExportResponse(exportModel))
+}
+
+// Download godoc
+//
+//	@Summary		Download a personal data export
+//	@Description	Download the ZIP archive of a ready export, manifest.json in the root of the archive describes every file
+//	@Tags			account
+//	@Produce		application/zip
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			id				path		int									true	"export id"
+//	@Success		200				{file}		file								"export archive"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}	"export does not exist"
+//	@Failure		409				{object}	dto.Response{response=dto.Empty}	"export is not ready yet or has failed"
+//	@Failure		410				{object}	dto.Response{response=dto.Empty}	"export has expired"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/account/export/{id}/download [get]
+//	@Security		ApiKeyAuth
+func (e *ExportHandler) Download(ctx *gin.Context) {
+	log := e.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var uriExport dto.URIExport
+	if err := ctx.ShouldBindUri(&uriExport); err != nil {
+		log.Error("fail to bind uri export", logger.FError(err))
+		badRequestResponse(ctx, e.validation, dto.BadRequestError, err)
+		return
+	}
+	file, exportModel, err := e.exportUsecase.DownloadExport(ctx, *accountID, uriExport.ID)
+	if err != nil {
+		log.Error("fail to download export", logger.FError(err), logger.F("export_id", uriExport.ID))
+		switch err {
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+		case model.ExportNotReadyError:
+			failResponse(ctx, http.StatusConflict, dto.ExportNotReadyError, err)
+		case model.ExportExpiredError:
+			failResponse(ctx, http.StatusGone, dto.ExportExpiredError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	headers := map[string]string{
+		"Content-Disposition": fmt.Sprintf("attachment; filename=\"tonify-export-%d.zip\"", exportModel.ID),
+	}
+	ctx.DataFromReader(http.StatusOK, -1, exportContentType, file, headers)
+}
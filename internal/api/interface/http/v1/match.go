@@ -34,10 +34,16 @@ func NewMatchHandler(
 //
 //	@Summary		Matchable accounts
 //	@Description	Get matchable accounts: accounts that have not been liked, disliked, or were disliked a long time ago.
+//	@Description	The accounts are ranked by shared tags and categories, the same country, profile completeness, recent activity and whether they already liked you.
+//	@Description	The ranking is deterministic during a day, so pages don't overlap while the feed doesn't change.
+//	@Description	The accounts are narrowed by the match preferences of the account, see /v1/match/preferences.
 //	@Description	**Attention**: The rules may change from time to time. If you need more information about the endpoint, please contact API support
 //	@Tags			match
 //	@Param			Authorization	header		string					true	"account's access token"
+//	@Param			X-Active-Role	header		string					false	"role the account acts in, the default role of the account when missing"	Enums(client, freelancer)
+//	@Param			offset			query		int						false	"pagination offset"
 //	@Param			limit			query		int						true	"pagination limit"
+//	@Param			verified_only	query		bool					false	"only accounts with a verified identity document"
 //	@Produce		json
 //	@Success		200	{object}	dto.Response{response=dto.Pagination{data=[]dto.Account}}		"list of matchable accounts"
 //	@Failure		400	{object}	dto.Response{response=dto.Empty}								"detailed error message"
@@ -54,13 +60,22 @@ func (m *MatchHandler) MatchableAccounts(ctx *gin.Context) {
 		failResponse(ctx, http.StatusUnauthorized, err, nil)
 		return
 	}
+	activeRole, err := getActiveRole(ctx)
+	if err != nil {
+		log.Error("fail to get active role", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
+		return
+	}
 	var getMatchAccounts dto.GetMatchAccounts
 	if err := ctx.ShouldBindQuery(&getMatchAccounts); err != nil {
 		log.Error("fail to bind get match accounts", logger.FError(err))
 		badRequestResponse(ctx, m.validation, dto.BadRequestError, err)
 		return
 	}
-	paginationModel, err := m.matchUsecase.MatchableAccounts(ctx, *accountID, getMatchAccounts.Limit)
+	matchableFilter := model.MatchableFilter{
+		VerifiedOnly: getMatchAccounts.VerifiedOnly,
+	}
+	paginationModel, err := m.matchUsecase.MatchableAccounts(ctx, *accountID, activeRole, matchableFilter, getMatchAccounts.Offset, getMatchAccounts.Limit)
 	if err != nil {
 		log.Error("fail to get matchable accounts", logger.FError(err))
 		switch err {
@@ -87,6 +102,7 @@ func (m *MatchHandler) MatchableAccounts(ctx *gin.Context) {
 //	@Description	Get accounts who like you.
 //	@Tags			match
 //	@Param			Authorization	header		string					true	"account's access token"
+//	@Param			X-Active-Role	header		string					false	"role the account acts in, the default role of the account when missing"	Enums(client, freelancer)
 //	@Param			offset			query		int						true	"pagination offset"
 //	@Param			limit			query		int						true	"pagination limit"
 //	@Produce		json
@@ -105,13 +121,19 @@ func (m *MatchHandler) AccountLikers(ctx *gin.Context) {
 		failResponse(ctx, http.StatusUnauthorized, err, nil)
 		return
 	}
+	activeRole, err := getActiveRole(ctx)
+	if err != nil {
+		log.Error("fail to get active role", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
+		return
+	}
 	var getLikedAccounts dto.GetLikedAccounts
 	if err := ctx.ShouldBindQuery(&getLikedAccounts); err != nil {
 		log.Error("fail to bind get match accounts", logger.FError(err))
 		badRequestResponse(ctx, m.validation, dto.BadRequestError, err)
 		return
 	}
-	paginationModel, err := m.matchUsecase.GetAccountLikers(ctx, *accountID, getLikedAccounts.Offset, getLikedAccounts.Limit)
+	paginationModel, err := m.matchUsecase.GetAccountLikers(ctx, *accountID, activeRole, getLikedAccounts.Offset, getLikedAccounts.Limit)
 	if err != nil {
 		log.Error("fail to get account likers", logger.FError(err))
 		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
@@ -139,12 +161,16 @@ func (m *MatchHandler) AccountLikers(ctx *gin.Context) {
 // @Description  - **error**: An error occurred while processing the request.
 // @Tags			match
 // @Param			Authorization	header		string					true	"account's access token"
+// @Param			X-Active-Role	header		string					false	"role the account acts in, the default role of the account when missing"	Enums(client, freelancer)
 // @Param			request			body		dto.PostMatchAction		true	"action match parameters"
 // @Produce			json
 // @Param        	action    		query     dto.MatchAction  true  "match action"
 // @Success		200	{object}	dto.Response{response=dto.MatchResult}	"list of matching accounts"
 // @Failure		400	{object}	dto.Response{response=dto.Empty}		"detailed error message"
 // @Failure		401	{object}	dto.Response{response=dto.Empty}		"the authorization token is invalid/expired/missing"
+// @Failure		403	{object}	dto.Response{response=dto.Empty}		"the account doesn't hold the active role"
+// @Failure		404	{object}	dto.Response{response=dto.Empty}		"the target account doesn't hold the opposite role or the accounts have blocked each other"
+// @Failure		409	{object}	dto.Response{response=dto.Empty}		"the accounts have unmatched"
 // @Failure		500	{object}	dto.Response{response=dto.Empty}		"detailed error message"
 // @Router			/v1/match/action/{action} [post]
 // @Security		ApiKeyAuth
@@ -156,6 +182,12 @@ func (m *MatchHandler) MatchAction(ctx *gin.Context) {
 		failResponse(ctx, http.StatusUnauthorized, err, nil)
 		return
 	}
+	activeRole, err := getActiveRole(ctx)
+	if err != nil {
+		log.Error("fail to get active role", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
+		return
+	}
 	var uriMatchAction dto.URIMatchAction
 	if err := ctx.ShouldBindUri(&uriMatchAction); err != nil {
 		log.Error("fail to bind uri match action", logger.FError(err))
@@ -169,12 +201,273 @@ func (m *MatchHandler) MatchAction(ctx *gin.Context) {
 		return
 	}
 	matchActionModel := converter.ConvertDto2MatchActionModel(uriMatchAction.Action)
-	matchResultModel, err := m.matchUsecase.MatchAction(ctx, *accountID, postMatchAction.TargetID, matchActionModel)
+	matchResultModel, err := m.matchUsecase.MatchAction(ctx, *accountID, activeRole, postMatchAction.TargetID, matchActionModel)
 	if err != nil {
 		log.Error("fail to perform match action", logger.FError(err))
-		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		switch err {
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+		case model.UnmatchedPairError:
+			failResponse(ctx, http.StatusConflict, dto.UnmatchedPairError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
 		return
 	}
 	matchResult := converter.ConvertModel2MatchActionResponse(matchResultModel)
 	successResponse(ctx, http.StatusOK, matchResult)
 }
+
+// Matches godoc
+//
+//	@Summary		Get my matches
+//	@Description	Get accounts that liked you back while you act in the active role, the newest match first.
+//	@Tags			match
+//	@Param			Authorization	header		string					true	"account's access token"
+//	@Param			X-Active-Role	header		string					false	"role the account acts in, the default role of the account when missing"	Enums(client, freelancer)
+//	@Param			offset			query		int						false	"pagination offset"
+//	@Param			limit			query		int						true	"pagination limit"
+//	@Produce		json
+//	@Success		200	{object}	dto.Response{response=dto.Pagination{data=[]dto.Match}}	"list of matches"
+//	@Failure		400	{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Failure		401	{object}	dto.Response{response=dto.Empty}		"the authorization token is invalid/expired/missing"
+//	@Failure		500	{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Router			/v1/match/matches [get]
+//	@Security		ApiKeyAuth
+func (m *MatchHandler) Matches(ctx *gin.Context) {
+	log := m.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusUnauthorized, err, nil)
+		return
+	}
+	activeRole, err := getActiveRole(ctx)
+	if err != nil {
+		log.Error("fail to get active role", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
+		return
+	}
+	var getMatches dto.GetMatches
+	if err := ctx.ShouldBindQuery(&getMatches); err != nil {
+		log.Error("fail to bind get matches", logger.FError(err))
+		badRequestResponse(ctx, m.validation, dto.BadRequestError, err)
+		return
+	}
+	paginationModel, err := m.matchUsecase.GetMatches(ctx, *accountID, activeRole, getMatches.Offset, getMatches.Limit)
+	if err != nil {
+		log.Error("fail to get matches", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	pagination := dto.Pagination{
+		Offset: paginationModel.Offset,
+		Limit:  paginationModel.Limit,
+		Total:  paginationModel.Total,
+		Data:   converter.ConvertModels2MatchesResponse(paginationModel.Data),
+	}
+	successResponse(ctx, http.StatusOK, pagination)
+}
+
+// Unmatch godoc
+//
+//	@Summary		Unmatch
+//	@Description	End a match for both accounts: both likes are removed and the accounts don't appear in each other's feed again.
+//	@Tags			match
+//	@Param			Authorization	header		string					true	"account's access token"
+//	@Param			id				path		int						true	"match id"
+//	@Produce		json
+//	@Success		200	{object}	dto.Response{response=string}			"returns ok string"
+//	@Failure		400	{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Failure		401	{object}	dto.Response{response=dto.Empty}		"the authorization token is invalid/expired/missing"
+//	@Failure		404	{object}	dto.Response{response=dto.Empty}		"match does not exist or has already ended"
+//	@Failure		500	{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Router			/v1/match/matches/{id} [delete]
+//	@Security		ApiKeyAuth
+func (m *MatchHandler) Unmatch(ctx *gin.Context) {
+	log := m.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusUnauthorized, err, nil)
+		return
+	}
+	var uriMatch dto.URIMatch
+	if err := ctx.ShouldBindUri(&uriMatch); err != nil {
+		log.Error("fail to bind uri match", logger.FError(err))
+		badRequestResponse(ctx, m.validation, dto.BadRequestError, err)
+		return
+	}
+	if err := m.matchUsecase.Unmatch(ctx, *accountID, uriMatch.ID); err != nil {
+		log.Error("fail to unmatch", logger.FError(err), logger.F("match_id", uriMatch.ID))
+		switch err {
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	successResponse(ctx, http.StatusOK, "ok")
+}
+
+// Rewind godoc
+//
+//	@Summary		Rewind the last swipe
+//	@Description	Revert the latest like or dislike made in the active role, a match the like formed is dissolved. The number of rewinds a day is limited.
+//	@Tags			match
+//	@Param			Authorization	header		string					true	"account's access token"
+//	@Param			X-Active-Role	header		string					false	"role the account acts in, the default role of the account when missing"	Enums(client, freelancer)
+//	@Produce		json
+//	@Success		200	{object}	dto.Response{response=dto.Rewind}		"reverted action and the account to show again, account is null when it isn't active anymore"
+//	@Failure		401	{object}	dto.Response{response=dto.Empty}		"the authorization token is invalid/expired/missing"
+//	@Failure		403	{object}	dto.Response{response=dto.Empty}		"the account doesn't hold the active role"
+//	@Failure		404	{object}	dto.Response{response=dto.Empty}		"there is no swipe to rewind"
+//	@Failure		409	{object}	dto.Response{response=dto.Empty}		"the last swipe is a dislike that ended a match"
+//	@Failure		429	{object}	dto.Response{response=dto.Empty}		"exceeded the daily rewind limit"
+//	@Failure		500	{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Router			/v1/match/rewind [post]
+//	@Security		ApiKeyAuth
+func (m *MatchHandler) Rewind(ctx *gin.Context) {
+	log := m.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusUnauthorized, err, nil)
+		return
+	}
+	activeRole, err := getActiveRole(ctx)
+	if err != nil {
+		log.Error("fail to get active role", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
+		return
+	}
+	rewindModel, err := m.matchUsecase.Rewind(ctx, *accountID, activeRole)
+	if err != nil {
+		log.Error("fail to rewind", logger.FError(err))
+		switch err {
+		case model.NothingToRewindError:
+			failResponse(ctx, http.StatusNotFound, dto.NothingToRewindError, err)
+		case model.RewindLimitError:
+			failResponse(ctx, http.StatusTooManyRequests, dto.RewindLimitError, err)
+		case model.RewindEndedMatchError:
+			failResponse(ctx, http.StatusConflict, dto.RewindEndedMatchError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	successResponse(ctx, http.StatusOK, converter.ConvertModel2RewindResponse(rewindModel))
+}
+
+// Block godoc
+//
+//	@Summary		Block an account
+//	@Description	Block an account in every role: the accounts don't see each other in the feeds, the likers and the profiles and can't like each other, a match between them ends.
+//	@Tags			match
+//	@Param			Authorization	header		string					true	"account's access token"
+//	@Param			id				path		int						true	"account id"
+//	@Produce		json
+//	@Success		200	{object}	dto.Response{response=string}			"returns ok string"
+//	@Failure		400	{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Failure		401	{object}	dto.Response{response=dto.Empty}		"the authorization token is invalid/expired/missing"
+//	@Failure		404	{object}	dto.Response{response=dto.Empty}		"account does not exist"
+//	@Failure		500	{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Router			/v1/match/block/{id} [post]
+//	@Security		ApiKeyAuth
+func (m *MatchHandler) Block(ctx *gin.Context) {
+	log := m.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusUnauthorized, err, nil)
+		return
+	}
+	var uriAccount dto.URIAccount
+	if err := ctx.ShouldBindUri(&uriAccount); err != nil {
+		log.Error("fail to bind uri account", logger.FError(err))
+		badRequestResponse(ctx, m.validation, dto.BadRequestError, err)
+		return
+	}
+	if err := m.matchUsecase.Block(ctx, *accountID, uriAccount.ID); err != nil {
+		log.Error("fail to block account", logger.FError(err), logger.F("target_id", uriAccount.ID))
+		switch err {
+		case model.InvalidBlockError:
+			failResponse(ctx, http.StatusBadRequest, dto.InvalidBlockError, err)
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	successResponse(ctx, http.StatusOK, "ok")
+}
+
+// GetPreferences godoc
+//
+//	@Summary		Get my match preferences
+//	@Description	Get the countries, categories, required tags, verified-only and has-company preferences that narrow the matchable accounts
+//	@Tags			match
+//	@Param			Authorization	header		string					true	"account's access token"
+//	@Produce		json
+//	@Success		200	{object}	dto.Response{response=dto.MatchPreference}	"match preferences"
+//	@Failure		401	{object}	dto.Response{response=dto.Empty}			"the authorization token is invalid/expired/missing"
+//	@Failure		500	{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Router			/v1/match/preferences [get]
+//	@Security		ApiKeyAuth
+func (m *MatchHandler) GetPreferences(ctx *gin.Context) {
+	log := m.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusUnauthorized, err, nil)
+		return
+	}
+	preferenceModel, err := m.matchUsecase.GetPreference(ctx, *accountID)
+	if err != nil {
+		log.Error("fail to get match preference", logger.F("account_id", *accountID), logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	successResponse(ctx, http.StatusOK, converter.ConvertModel2MatchPreferenceResponse(preferenceModel))
+}
+
+// UpdatePreferences godoc
+//
+//	@Summary		Update my match preferences
+//	@Description	Replace the match preferences. An account matches when its country is one of the countries, it has one of the categories and all the required tags.
+//	@Description	Empty lists and a null has_company don't narrow the matchable accounts, verified_only applies even when the query parameter of the feed is false
+//	@Tags			match
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string					true	"account's access token"
+//	@Param			request			body		dto.MatchPreference		true	"match preferences"
+//	@Success		200	{object}	dto.Response{response=dto.MatchPreference}	"match preferences"
+//	@Failure		400	{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Failure		401	{object}	dto.Response{response=dto.Empty}			"the authorization token is invalid/expired/missing"
+//	@Failure		500	{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Router			/v1/match/preferences [put]
+//	@Security		ApiKeyAuth
+func (m *MatchHandler) UpdatePreferences(ctx *gin.Context) {
+	log := m.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusUnauthorized, err, nil)
+		return
+	}
+	var preferenceRequest dto.MatchPreference
+	if err := ctx.ShouldBindJSON(&preferenceRequest); err != nil {
+		log.Error("fail to parse/validate request model", logger.FError(err))
+		badRequestResponse(ctx, m.validation, dto.BadRequestError, err)
+		return
+	}
+	preferenceModel, err := m.matchUsecase.UpdatePreference(ctx, *accountID, *converter.ConvertDto2MatchPreferenceModel(&preferenceRequest))
+	if err != nil {
+		log.Error("fail to update match preference", logger.F("account_id", *accountID), logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	successResponse(ctx, http.StatusOK, converter.ConvertModel2MatchPreferenceResponse(preferenceModel))
+}
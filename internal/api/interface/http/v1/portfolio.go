@@ -0,0 +1,266 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/api/interface/http/v1/converter"
+	"go-tonify-backend/internal/api/interface/http/validator"
+	"go-tonify-backend/internal/container"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/account/usecase"
+	"go-tonify-backend/pkg/logger"
+	"net/http"
+)
+
+type PortfolioHandler struct {
+	container        container.Container
+	validation       validator.HttpValidator
+	portfolioUsecase usecase.Portfolio
+}
+
+func NewPortfolioHandler(
+	container container.Container,
+	validation validator.HttpValidator,
+	portfolioUsecase usecase.Portfolio,
+) *PortfolioHandler {
+	return &PortfolioHandler{
+		container:        container,
+		validation:       validation,
+		portfolioUsecase: portfolioUsecase,
+	}
+}
+
+// GetAll godoc
+//
+//	@Summary		Get my portfolio
+//	@Description	Get the portfolio items of the authenticated user's account in their order
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization	header		string										true	"account's access token"
+//	@Success		200				{object}	dto.Response{response=[]dto.PortfolioItem}	"portfolio items"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}			"the authorization token is invalid/expired/missing"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Router			/v1/account/portfolio [get]
+//	@Security		ApiKeyAuth
+func (p *PortfolioHandler) GetAll(ctx *gin.Context) {
+	log := p.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	portfolioItemModels, err := p.portfolioUsecase.GetPortfolio(ctx, *accountID)
+	if err != nil {
+		log.Error("fail to get portfolio", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	successResponse(ctx, http.StatusOK, converter.ConvertModels2PortfolioItemResponses(portfolioItemModels))
+}
+
+// Add godoc
+//
+//	@Summary		Add a portfolio item
+//	@Description	Upload a file to the portfolio of the authenticated freelancer, the item is put at the end of the portfolio.
+//	@Description	An account can have up to 12 portfolio items
+//	@Tags			account
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			Authorization	header		string										true	"account's access token"
+//	@Param			title			formData	string										true	"title"
+//	@Param			description		formData	string										false	"description"
+//	@Param			external_link	formData	string										false	"link to the work"
+//	@Param			file			formData	file										true	"portfolio file"
+//	@Success		201				{object}	dto.Response{response=dto.PortfolioItem}	"added portfolio item"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}			"the authorization token is invalid/expired/missing"
+//	@Failure		403				{object}	dto.Response{response=dto.Empty}			"the account is not a freelancer"
+//	@Failure		409				{object}	dto.Response{response=dto.Empty}			"the portfolio is full"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Router			/v1/account/portfolio [post]
+//	@Security		ApiKeyAuth
+func (p *PortfolioHandler) Add(ctx *gin.Context) {
+	log := p.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		log.Error("fail to retrieve portfolio file header", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, dto.BadRequestError, err)
+		return
+	}
+	var addPortfolioItemRequest dto.AddPortfolioItem
+	if err := ctx.ShouldBind(&addPortfolioItemRequest); err != nil {
+		log.Error("fail to bind add portfolio item", logger.FError(err))
+		badRequestResponse(ctx, p.validation, dto.BadRequestError, err)
+		return
+	}
+	addPortfolioItem := model.AddPortfolioItem{
+		AccountID:    *accountID,
+		Title:        addPortfolioItemRequest.Title,
+		Description:  addPortfolioItemRequest.Description,
+		ExternalLink: addPortfolioItemRequest.ExternalLink,
+		FileHeader:   fileHeader,
+	}
+	portfolioItemModel, err := p.portfolioUsecase.AddItem(ctx, addPortfolioItem)
+	if err != nil {
+		log.Error("fail to add portfolio item", logger.FError(err))
+		switch err {
+		case model.PortfolioLimitError:
+			failResponse(ctx, http.StatusConflict, dto.PortfolioLimitError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	successResponse(ctx, http.StatusCreated, converter.ConvertModel2PortfolioItemResponse(portfolioItemModel))
+}
+
+// Edit godoc
+//
+//	@Summary		Edit a portfolio item
+//	@Description	Replace the title, description and link of a portfolio item of the authenticated user's account
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string										true	"account's access token"
+//	@Param			id				path		int											true	"portfolio item id"
+//	@Param			request			body		dto.EditPortfolioItem						true	"portfolio item details"
+//	@Success		200				{object}	dto.Response{response=dto.PortfolioItem}	"edited portfolio item"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}			"the authorization token is invalid/expired/missing"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}			"portfolio item does not exist"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Router			/v1/account/portfolio/{id} [put]
+//	@Security		ApiKeyAuth
+func (p *PortfolioHandler) Edit(ctx *gin.Context) {
+	log := p.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var uriPortfolioItem dto.URIPortfolioItem
+	if err := ctx.ShouldBindUri(&uriPortfolioItem); err != nil {
+		log.Error("fail to bind uri portfolio item", logger.FError(err))
+		badRequestResponse(ctx, p.validation, dto.BadRequestError, err)
+		return
+	}
+	var editPortfolioItemRequest dto.EditPortfolioItem
+	if err := ctx.ShouldBindJSON(&editPortfolioItemRequest); err != nil {
+		log.Error("fail to bind edit portfolio item", logger.FError(err))
+		badRequestResponse(ctx, p.validation, dto.BadRequestError, err)
+		return
+	}
+	editPortfolioItem := model.EditPortfolioItem{
+		ID:           uriPortfolioItem.ID,
+		AccountID:    *accountID,
+		Title:        editPortfolioItemRequest.Title,
+		Description:  editPortfolioItemRequest.Description,
+		ExternalLink: editPortfolioItemRequest.ExternalLink,
+	}
+	portfolioItemModel, err := p.portfolioUsecase.EditItem(ctx, editPortfolioItem)
+	if err != nil {
+		log.Error("fail to edit portfolio item", logger.FError(err), logger.F("portfolio_item_id", uriPortfolioItem.ID))
+		switch err {
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	successResponse(ctx, http.StatusOK, converter.ConvertModel2PortfolioItemResponse(portfolioItemModel))
+}
+
+// Reorder godoc
+//
+//	@Summary		Reorder my portfolio
+//	@Description	Sort the portfolio of the authenticated user's account, item_ids must list every portfolio item exactly once
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string										true	"account's access token"
+//	@Param			request			body		dto.ReorderPortfolio						true	"portfolio item ids in the new order"
+//	@Success		200				{object}	dto.Response{response=[]dto.PortfolioItem}	"portfolio items"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}			"the authorization token is invalid/expired/missing"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}			"detailed error message"
+//	@Router			/v1/account/portfolio/order [put]
+//	@Security		ApiKeyAuth
+func (p *PortfolioHandler) Reorder(ctx *gin.Context) {
+	log := p.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var reorderPortfolio dto.ReorderPortfolio
+	if err := ctx.ShouldBindJSON(&reorderPortfolio); err != nil {
+		log.Error("fail to bind reorder portfolio", logger.FError(err))
+		badRequestResponse(ctx, p.validation, dto.BadRequestError, err)
+		return
+	}
+	portfolioItemModels, err := p.portfolioUsecase.ReorderItems(ctx, *accountID, reorderPortfolio.ItemIDs)
+	if err != nil {
+		log.Error("fail to reorder portfolio", logger.FError(err))
+		switch err {
+		case model.InvalidPortfolioOrderError:
+			failResponse(ctx, http.StatusBadRequest, dto.InvalidPortfolioOrderError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	successResponse(ctx, http.StatusOK, converter.ConvertModels2PortfolioItemResponses(portfolioItemModels))
+}
+
+// Remove godoc
+//
+//	@Summary		Remove a portfolio item
+//	@Description	Remove a portfolio item of the authenticated user's account together with its file
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			id				path		int									true	"portfolio item id"
+//	@Success		200				{object}	dto.Response{response=string}		"returns ok string"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}	"portfolio item does not exist"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/account/portfolio/{id} [delete]
+//	@Security		ApiKeyAuth
+func (p *PortfolioHandler) Remove(ctx *gin.Context) {
+	log := p.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var uriPortfolioItem dto.URIPortfolioItem
+	if err := ctx.ShouldBindUri(&uriPortfolioItem); err != nil {
+		log.Error("fail to bind uri portfolio item", logger.FError(err))
+		badRequestResponse(ctx, p.validation, dto.BadRequestError, err)
+		return
+	}
+	if err := p.portfolioUsecase.RemoveItem(ctx, *accountID, uriPortfolioItem.ID); err != nil {
+		log.Error("fail to remove portfolio item", logger.FError(err), logger.F("portfolio_item_id", uriPortfolioItem.ID))
+		switch err {
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	successResponse(ctx, http.StatusOK, "ok")
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/api/interface/http/v1/converter"
+	"go-tonify-backend/internal/api/interface/http/validator"
+	"go-tonify-backend/internal/container"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/account/usecase"
+	"go-tonify-backend/pkg/logger"
+	"mime/multipart"
+	"net/http"
+)
+
+type ReportHandler struct {
+	container     container.Container
+	validation    validator.HttpValidator
+	reportUsecase usecase.Report
+}
+
+func NewReportHandler(
+	container container.Container,
+	validation validator.HttpValidator,
+	reportUsecase usecase.Report,
+) *ReportHandler {
+	return &ReportHandler{
+		container:     container,
+		validation:    validation,
+		reportUsecase: reportUsecase,
+	}
+}
+
+// Create godoc
+//
+//	@Summary		Report an account
+//	@Description	Report an account to the moderators, the report waits in the moderation queue until a moderator reviews it.
+//	@Description	The other reason needs a description, a screenshot or another file can be attached as evidence
+//	@Tags			report
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			account_id		formData	int									true	"reported account id"
+//	@Param			reason			formData	string								true	"report reason"	Enums(spam, harassment, fake_profile, scam, inappropriate_content, other)
+//	@Param			description		formData	string								false	"what happened"
+//	@Param			attachment		formData	file								false	"evidence file"
+//	@Success		201				{object}	dto.Response{response=dto.Report}	"created report"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}	"reported account does not exist"
+//	@Failure		429				{object}	dto.Response{response=dto.Empty}	"too many reports, retry later"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/report [post]
+//	@Security		ApiKeyAuth
+func (r *ReportHandler) Create(ctx *gin.Context) {
+	log := r.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusUnauthorized, err, nil)
+		return
+	}
+	var createReportRequest dto.CreateReport
+	if err := ctx.ShouldBind(&createReportRequest); err != nil {
+		log.Error("fail to bind create report", logger.FError(err))
+		badRequestResponse(ctx, r.validation, dto.BadRequestError, err)
+		return
+	}
+	var fileHeader *multipart.FileHeader
+	if fileHeader, err = ctx.FormFile("attachment"); err != nil && err != http.ErrMissingFile {
+		log.Error("fail to retrieve report attachment file header", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, dto.BadRequestError, err)
+		return
+	}
+	createReport := model.CreateReport{
+		ReporterID:  *accountID,
+		ReportedID:  createReportRequest.AccountID,
+		Reason:      converter.ConvertDto2ReportReasonModel(createReportRequest.Reason),
+		Description: createReportRequest.Description,
+		FileHeader:  fileHeader,
+	}
+	reportModel, err := r.reportUsecase.Create(ctx, createReport)
+	if err != nil {
+		log.Error("fail to create report", logger.FError(err))
+		switch err {
+		case model.InvalidReportError:
+			failResponse(ctx, http.StatusBadRequest, dto.InvalidReportError, err)
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	successResponse(ctx, http.StatusCreated, converter.ConvertModel2ReportResponse(reportModel))
+}
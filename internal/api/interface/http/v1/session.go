@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/api/interface/http/v1/converter"
+	"go-tonify-backend/internal/api/interface/http/validator"
+	"go-tonify-backend/internal/container"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/account/usecase"
+	"go-tonify-backend/pkg/logger"
+	"net/http"
+)
+
+type SessionHandler struct {
+	container      container.Container
+	validation     validator.HttpValidator
+	sessionUsecase usecase.Session
+}
+
+func NewSessionHandler(
+	container container.Container,
+	validation validator.HttpValidator,
+	sessionUsecase usecase.Session,
+) *SessionHandler {
+	return &SessionHandler{
+		container:      container,
+		validation:     validation,
+		sessionUsecase: sessionUsecase,
+	}
+}
+
+// GetAll godoc
+//
+//	@Summary		Get active sessions
+//	@Description	Get the active sessions (signed in devices) of the authenticated user's account
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization	header		string									true	"account's access token"
+//	@Success		200				{object}	dto.Response{response=[]dto.Session}	"active sessions"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}		"the authorization token is invalid/expired/missing"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Router			/v1/account/sessions [get]
+//	@Security		ApiKeyAuth
+func (s *SessionHandler) GetAll(ctx *gin.Context) {
+	log := s.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	sessionID, err := getSessionID(ctx)
+	if err != nil {
+		log.Error("fail to get session id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	authorization := model.Authorization{
+		AccountID: *accountID,
+		SessionID: *sessionID,
+	}
+	sessionModels, err := s.sessionUsecase.GetSessions(ctx, authorization)
+	if err != nil {
+		log.Error("fail to get sessions", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	sessions := converter.ConvertModels2SessionsResponse(sessionModels)
+	successResponse(ctx, http.StatusOK, sessions)
+}
+
+// Delete godoc
+//
+//	@Summary		Revoke a session
+//	@Description	End one of the sessions of the authenticated user's account. Access and refresh tokens of the session stop working
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			id				path		string								true	"session id"
+//	@Success		200				{object}	dto.Response{response=string}		"returns ok string"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		404				{object}	dto.Response{response=dto.Empty}	"session does not exist"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/account/sessions/{id} [delete]
+//	@Security		ApiKeyAuth
+func (s *SessionHandler) Delete(ctx *gin.Context) {
+	log := s.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var uriSession dto.URISession
+	if err := ctx.ShouldBindUri(&uriSession); err != nil {
+		log.Error("fail to bind uri session", logger.FError(err))
+		badRequestResponse(ctx, s.validation, dto.BadRequestError, err)
+		return
+	}
+	if err := s.sessionUsecase.RevokeSession(ctx, *accountID, uriSession.ID); err != nil {
+		log.Error("fail to revoke session", logger.FError(err))
+		switch err {
+		case model.EntityNotFoundError:
+			failResponse(ctx, http.StatusNotFound, dto.ModelNotFoundError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	successResponse(ctx, http.StatusOK, "ok")
+}
@@ -33,10 +33,11 @@ func NewTaskHandler(
 // CreateTask godoc
 //
 //	@Summary		Create a task
-//	@Description	The account must have a client role. Each account has a limit on task creation.
+//	@Description	The account must act in the client role. Each account has a limit on task creation per role.
 //	@Description	If everything goes well, the server will return the created task as a response
 //	@Tags			task
 //	@Param			Authorization	header		string					true	"account's access token"
+//	@Param			X-Active-Role	header		string					false	"role the account acts in, the default role of the account when missing"	Enums(client, freelancer)
 //	@Param			request			body		dto.CreateTask	true	"create task parameters"
 //	@Produce		json
 //	@Success		201	{object}	dto.Response{response=dto.Task}			"created task"
@@ -54,6 +55,12 @@ func (t *TaskHandler) CreateTask(ctx *gin.Context) {
 		failResponse(ctx, http.StatusUnauthorized, err, nil)
 		return
 	}
+	activeRole, err := getActiveRole(ctx)
+	if err != nil {
+		log.Error("fail to get active role", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.InternalServerError, err)
+		return
+	}
 	var createTask dto.CreateTask
 	if err := ctx.ShouldBindJSON(&createTask); err != nil {
 		log.Error("fail to bind get match accounts", logger.FError(err))
@@ -62,6 +69,7 @@ func (t *TaskHandler) CreateTask(ctx *gin.Context) {
 	}
 	var createTaskModel = model.Task{
 		OwnerID:     *accountID,
+		OwnerRole:   string(activeRole),
 		Title:       createTask.Title,
 		Description: createTask.Description,
 	}
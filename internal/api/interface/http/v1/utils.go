@@ -5,6 +5,7 @@ import (
 	v "github.com/go-playground/validator/v10"
 	"go-tonify-backend/internal/api/interface/http/dto"
 	"go-tonify-backend/internal/api/interface/http/validator"
+	"go-tonify-backend/internal/domain/account/model"
 	"go-tonify-backend/internal/utils"
 	"net/http"
 )
@@ -24,6 +25,48 @@ func getAccountID(ctx *gin.Context) (*int64, error) {
 	return &accountID, nil
 }
 
+func getSessionID(ctx *gin.Context) (*string, error) {
+	sessionIDValue, exist := ctx.Get(dto.SessionIDKey)
+	if !exist {
+		return nil, dto.MissingSessionIDError
+	}
+	if sessionIDValue == nil {
+		return nil, dto.NilError
+	}
+	sessionID, ok := sessionIDValue.(string)
+	if !ok {
+		return nil, dto.CastTypeError
+	}
+	return &sessionID, nil
+}
+
+func getActiveRole(ctx *gin.Context) (model.Role, error) {
+	activeRoleValue, exist := ctx.Get(dto.ActiveRoleKey)
+	if !exist {
+		return model.UnknownRole, dto.MissingActiveRoleError
+	}
+	activeRole, ok := activeRoleValue.(model.Role)
+	if !ok {
+		return model.UnknownRole, dto.CastTypeError
+	}
+	return activeRole, nil
+}
+
+func getDevice(ctx *gin.Context) model.Device {
+	return model.Device{
+		UserAgent: ctx.GetHeader(dto.UserAgentHeaderKey),
+		Platform:  ctx.GetHeader(dto.TelegramPlatformKey),
+		IP:        ctx.ClientIP(),
+	}
+}
+
+func getAuditSource(ctx *gin.Context, actorID *int64) model.AuditSource {
+	return model.AuditSource{
+		ActorID: actorID,
+		IP:      ctx.ClientIP(),
+	}
+}
+
 func successResponse[T any](ctx *gin.Context, code int, model T) {
 	var response = dto.Response{
 		Response: &model,
@@ -0,0 +1,149 @@
+package v1
+
+import (
+	"github.com/gin-gonic/gin"
+	"go-tonify-backend/internal/api/interface/http/dto"
+	"go-tonify-backend/internal/api/interface/http/v1/converter"
+	"go-tonify-backend/internal/api/interface/http/validator"
+	"go-tonify-backend/internal/container"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/account/usecase"
+	"go-tonify-backend/pkg/logger"
+	"net/http"
+)
+
+type WalletHandler struct {
+	container     container.Container
+	validation    validator.HttpValidator
+	walletUsecase usecase.Wallet
+}
+
+func NewWalletHandler(
+	container container.Container,
+	validation validator.HttpValidator,
+	walletUsecase usecase.Wallet,
+) *WalletHandler {
+	return &WalletHandler{
+		container:     container,
+		validation:    validation,
+		walletUsecase: walletUsecase,
+	}
+}
+
+// Nonce godoc
+//
+//	@Summary		Issue a wallet nonce
+//	@Description	Issue a single use payload the TON wallet has to sign in its ton_proof to be linked to the authenticated user's account
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization	header		string									true	"account's access token"
+//	@Success		201				{object}	dto.Response{response=dto.WalletNonce}	"nonce payload"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}		"the authorization token is invalid/expired/missing"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}		"detailed error message"
+//	@Router			/v1/account/wallet/nonce [post]
+//	@Security		ApiKeyAuth
+func (w *WalletHandler) Nonce(ctx *gin.Context) {
+	log := w.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	walletNonceModel, err := w.walletUsecase.IssueNonce(ctx, *accountID)
+	if err != nil {
+		log.Error("fail to issue wallet nonce", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	successResponse(ctx, http.StatusCreated, converter.ConvertModel2WalletNonceResponse(walletNonceModel))
+}
+
+// Link godoc
+//
+//	@Summary		Link a TON wallet
+//	@Description	Verify the TON Connect ton_proof of a wallet and link the wallet to the authenticated user's account.
+//	@Description	The proof payload must be a nonce issued by /v1/account/wallet/nonce, every nonce can be used once
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Param			request			body		dto.LinkWallet						true	"wallet address with its ton_proof"
+//	@Success		200				{object}	dto.Response{response=dto.Wallet}	"linked wallet"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		409				{object}	dto.Response{response=dto.Empty}	"the wallet is linked to another account"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/account/wallet [post]
+//	@Security		ApiKeyAuth
+func (w *WalletHandler) Link(ctx *gin.Context) {
+	log := w.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	var linkWalletRequest dto.LinkWallet
+	if err := ctx.ShouldBindJSON(&linkWalletRequest); err != nil {
+		log.Error("fail to bind link wallet", logger.FError(err))
+		badRequestResponse(ctx, w.validation, dto.BadRequestError, err)
+		return
+	}
+	linkWallet := model.LinkWallet{
+		AccountID: *accountID,
+		Address:   linkWalletRequest.Address,
+		Proof: model.WalletProof{
+			Timestamp:         linkWalletRequest.Proof.Timestamp,
+			DomainLengthBytes: linkWalletRequest.Proof.Domain.LengthBytes,
+			DomainValue:       linkWalletRequest.Proof.Domain.Value,
+			Payload:           linkWalletRequest.Proof.Payload,
+			Signature:         linkWalletRequest.Proof.Signature,
+			StateInit:         linkWalletRequest.Proof.StateInit,
+		},
+	}
+	walletAddress, err := w.walletUsecase.LinkWallet(ctx, linkWallet)
+	if err != nil {
+		log.Error("fail to link wallet", logger.FError(err))
+		switch err {
+		case model.InvalidWalletProofError:
+			failResponse(ctx, http.StatusBadRequest, dto.InvalidWalletProofError, err)
+		case model.WalletAlreadyLinkedError:
+			failResponse(ctx, http.StatusConflict, dto.WalletAlreadyLinkedError, err)
+		default:
+			failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		}
+		return
+	}
+	successResponse(ctx, http.StatusOK, dto.Wallet{Address: *walletAddress})
+}
+
+// Unlink godoc
+//
+//	@Summary		Unlink the TON wallet
+//	@Description	Unlink the TON wallet from the authenticated user's account
+//	@Tags			account
+//	@Produce		json
+//	@Param			Authorization	header		string								true	"account's access token"
+//	@Success		200				{object}	dto.Response{response=string}		"returns ok string"
+//	@Failure		400				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Failure		401				{object}	dto.Response{response=dto.Empty}	"the authorization token is invalid/expired/missing"
+//	@Failure		500				{object}	dto.Response{response=dto.Empty}	"detailed error message"
+//	@Router			/v1/account/wallet [delete]
+//	@Security		ApiKeyAuth
+func (w *WalletHandler) Unlink(ctx *gin.Context) {
+	log := w.container.GetLogger()
+	accountID, err := getAccountID(ctx)
+	if err != nil {
+		log.Error("fail to get account id", logger.FError(err))
+		failResponse(ctx, http.StatusBadRequest, err, nil)
+		return
+	}
+	if err := w.walletUsecase.UnlinkWallet(ctx, *accountID); err != nil {
+		log.Error("fail to unlink wallet", logger.FError(err))
+		failResponse(ctx, http.StatusInternalServerError, dto.FailProcessRequestError, err)
+		return
+	}
+	successResponse(ctx, http.StatusOK, "ok")
+}
@@ -6,7 +6,10 @@ import (
 	"go-tonify-backend/internal/api/interface/http/dto"
 	"go-tonify-backend/internal/container"
 	"go-tonify-backend/internal/infrastructure/config"
+	"go-tonify-backend/pkg/jwt"
 	"go-tonify-backend/pkg/logger"
+	"go-tonify-backend/pkg/telegram"
+	"go-tonify-backend/pkg/ton"
 	"testing"
 	"time"
 )
@@ -25,6 +28,18 @@ func (f *fakeContainer) GetTelegramBotToken() string {
 	return ""
 }
 
+func (f *fakeContainer) GetTelegramMiniAppURL() string {
+	return ""
+}
+
+func (f *fakeContainer) GetTelegramInitData() telegram.InitData {
+	return telegram.InitData{}
+}
+
+func (f *fakeContainer) GetTelegramLoginWidget() telegram.LoginWidget {
+	return telegram.LoginWidget{}
+}
+
 func (f *fakeContainer) GetAWSConfig() *config.AWS {
 	return nil
 }
@@ -33,14 +48,38 @@ func (f *fakeContainer) GetDBConnection() *sql.DB {
 	return nil
 }
 
-func (f *fakeContainer) GetJWTSecretKey() string {
-	return ""
+func (f *fakeContainer) GetJWTKeyring() *jwt.Keyring {
+	return nil
 }
 
 func (f *fakeContainer) GetServerConfig() *config.Server {
 	return nil
 }
 
+func (f *fakeContainer) GetAccountConfig() *config.Account {
+	return nil
+}
+
+func (f *fakeContainer) GetRateLimitConfig() *config.RateLimit {
+	return nil
+}
+
+func (f *fakeContainer) GetTonConnectConfig() *config.TonConnect {
+	return nil
+}
+
+func (f *fakeContainer) GetTonProofVerifier() ton.ProofVerifier {
+	return ton.ProofVerifier{}
+}
+
+func (f *fakeContainer) GetMatchConfig() *config.Match {
+	return nil
+}
+
+func (f *fakeContainer) GetStaffConfig() *config.Staff {
+	return nil
+}
+
 func (f *fakeContainer) GetAccessJWTExpiresIn() time.Duration {
 	return 0
 }
@@ -55,7 +94,7 @@ func TestEnumValidation(t *testing.T) {
 		Gender dto.Gender `json:"gender" validate:"required,enum_validate"`
 	}
 	test.Gender = dto.MaleGender
-	test.Role = "ff"
+	test.Role = dto.ClientRole
 	box := NewFakeContainer()
 	v := validator.New()
 	testValidator := NewValidator(box)
@@ -3,7 +3,11 @@ package container
 import (
 	"database/sql"
 	"go-tonify-backend/internal/infrastructure/config"
+	"go-tonify-backend/pkg/jwt"
 	"go-tonify-backend/pkg/logger"
+	"go-tonify-backend/pkg/telegram"
+	"go-tonify-backend/pkg/ton"
+	"net/url"
 	"time"
 )
 
@@ -11,10 +15,18 @@ type Container interface {
 	GetLogger() logger.Logger
 	GetTelegramBotToken() string
 	GetTelegramMiniAppURL() string
+	GetTelegramInitData() telegram.InitData
+	GetTelegramLoginWidget() telegram.LoginWidget
 	GetAWSConfig() *config.AWS
 	GetDBConnection() *sql.DB
-	GetJWTSecretKey() string
+	GetJWTKeyring() *jwt.Keyring
 	GetServerConfig() *config.Server
+	GetAccountConfig() *config.Account
+	GetRateLimitConfig() *config.RateLimit
+	GetTonConnectConfig() *config.TonConnect
+	GetTonProofVerifier() ton.ProofVerifier
+	GetMatchConfig() *config.Match
+	GetStaffConfig() *config.Staff
 	GetAccessJWTExpiresIn() time.Duration
 	GetRefreshJWTExpiresIn() time.Duration
 }
@@ -45,6 +57,25 @@ func (c *container) GetTelegramMiniAppURL() string {
 	return c.config.Telegram.MiniAppURL
 }
 
+func (c *container) GetTelegramInitData() telegram.InitData {
+	telegramConfig := c.config.Telegram
+	return telegram.InitData{
+		Mode:      telegramConfig.InitDataValidationMode,
+		Token:     telegramConfig.BotToken,
+		BotID:     telegramConfig.BotID,
+		PublicKey: telegramConfig.PublicKey,
+		MaxAge:    telegramConfig.InitDataMaxAge,
+	}
+}
+
+func (c *container) GetTelegramLoginWidget() telegram.LoginWidget {
+	telegramConfig := c.config.Telegram
+	return telegram.LoginWidget{
+		Token:  telegramConfig.BotToken,
+		MaxAge: telegramConfig.InitDataMaxAge,
+	}
+}
+
 func (c *container) GetAWSConfig() *config.AWS {
 	return c.config.AWS
 }
@@ -53,8 +84,31 @@ func (c *container) GetDBConnection() *sql.DB {
 	return c.conn
 }
 
-func (c *container) GetJWTSecretKey() string {
-	return c.config.Server.JWTSecretKey
+func (c *container) GetJWTKeyring() *jwt.Keyring {
+	serverConfig := c.config.Server
+	activeKey := jwt.Key{
+		ID:     serverConfig.JWTSecretKeyID,
+		Secret: []byte(serverConfig.JWTSecretKey),
+	}
+	retiredKeys := make([]jwt.Key, 0, len(serverConfig.JWTRetiredSecretKeys))
+	for _, retiredSecretKey := range serverConfig.JWTRetiredSecretKeys {
+		retiredAt := retiredSecretKey.RetiredAt
+		retiredKeys = append(retiredKeys, jwt.Key{
+			ID:        retiredSecretKey.ID,
+			Secret:    []byte(retiredSecretKey.Secret),
+			RetiredAt: &retiredAt,
+		})
+	}
+	// the tokens issued before the keyring have been signed by the active secret,
+	// they are rejected unless the moment of the keyring rollout is configured
+	var legacyKey *jwt.Key
+	if serverConfig.JWTLegacyKeyRetiredAt != nil {
+		legacyKey = &jwt.Key{
+			Secret:    []byte(serverConfig.JWTSecretKey),
+			RetiredAt: serverConfig.JWTLegacyKeyRetiredAt,
+		}
+	}
+	return jwt.NewKeyring(activeKey, retiredKeys, legacyKey, serverConfig.JWTKeyGracePeriod)
 }
 
 func (c *container) GetAccessJWTExpiresIn() time.Duration {
@@ -69,6 +123,40 @@ func (c *container) GetServerConfig() *config.Server {
 	return c.config.Server
 }
 
+func (c *container) GetAccountConfig() *config.Account {
+	return c.config.Account
+}
+
+func (c *container) GetRateLimitConfig() *config.RateLimit {
+	return c.config.RateLimit
+}
+
+func (c *container) GetTonConnectConfig() *config.TonConnect {
+	return c.config.TonConnect
+}
+
+func (c *container) GetTonProofVerifier() ton.ProofVerifier {
+	tonConnectConfig := c.config.TonConnect
+	domains := tonConnectConfig.Domains
+	if len(domains) == 0 {
+		if miniAppURL, err := url.Parse(c.config.Telegram.MiniAppURL); err == nil && len(miniAppURL.Host) > 0 {
+			domains = []string{miniAppURL.Host}
+		}
+	}
+	return ton.ProofVerifier{
+		Domains: domains,
+		MaxAge:  tonConnectConfig.ProofMaxAge,
+	}
+}
+
+func (c *container) GetMatchConfig() *config.Match {
+	return c.config.Match
+}
+
+func (c *container) GetStaffConfig() *config.Staff {
+	return c.config.Staff
+}
+
 func (c *container) GetLogger() logger.Logger {
 	return c.logger
 }
@@ -7,19 +7,22 @@ import (
 
 func ConvertEntity2AccountModel(accountEntity *entity.Account) *model.Account {
 	account := model.Account{
-		ID:         accountEntity.ID,
-		TelegramID: accountEntity.TelegramID,
-		FirstName:  accountEntity.FirstName,
-		MiddleName: accountEntity.MiddleName,
-		LastName:   accountEntity.LastName,
-		Role:       accountEntity.Role.String(),
-		Nickname:   accountEntity.Nickname,
-		AboutMe:    accountEntity.AboutMe,
-		Gender:     accountEntity.Gender.String(),
-		Country:    accountEntity.Country,
-		Location:   accountEntity.Location,
-		CreatedAt:  accountEntity.CreatedAt,
-		UpdatedAt:  accountEntity.UpdatedAt,
+		ID:                 accountEntity.ID,
+		TelegramID:         accountEntity.TelegramID,
+		FirstName:          accountEntity.FirstName,
+		MiddleName:         accountEntity.MiddleName,
+		LastName:           accountEntity.LastName,
+		Role:               accountEntity.Role.String(),
+		Nickname:           accountEntity.Nickname,
+		AboutMe:            accountEntity.AboutMe,
+		Gender:             accountEntity.Gender.String(),
+		Country:            accountEntity.Country,
+		Location:           accountEntity.Location,
+		CreatedAt:          accountEntity.CreatedAt,
+		UpdatedAt:          accountEntity.UpdatedAt,
+		VerificationStatus: model.VerificationStatus(accountEntity.VerificationStatus.String()),
+		VerificationReason: accountEntity.VerificationReason,
+		WalletAddress:      accountEntity.WalletAddress,
 	}
 	if accountEntity.Company != nil {
 		company := model.Company{
@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/entity"
+)
+
+func ConvertEntity2AuditModel(auditEntity *entity.AccountAudit) *model.AccountAudit {
+	return &model.AccountAudit{
+		ID:             auditEntity.ID,
+		AccountID:      auditEntity.AccountID,
+		ActorAccountID: auditEntity.ActorAccountID,
+		Action:         model.AuditAction(auditEntity.Action),
+		Diff:           auditEntity.Diff,
+		SourceIP:       auditEntity.SourceIP,
+		CreatedAt:      auditEntity.CreatedAt,
+	}
+}
+
+func ConvertEntities2AuditModels(auditEntities []entity.AccountAudit) []model.AccountAudit {
+	audits := make([]model.AccountAudit, 0, len(auditEntities))
+	for _, auditEntity := range auditEntities {
+		audits = append(audits, *ConvertEntity2AuditModel(&auditEntity))
+	}
+	return audits
+}
@@ -0,0 +1,18 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/entity"
+)
+
+func ConvertEntity2ExportModel(exportEntity *entity.AccountExport) *model.AccountExport {
+	return &model.AccountExport{
+		ID:          exportEntity.ID,
+		AccountID:   exportEntity.AccountID,
+		Status:      model.ExportStatus(exportEntity.Status.String()),
+		FileName:    exportEntity.FileName,
+		CreatedAt:   exportEntity.CreatedAt,
+		CompletedAt: exportEntity.CompletedAt,
+		ExpiresAt:   exportEntity.ExpiresAt,
+	}
+}
@@ -0,0 +1,16 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/entity"
+)
+
+func ConvertEntity2MatchPreferenceModel(preferenceEntity *entity.MatchPreference) *model.MatchPreference {
+	return &model.MatchPreference{
+		Countries:    preferenceEntity.Countries,
+		CategoryIDs:  preferenceEntity.CategoryIDs,
+		RequiredTags: preferenceEntity.RequiredTags,
+		VerifiedOnly: preferenceEntity.VerifiedOnly,
+		HasCompany:   preferenceEntity.HasCompany,
+	}
+}
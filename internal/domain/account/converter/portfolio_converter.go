@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/entity"
+)
+
+func ConvertEntity2PortfolioItemModel(portfolioItemEntity *entity.PortfolioItem) *model.PortfolioItem {
+	portfolioItem := model.PortfolioItem{
+		ID:           portfolioItemEntity.ID,
+		Title:        portfolioItemEntity.Title,
+		Description:  portfolioItemEntity.Description,
+		ExternalLink: portfolioItemEntity.ExternalLink,
+		SortOrder:    portfolioItemEntity.SortOrder,
+		CreatedAt:    portfolioItemEntity.CreatedAt,
+		UpdatedAt:    portfolioItemEntity.UpdatedAt,
+	}
+	if attachmentEntity := portfolioItemEntity.Attachment; attachmentEntity != nil {
+		attachment := model.Attachment{
+			ID:        attachmentEntity.ID,
+			Name:      attachmentEntity.FileName,
+			CreatedAt: attachmentEntity.CreatedAt,
+			UpdatedAt: attachmentEntity.UpdatedAt,
+		}
+		if attachmentEntity.Path != nil {
+			attachment.Path = *attachmentEntity.Path
+		}
+		portfolioItem.Attachment = &attachment
+	}
+	return &portfolioItem
+}
+
+func ConvertEntities2PortfolioItemModels(portfolioItemEntities []entity.PortfolioItem) []model.PortfolioItem {
+	portfolioItems := make([]model.PortfolioItem, 0, len(portfolioItemEntities))
+	for _, portfolioItemEntity := range portfolioItemEntities {
+		portfolioItems = append(portfolioItems, *ConvertEntity2PortfolioItemModel(&portfolioItemEntity))
+	}
+	return portfolioItems
+}
@@ -0,0 +1,16 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/entity"
+)
+
+func ConvertEntity2PrivacyModel(privacyEntity *entity.AccountPrivacy) *model.Privacy {
+	return &model.Privacy{
+		LastName:           model.Visibility(privacyEntity.LastName.String()),
+		Location:           model.Visibility(privacyEntity.Location.String()),
+		Company:            model.Visibility(privacyEntity.Company.String()),
+		DocumentAttachment: model.Visibility(privacyEntity.DocumentAttachment.String()),
+		TelegramID:         model.Visibility(privacyEntity.TelegramID.String()),
+	}
+}
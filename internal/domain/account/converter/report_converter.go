@@ -0,0 +1,45 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/entity"
+)
+
+func ConvertEntity2ReportModel(reportEntity *entity.Report) *model.Report {
+	report := model.Report{
+		ID:          reportEntity.ID,
+		ReporterID:  reportEntity.ReporterID,
+		ReportedID:  reportEntity.ReportedID,
+		Reason:      model.ReportReason(reportEntity.Reason.String()),
+		Description: reportEntity.Description,
+		Status:      model.ReportStatus(reportEntity.Status.String()),
+		ReviewerID:  reportEntity.ReviewerID,
+		CreatedAt:   reportEntity.CreatedAt,
+		ReviewedAt:  reportEntity.ReviewedAt,
+	}
+	if attachmentEntity := reportEntity.Attachment; attachmentEntity != nil {
+		attachment := model.Attachment{
+			ID:        attachmentEntity.ID,
+			Name:      attachmentEntity.FileName,
+			CreatedAt: attachmentEntity.CreatedAt,
+			UpdatedAt: attachmentEntity.UpdatedAt,
+		}
+		if attachmentEntity.Path != nil {
+			attachment.Path = *attachmentEntity.Path
+		}
+		report.Attachment = &attachment
+	}
+	return &report
+}
+
+func ConvertEntities2ReportModels(reportEntities []entity.Report) []model.Report {
+	reports := make([]model.Report, 0, len(reportEntities))
+	for _, reportEntity := range reportEntities {
+		reports = append(reports, *ConvertEntity2ReportModel(&reportEntity))
+	}
+	return reports
+}
+
+func ConvertReportReasonModel2Entity(reasonModel model.ReportReason) (entity.ReportReason, error) {
+	return entity.ReportReasonFromString(string(reasonModel))
+}
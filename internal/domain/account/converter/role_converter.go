@@ -0,0 +1,29 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/entity"
+)
+
+func ConvertEntity2RoleModel(roleEntity entity.Role) model.Role {
+	switch roleEntity {
+	case entity.ClientRole:
+		return model.ClientRole
+	case entity.FreelancerRole:
+		return model.FreelancerRole
+	default:
+		return model.UnknownRole
+	}
+}
+
+func ConvertEntities2RoleModels(roleEntities []entity.Role) []model.Role {
+	var roleModels = make([]model.Role, 0, len(roleEntities))
+	for _, roleEntity := range roleEntities {
+		roleModels = append(roleModels, ConvertEntity2RoleModel(roleEntity))
+	}
+	return roleModels
+}
+
+func ConvertRoleModel2Entity(roleModel model.Role) (entity.Role, error) {
+	return entity.RoleFromString(string(roleModel))
+}
@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/entity"
+)
+
+func ConvertEntity2SanctionModel(sanctionEntity *entity.AccountSanction) *model.Sanction {
+	return &model.Sanction{
+		ID:             sanctionEntity.ID,
+		AccountID:      sanctionEntity.AccountID,
+		StaffAccountID: sanctionEntity.StaffAccountID,
+		Action:         model.SanctionAction(sanctionEntity.Action),
+		Reason:         sanctionEntity.Reason,
+		SuspendedUntil: sanctionEntity.SuspendedUntil,
+		CreatedAt:      sanctionEntity.CreatedAt,
+	}
+}
+
+func ConvertEntities2SanctionModels(sanctionEntities []entity.AccountSanction) []model.Sanction {
+	sanctions := make([]model.Sanction, 0, len(sanctionEntities))
+	for _, sanctionEntity := range sanctionEntities {
+		sanctions = append(sanctions, *ConvertEntity2SanctionModel(&sanctionEntity))
+	}
+	return sanctions
+}
@@ -0,0 +1,18 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/entity"
+)
+
+func ConvertEntity2SessionModel(sessionEntity *entity.Session) *model.Session {
+	return &model.Session{
+		ID:           sessionEntity.ID,
+		Device:       sessionEntity.Device,
+		Platform:     sessionEntity.Platform,
+		UserAgent:    sessionEntity.UserAgent,
+		IP:           sessionEntity.IP,
+		CreatedAt:    sessionEntity.CreatedAt,
+		LastActiveAt: sessionEntity.LastActiveAt,
+	}
+}
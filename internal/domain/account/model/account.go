@@ -12,6 +12,7 @@ type Account struct {
 	MiddleName         *string
 	LastName           string
 	Role               string
+	Roles              *[]Role
 	Nickname           *string
 	AboutMe            *string
 	Gender             string
@@ -22,6 +23,13 @@ type Account struct {
 	Company            *Company
 	AvatarAttachment   *Attachment
 	DocumentAttachment *Attachment
+	Portfolio          *[]PortfolioItem
+	VerificationStatus VerificationStatus
+	VerificationReason *string
+	WalletAddress      *string
 	CreatedAt          *time.Time
 	UpdatedAt          *time.Time
+	// Privacy hides fields from the Audience, nil Privacy falls back to DefaultPrivacy.
+	Privacy  *Privacy
+	Audience Audience
 }
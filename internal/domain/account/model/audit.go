@@ -0,0 +1,31 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	CreateAuditAction     AuditAction = "create"
+	EditAuditAction       AuditAction = "edit"
+	RoleChangeAuditAction AuditAction = "role_change"
+	DeleteAuditAction     AuditAction = "delete"
+)
+
+// AuditSource describes who made a change of an account and from where, nil ActorID means the account itself.
+type AuditSource struct {
+	ActorID *int64
+	IP      string
+}
+
+type AccountAudit struct {
+	ID             int64
+	AccountID      int64
+	ActorAccountID *int64
+	Action         AuditAction
+	Diff           json.RawMessage
+	SourceIP       *string
+	CreatedAt      *time.Time
+}
@@ -0,0 +1,6 @@
+package model
+
+type Authorization struct {
+	AccountID int64
+	SessionID string
+}
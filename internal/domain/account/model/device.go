@@ -0,0 +1,7 @@
+package model
+
+type Device struct {
+	UserAgent string
+	Platform  string
+	IP        string
+}
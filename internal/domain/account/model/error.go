@@ -6,8 +6,42 @@ var (
 	NilError                            = errors.New("nil error")
 	DecodeTelegramInitDataError         = errors.New("decode telegram initialization data error")
 	InvalidTelegramInitDataError        = errors.New("invalid telegram initialization data provided")
+	ExpiredTelegramInitDataError        = errors.New("telegram initialization data has expired")
 	DuplicateAccountWithTelegramIDError = errors.New("an account with the specified telegram id already exists")
 	EntityNotFoundError                 = errors.New("entity not found")
 	DuplicateMatchActionError           = errors.New("duplicate match action")
 	UnhandledMatchActionError           = errors.New("unhandled match action")
+	InvalidRefreshTokenError            = errors.New("refresh token is invalid, expired or revoked")
+	RefreshTokenReusedError             = errors.New("refresh token has already been used")
+	RestorableAccountError              = errors.New("the account has been deleted and can be restored")
+	RestorePeriodExpiredError           = errors.New("the account restore period has expired")
+	SessionRevokedError                 = errors.New("session has been revoked")
+	AccountSuspendedError               = errors.New("the account is suspended")
+	AccountBannedError                  = errors.New("the account is banned")
+	InvalidSanctionError                = errors.New("invalid sanction")
+	SanctionStaffRankError              = errors.New("the account is staff of equal or higher rank")
+	UnknownVisibilityError              = errors.New("unknown visibility")
+	InvalidAccountPatchError            = errors.New("invalid account patch")
+	InvalidSearchFilterError            = errors.New("invalid search filter")
+	ExportNotReadyError                 = errors.New("the export is not ready")
+	ExportExpiredError                  = errors.New("the export has expired")
+	VerificationNotPendingError         = errors.New("the account verification is not pending")
+	InvalidVerificationReviewError      = errors.New("invalid verification review")
+	PortfolioLimitError                 = errors.New("exceeded the maximum portfolio items limit")
+	InvalidPortfolioOrderError          = errors.New("invalid portfolio order")
+	NicknameUnavailableError            = errors.New("the nickname is taken or reserved")
+	InvalidNicknameError                = errors.New("invalid nickname")
+	InvalidWalletProofError             = errors.New("invalid wallet proof")
+	WalletAlreadyLinkedError            = errors.New("the wallet is linked to another account")
+	RoleNotHeldError                    = errors.New("the account doesn't hold the role")
+	LastRoleError                       = errors.New("the last role of the account can't be removed")
+	UnmatchedPairError                  = errors.New("the accounts have unmatched")
+	NothingToRewindError                = errors.New("there is no swipe to rewind")
+	RewindLimitError                    = errors.New("exceeded the daily rewind limit")
+	RewindEndedMatchError               = errors.New("the swipe belongs to an ended match")
+	InvalidBlockError                   = errors.New("invalid block")
+	InvalidReportError                  = errors.New("invalid report")
+	ReportNotPendingError               = errors.New("the report is not pending")
+	RestoreNicknameTakenError           = errors.New("the nickname has been taken during the restore period")
+	RestoreWalletTakenError             = errors.New("the wallet has been linked to another account during the restore period")
 )
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+type ExportStatus string
+
+const (
+	PendingExportStatus    ExportStatus = "pending"
+	ProcessingExportStatus ExportStatus = "processing"
+	ReadyExportStatus      ExportStatus = "ready"
+	FailedExportStatus     ExportStatus = "failed"
+	ExpiredExportStatus    ExportStatus = "expired"
+)
+
+type AccountExport struct {
+	ID          int64
+	AccountID   int64
+	Status      ExportStatus
+	FileName    *string
+	CreatedAt   *time.Time
+	CompletedAt *time.Time
+	ExpiresAt   *time.Time
+}
@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+// Match is a mutual like seen by one of its sides, Account is the other side.
+type Match struct {
+	ID        int64
+	Account   *Account
+	CreatedAt *time.Time
+}
@@ -0,0 +1,9 @@
+package model
+
+type MatchPreference struct {
+	Countries    []string
+	CategoryIDs  []int64
+	RequiredTags []string
+	VerifiedOnly bool
+	HasCompany   *bool
+}
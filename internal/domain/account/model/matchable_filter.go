@@ -0,0 +1,5 @@
+package model
+
+type MatchableFilter struct {
+	VerifiedOnly bool
+}
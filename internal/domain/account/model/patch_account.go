@@ -0,0 +1,27 @@
+package model
+
+import (
+	commonModel "go-tonify-backend/internal/domain/model"
+)
+
+// PatchAccount follows RFC 7396, only the fields that are set change.
+type PatchAccount struct {
+	ID          int64
+	FirstName   commonModel.Optional[string]
+	MiddleName  commonModel.Optional[string]
+	LastName    commonModel.Optional[string]
+	Role        commonModel.Optional[string]
+	Nickname    commonModel.Optional[string]
+	AboutMe     commonModel.Optional[string]
+	Gender      commonModel.Optional[string]
+	Country     commonModel.Optional[string]
+	Location    commonModel.Optional[string]
+	Company     commonModel.Optional[PatchCompany]
+	Tags        commonModel.Optional[[]string]
+	CategoryIDs commonModel.Optional[[]int64]
+}
+
+type PatchCompany struct {
+	Name        commonModel.Optional[string]
+	Description commonModel.Optional[string]
+}
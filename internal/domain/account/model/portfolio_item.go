@@ -0,0 +1,33 @@
+package model
+
+import (
+	"mime/multipart"
+	"time"
+)
+
+type PortfolioItem struct {
+	ID           int64
+	Attachment   *Attachment
+	Title        string
+	Description  *string
+	ExternalLink *string
+	SortOrder    int64
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+}
+
+type AddPortfolioItem struct {
+	AccountID    int64
+	Title        string
+	Description  *string
+	ExternalLink *string
+	FileHeader   *multipart.FileHeader
+}
+
+type EditPortfolioItem struct {
+	ID           int64
+	AccountID    int64
+	Title        string
+	Description  *string
+	ExternalLink *string
+}
@@ -0,0 +1,51 @@
+package model
+
+type Visibility string
+
+const (
+	EveryoneVisibility Visibility = "everyone"
+	MatchesVisibility  Visibility = "matches"
+	NobodyVisibility   Visibility = "nobody"
+)
+
+// Audience is the relation of the viewer to the account being viewed.
+type Audience string
+
+const (
+	OwnerAudience    Audience = "owner"
+	MatchAudience    Audience = "match"
+	StrangerAudience Audience = "stranger"
+	// StaffAudience is staff reviewing the account, it sees every field like the owner.
+	StaffAudience Audience = "staff"
+)
+
+func (v Visibility) VisibleTo(audience Audience) bool {
+	switch audience {
+	case OwnerAudience, StaffAudience:
+		return true
+	case MatchAudience:
+		return v == EveryoneVisibility || v == MatchesVisibility
+	default:
+		return v == EveryoneVisibility
+	}
+}
+
+type Privacy struct {
+	LastName           Visibility
+	Location           Visibility
+	Company            Visibility
+	DocumentAttachment Visibility
+	TelegramID         Visibility
+}
+
+// DefaultPrivacy matches the column defaults of account_privacy,
+// it applies when the settings of the account weren't loaded.
+func DefaultPrivacy() Privacy {
+	return Privacy{
+		LastName:           EveryoneVisibility,
+		Location:           EveryoneVisibility,
+		Company:            EveryoneVisibility,
+		DocumentAttachment: MatchesVisibility,
+		TelegramID:         MatchesVisibility,
+	}
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"mime/multipart"
+	"time"
+)
+
+type ReportReason string
+
+const (
+	SpamReportReason                 ReportReason = "spam"
+	HarassmentReportReason           ReportReason = "harassment"
+	FakeProfileReportReason          ReportReason = "fake_profile"
+	ScamReportReason                 ReportReason = "scam"
+	InappropriateContentReportReason ReportReason = "inappropriate_content"
+	OtherReportReason                ReportReason = "other"
+)
+
+type ReportStatus string
+
+const (
+	PendingReportStatus   ReportStatus = "pending"
+	ResolvedReportStatus  ReportStatus = "resolved"
+	DismissedReportStatus ReportStatus = "dismissed"
+)
+
+type Report struct {
+	ID          int64
+	ReporterID  *int64
+	ReportedID  int64
+	Reason      ReportReason
+	Description *string
+	Attachment  *Attachment
+	Status      ReportStatus
+	ReviewerID  *int64
+	CreatedAt   *time.Time
+	ReviewedAt  *time.Time
+}
+
+type CreateReport struct {
+	ReporterID  int64
+	ReportedID  int64
+	Reason      ReportReason
+	Description *string
+	FileHeader  *multipart.FileHeader
+}
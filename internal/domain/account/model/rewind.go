@@ -0,0 +1,7 @@
+package model
+
+// Rewind is a reverted swipe, Account is the account the swipe was made on.
+type Rewind struct {
+	Action  MatchAction
+	Account *Account
+}
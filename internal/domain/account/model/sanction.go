@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+type SanctionAction string
+
+const (
+	SuspendSanctionAction SanctionAction = "suspend"
+	BanSanctionAction     SanctionAction = "ban"
+	LiftSanctionAction    SanctionAction = "lift"
+)
+
+type Sanction struct {
+	ID             int64
+	AccountID      int64
+	StaffAccountID *int64
+	Action         SanctionAction
+	Reason         string
+	SuspendedUntil *time.Time
+	CreatedAt      *time.Time
+}
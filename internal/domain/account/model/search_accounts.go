@@ -0,0 +1,22 @@
+package model
+
+type AccountSort string
+
+const (
+	RelevanceAccountSort AccountSort = "relevance"
+	NewestAccountSort    AccountSort = "newest"
+	OldestAccountSort    AccountSort = "oldest"
+)
+
+type SearchAccounts struct {
+	Query       *string
+	Role        *Role
+	Tags        []string
+	CategoryIDs []int64
+	Country     *string
+	Gender      *string
+	HasCompany  *bool
+	Sort        AccountSort
+	Offset      int64
+	Limit       int64
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+type Session struct {
+	ID           string
+	Device       string
+	Platform     *string
+	UserAgent    *string
+	IP           *string
+	Current      bool
+	CreatedAt    *time.Time
+	LastActiveAt *time.Time
+}
@@ -0,0 +1,10 @@
+package model
+
+type VerificationStatus string
+
+const (
+	UnverifiedVerificationStatus VerificationStatus = "unverified"
+	PendingVerificationStatus    VerificationStatus = "pending"
+	VerifiedVerificationStatus   VerificationStatus = "verified"
+	RejectedVerificationStatus   VerificationStatus = "rejected"
+)
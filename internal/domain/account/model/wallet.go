@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+type WalletNonce struct {
+	Payload   string
+	ExpiresAt time.Time
+}
+
+type WalletProof struct {
+	Timestamp         uint64
+	DomainLengthBytes uint32
+	DomainValue       string
+	Payload           string
+	Signature         string
+	StateInit         string
+}
+
+type LinkWallet struct {
+	AccountID int64
+	Address   string
+	Proof     WalletProof
+}
@@ -2,31 +2,105 @@ package repository
 
 import (
 	"database/sql"
+	"github.com/lib/pq"
 	"go-tonify-backend/internal/domain/entity"
 	"go-tonify-backend/pkg/psql"
 	"golang.org/x/net/context"
+	"strconv"
+	"strings"
 	"time"
 )
 
+// ActiveAccountCondition keeps accounts that are neither deleted, banned nor suspended at the moment.
+const ActiveAccountCondition = "account.deleted_at IS NULL " +
+	"AND (account.status = 'active' OR (account.status = 'suspended' AND account.suspended_until <= NOW())) "
+
+// accountListColumns are scanned by scanAccountListItem, the query joins accountListJoins.
+const accountListColumns = "" +
+	"	account.id, " +
+	"	account.telegram_id, " +
+	"	account.first_name, " +
+	"	account.middle_name, " +
+	"	account.last_name, " +
+	"	account.nickname, " +
+	"	account.role, " +
+	"	account.about_me, " +
+	"	account.gender, " +
+	"	account.country, " +
+	"	account.location, " +
+	"	account.avatar_id, " +
+	"	avatar.file_name, " +
+	"	avatar.path, " +
+	"	avatar.created_at, " +
+	"	avatar.updated_at, " +
+	"	account.document_id, " +
+	"	account.company_id, " +
+	"	company.name, " +
+	"	company.description, " +
+	"	company.created_at, " +
+	"	company.updated_at, " +
+	"	account.verification_status, " +
+	"	account.wallet_address, " +
+	"	account.created_at, " +
+	"	account.updated_at "
+
+// NicknameUniqueIndex keeps nicknames of the accounts that aren't deleted unique regardless of the case.
+const NicknameUniqueIndex = "account_nickname_unique_idx"
+
+// WalletAddressUniqueIndex keeps a wallet linked to a single account that isn't deleted.
+const WalletAddressUniqueIndex = "account_wallet_address_unique_idx"
+
+const accountListJoins = "" +
+	"LEFT JOIN company ON account.company_id = company.id " +
+	"LEFT JOIN attachment as avatar ON account.avatar_id = avatar.id "
+
 type Account interface {
 	ExistsWithTelegramID(ctx context.Context, telegramID int64) (bool, error)
+	ExistsWithNickname(ctx context.Context, nickname string, exceptID int64) (bool, error)
 	IsDeletedAccountByTelegramID(ctx context.Context, telegramID int64) (bool, error)
 	Create(ctx context.Context, account *entity.Account) (*int64, error)
 	GetByID(ctx context.Context, id int64) (*entity.Account, error)
 	GetByIDWithCompany(ctx context.Context, id int64) (*entity.Account, error)
 	GetFullDetailByID(ctx context.Context, id int64) (*entity.Account, error)
 	GetByTelegramID(ctx context.Context, telegramID int64) (*entity.Account, error)
+	GetStatusByID(ctx context.Context, id int64) (*entity.Account, error)
+	LockByID(ctx context.Context, id int64) error
 	Update(ctx context.Context, account *entity.Account) error
+	UpdateStatus(ctx context.Context, account *entity.Account) error
 	Delete(ctx context.Context, id int64) error
-	GetNumberMatchableAccounts(ctx context.Context, accountID int64, role entity.Role) (*int64, error)
-	GetMatchableAccounts(ctx context.Context, accountID int64, role entity.Role, limit int64) ([]entity.Account, error)
-	GetNumberAccountLikers(ctx context.Context, accountID int64) (*int64, error)
-	GetAccountLikers(ctx context.Context, accountID int64, offset int64, limit int64) ([]entity.Account, error)
+	GetDeletedByTelegramID(ctx context.Context, telegramID int64) (*entity.Account, error)
+	Restore(ctx context.Context, id int64) error
+	UpdateWalletAddress(ctx context.Context, id int64, walletAddress *string) error
+	GetRoles(ctx context.Context, id int64) ([]entity.Role, error)
+	HasRole(ctx context.Context, id int64, role entity.Role) (bool, error)
+	AddRole(ctx context.Context, id int64, role entity.Role) error
+	RemoveRole(ctx context.Context, id int64, role entity.Role) error
+	GetPurgeableAccounts(ctx context.Context, deletedBefore time.Time, limit int64) ([]entity.Account, error)
+	HardDelete(ctx context.Context, id int64) error
+	GetNumberMatchableAccounts(ctx context.Context, accountID int64, filter entity.MatchableAccountFilter) (*int64, error)
+	GetMatchableCandidates(ctx context.Context, accountID int64, filter entity.MatchableAccountFilter, poolSize int64) ([]entity.MatchCandidate, error)
+	GetListByIDs(ctx context.Context, ids []int64) ([]entity.Account, error)
+	GetNumberAccountLikers(ctx context.Context, accountID int64, likedRole entity.Role) (*int64, error)
+	GetAccountLikers(ctx context.Context, accountID int64, likedRole entity.Role, offset int64, limit int64) ([]entity.Account, error)
 	ExistsLike(ctx context.Context, likeAccount entity.LikeAccount) (bool, error)
+	IsMatched(ctx context.Context, accountID int64, otherAccountID int64) (bool, error)
+	BlockAccount(ctx context.Context, blockAccount entity.BlockAccount) error
+	IsBlocked(ctx context.Context, accountID int64, otherAccountID int64) (bool, error)
+	GetBlocksByBlockerID(ctx context.Context, blockerID int64) ([]entity.BlockAccount, error)
+	Search(ctx context.Context, filter entity.AccountSearchFilter, offset int64, limit int64) ([]entity.Account, error)
+	GetNumberSearchAccounts(ctx context.Context, filter entity.AccountSearchFilter) (*int64, error)
+	GetLikesByLikerID(ctx context.Context, likerID int64) ([]entity.LikeAccount, error)
+	GetLikesByLikedID(ctx context.Context, likedID int64) ([]entity.LikeAccount, error)
+	GetDislikesByDislikerID(ctx context.Context, dislikerID int64) ([]entity.DislikeAccount, error)
+	SubmitVerification(ctx context.Context, id int64) error
+	ReviewVerification(ctx context.Context, id int64, reviewerID int64, status entity.VerificationStatus, reason *string) (bool, error)
+	GetPendingVerificationIDs(ctx context.Context, offset int64, limit int64) ([]int64, error)
+	GetNumberPendingVerifications(ctx context.Context) (*int64, error)
 	LikeAccount(ctx context.Context, likeAccount entity.LikeAccount) error
 	DeleteLikeAccount(ctx context.Context, likeAccount entity.LikeAccount) error
 	ExistsDislike(ctx context.Context, dislikeAccount entity.DislikeAccount) (bool, error)
 	DeleteDislikes(ctx context.Context, accountID int64, pastDays int64) error
+	GetLastSwipe(ctx context.Context, accountID int64, role entity.Role) (*entity.Swipe, error)
 	DislikeAccount(ctx context.Context, dislikeAccount entity.DislikeAccount) error
 	DeleteDislikeAccount(ctx context.Context, likeAccount entity.DislikeAccount) error
 }
@@ -48,6 +122,17 @@ func (a *account) ExistsWithTelegramID(ctx context.Context, telegramID int64) (b
 	return exists, err
 }
 
+// ExistsWithNickname compares nicknames case-insensitively and ignores deleted accounts and the exceptID account.
+func (a *account) ExistsWithNickname(ctx context.Context, nickname string, exceptID int64) (bool, error) {
+	query := "SELECT EXISTS(" +
+		"	SELECT 1 FROM account " +
+		"	WHERE lower(account.nickname) = lower($1) AND account.deleted_at IS NULL AND account.id <> $2" +
+		");"
+	var exists bool
+	err := a.conn.QueryRowContext(ctx, query, nickname, exceptID).Scan(&exists)
+	return exists, err
+}
+
 func (a *account) IsDeletedAccountByTelegramID(ctx context.Context, telegramID int64) (bool, error) {
 	query := "SELECT EXISTS(SELECT 1 FROM account WHERE telegram_id=$1 AND account.deleted_at IS NOT NULL);"
 	var exists bool
@@ -312,6 +397,9 @@ func (a *account) GetFullDetailByID(ctx context.Context, id int64) (*entity.Acco
 		"	company.description," +
 		"	company.created_at," +
 		"	company.updated_at," +
+		"	account.verification_status," +
+		"	account.verification_reason," +
+		"	account.wallet_address," +
 		"	account.created_at," +
 		"	account.updated_at " +
 		"FROM account " +
@@ -341,8 +429,11 @@ func (a *account) GetFullDetailByID(ctx context.Context, id int64) (*entity.Acco
 		documentPath       sql.NullString
 		documentCreatedAt  sql.NullTime
 		documentUpdatedAt  sql.NullTime
+		verificationReason sql.NullString
+		walletAddress      sql.NullString
 		role               string
 		gender             string
+		verificationStatus string
 	)
 	var account = entity.Account{
 		ID:       id,
@@ -375,6 +466,9 @@ func (a *account) GetFullDetailByID(ctx context.Context, id int64) (*entity.Acco
 		&companyDescription,
 		&companyCreatedAt,
 		&companyUpdatedAt,
+		&verificationStatus,
+		&verificationReason,
+		&walletAddress,
 		&createdAt,
 		&updatedAt,
 	)
@@ -383,6 +477,13 @@ func (a *account) GetFullDetailByID(ctx context.Context, id int64) (*entity.Acco
 	}
 	account.Role, _ = entity.RoleFromString(role)
 	account.Gender, _ = entity.GenderFromString(gender)
+	account.VerificationStatus, _ = entity.VerificationStatusFromString(verificationStatus)
+	if verificationReason.Valid {
+		account.VerificationReason = &verificationReason.String
+	}
+	if walletAddress.Valid {
+		account.WalletAddress = &walletAddress.String
+	}
 	if middleName.Valid {
 		account.MiddleName = &middleName.String
 	}
@@ -470,6 +571,9 @@ func (a *account) GetByTelegramID(ctx context.Context, telegramID int64) (*entit
 		"	avatar_id, " +
 		"	document_id, " +
 		"	company_id, " +
+		"	status, " +
+		"	suspended_until, " +
+		"	status_reason, " +
 		"	created_at, " +
 		"	updated_at " +
 		"FROM account WHERE telegram_id = $1 AND deleted_at IS NULL;"
@@ -485,6 +589,9 @@ func (a *account) GetByTelegramID(ctx context.Context, telegramID int64) (*entit
 		documentID sql.NullInt64
 		gender     string
 		role       string
+		status     string
+		suspended  sql.NullTime
+		reason     sql.NullString
 	)
 	var account = entity.Account{
 		TelegramID: telegramID,
@@ -505,6 +612,9 @@ func (a *account) GetByTelegramID(ctx context.Context, telegramID int64) (*entit
 		&avatarID,
 		&documentID,
 		&companyID,
+		&status,
+		&suspended,
+		&reason,
 		&createdAt,
 		&updatedAt,
 	)
@@ -513,6 +623,13 @@ func (a *account) GetByTelegramID(ctx context.Context, telegramID int64) (*entit
 	}
 	account.Role, _ = entity.RoleFromString(role)
 	account.Gender, _ = entity.GenderFromString(gender)
+	account.Status, _ = entity.AccountStatusFromString(status)
+	if suspended.Valid {
+		account.SuspendedUntil = &suspended.Time
+	}
+	if reason.Valid {
+		account.StatusReason = &reason.String
+	}
 	if middleName.Valid {
 		account.MiddleName = &middleName.String
 	}
@@ -577,6 +694,65 @@ func (a *account) Update(ctx context.Context, account *entity.Account) error {
 	return err
 }
 
+// LockByID locks the account row until the end of the transaction,
+// so concurrent changes limited per account, like portfolio additions and rewinds, are serialized.
+func (a *account) LockByID(ctx context.Context, id int64) error {
+	query := "SELECT id FROM account WHERE id = $1 FOR UPDATE;"
+	var lockedID int64
+	return a.conn.QueryRowContext(ctx, query, id).Scan(&lockedID)
+}
+
+func (a *account) GetStatusByID(ctx context.Context, id int64) (*entity.Account, error) {
+	query := "SELECT " +
+		"	status, " +
+		"	suspended_until, " +
+		"	status_reason " +
+		"FROM account WHERE id = $1 AND deleted_at IS NULL;"
+	var (
+		status         string
+		suspendedUntil sql.NullTime
+		statusReason   sql.NullString
+	)
+	err := a.conn.QueryRowContext(ctx, query, id).Scan(
+		&status,
+		&suspendedUntil,
+		&statusReason,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var account = entity.Account{
+		ID: id,
+	}
+	account.Status, _ = entity.AccountStatusFromString(status)
+	if suspendedUntil.Valid {
+		account.SuspendedUntil = &suspendedUntil.Time
+	}
+	if statusReason.Valid {
+		account.StatusReason = &statusReason.String
+	}
+	return &account, nil
+}
+
+func (a *account) UpdateStatus(ctx context.Context, account *entity.Account) error {
+	query := "UPDATE account SET " +
+		"	status = $1, " +
+		"	suspended_until = $2, " +
+		"	status_reason = $3, " +
+		"	updated_at = $4 " +
+		"WHERE id = $5;"
+	_, err := a.conn.ExecContext(
+		ctx,
+		query,
+		account.Status.String(),
+		account.SuspendedUntil,
+		account.StatusReason,
+		time.Now(),
+		account.ID,
+	)
+	return err
+}
+
 func (a *account) Delete(ctx context.Context, id int64) error {
 	query := "UPDATE account SET" +
 		"	deleted_at = $1 " +
@@ -585,382 +761,515 @@ func (a *account) Delete(ctx context.Context, id int64) error {
 	return err
 }
 
-func (a *account) GetNumberMatchableAccounts(ctx context.Context, accountID int64, role entity.Role) (*int64, error) {
+func (a *account) GetDeletedByTelegramID(ctx context.Context, telegramID int64) (*entity.Account, error) {
 	query := "SELECT " +
-		"	COUNT(*) " +
-		"FROM " +
-		"	account " +
-		"LEFT JOIN like_account ON like_account.liker_id = $1 AND account.id = like_account.liked_id " +
-		"LEFT JOIN dislike_account ON dislike_account.disliker_id = $2 AND account.id = dislike_account.disliked_id " +
-		"WHERE " +
-		"	account.role = $3 " +
-		"	AND account.id != $4 " +
-		"	AND like_account.id IS NULL " +
-		"	AND dislike_account.id IS NULL;"
-	var totalRows int64
-	if err := a.conn.QueryRowContext(ctx, query, accountID, accountID, role.String(), accountID).Scan(&totalRows); err != nil {
+		"	id, " +
+		"	company_id, " +
+		"	avatar_id, " +
+		"	document_id, " +
+		"	deleted_at " +
+		"FROM account WHERE telegram_id = $1 AND deleted_at IS NOT NULL;"
+	var (
+		companyID  sql.NullInt64
+		avatarID   sql.NullInt64
+		documentID sql.NullInt64
+		deletedAt  sql.NullTime
+	)
+	var account = entity.Account{
+		TelegramID: telegramID,
+	}
+	err := a.conn.QueryRowContext(ctx, query, telegramID).Scan(
+		&account.ID,
+		&companyID,
+		&avatarID,
+		&documentID,
+		&deletedAt,
+	)
+	if err != nil {
 		return nil, err
 	}
-	return &totalRows, nil
+	if companyID.Valid {
+		account.CompanyID = &companyID.Int64
+	}
+	if avatarID.Valid {
+		account.AvatarAttachmentID = &avatarID.Int64
+	}
+	if documentID.Valid {
+		account.DocumentAttachmentID = &documentID.Int64
+	}
+	if deletedAt.Valid {
+		account.DeletedAt = &deletedAt.Time
+	}
+	return &account, nil
 }
 
-func (a *account) GetMatchableAccounts(ctx context.Context, accountID int64, role entity.Role, limit int64) ([]entity.Account, error) {
-	query := "SELECT" +
+// Restore keeps the nickname unless another account has taken it meanwhile, then the id is appended to it.
+// The wallet is unlinked when another account has linked it meanwhile.
+func (a *account) Restore(ctx context.Context, id int64) error {
+	query := "UPDATE account SET " +
+		"	deleted_at = NULL, " +
+		"	updated_at = $1, " +
+		"	nickname = CASE WHEN EXISTS(" +
+		"		SELECT 1 FROM account AS other " +
+		"		WHERE lower(other.nickname) = lower(account.nickname) AND other.deleted_at IS NULL AND other.id <> account.id" +
+		"	) THEN account.nickname || '_' || account.id ELSE account.nickname END, " +
+		"	wallet_address = CASE WHEN EXISTS(" +
+		"		SELECT 1 FROM account AS other " +
+		"		WHERE other.wallet_address = account.wallet_address AND other.deleted_at IS NULL AND other.id <> account.id" +
+		"	) THEN NULL ELSE account.wallet_address END " +
+		"WHERE id = $2;"
+	_, err := a.conn.ExecContext(ctx, query, time.Now(), id)
+	return err
+}
+
+// unmatchedPairExclusionCondition skips the accounts the viewer, acting in the role of the role placeholder,
+// has unmatched with or has been unmatched by.
+func unmatchedPairExclusionCondition(viewerPlaceholder string, viewerRolePlaceholder string) string {
+	return "NOT EXISTS(" +
+		"	SELECT 1 FROM match_account " +
+		"	WHERE match_account.unmatched_at IS NOT NULL AND (" +
+		"		(match_account.client_id = " + viewerPlaceholder + " AND match_account.freelancer_id = account.id AND " + viewerRolePlaceholder + " = 'client') " +
+		"		OR (match_account.freelancer_id = " + viewerPlaceholder + " AND match_account.client_id = account.id AND " + viewerRolePlaceholder + " = 'freelancer')" +
+		"	)" +
+		") "
+}
+
+// matchPreferenceCondition keeps the accounts that satisfy the preference fields of MatchableAccountFilter,
+// it expects the viewer in $1 and the countries, the category ids, the required tags and has company in $6-$9.
+// A company hidden from the viewer counts as no company.
+var matchPreferenceCondition = "" +
+	"(COALESCE(CARDINALITY($6::TEXT[]), 0) = 0 OR UPPER(account.country) = ANY($6::TEXT[])) " +
+	"AND (" +
+	"	COALESCE(CARDINALITY($7::BIGINT[]), 0) = 0 OR EXISTS(" +
+	"		SELECT 1 FROM account_category " +
+	"		WHERE account_category.account_id = account.id AND account_category.category_id = ANY($7::BIGINT[])" +
+	"	)" +
+	") " +
+	"AND NOT EXISTS(" +
+	"	SELECT 1 FROM UNNEST($8::TEXT[]) AS required_tag(title) " +
+	"	WHERE NOT EXISTS(" +
+	"		SELECT 1 FROM account_tag " +
+	"		JOIN tag ON tag.id = account_tag.tag_id " +
+	"		WHERE account_tag.account_id = account.id AND tag.title = required_tag.title" +
+	"	)" +
+	") " +
+	"AND ($9::BOOLEAN IS NULL OR (account.company_id IS NOT NULL AND " + privacyVisibleCondition("company", entity.DefaultAccountPrivacy(0).Company, "$1") + ") = $9::BOOLEAN) "
+
+// privacyVisibleCondition keeps the accounts whose privacy column lets the viewer see the field,
+// accounts without privacy settings get defaultVisibility. Without a viewer placeholder
+// only the fields visible to everyone pass.
+func privacyVisibleCondition(column string, defaultVisibility entity.Visibility, viewerPlaceholder string) string {
+	visibility := "COALESCE(" +
+		"(SELECT account_privacy." + column + " FROM account_privacy WHERE account_privacy.account_id = account.id), " +
+		"'" + defaultVisibility.String() + "'" +
+		")"
+	if len(viewerPlaceholder) == 0 {
+		return "(" + visibility + " = 'everyone') "
+	}
+	return "(" +
+		"	account.id = " + viewerPlaceholder + " " +
+		"	OR " + visibility + " = 'everyone' " +
+		"	OR (" + visibility + " = 'matches' AND EXISTS(" +
+		"		SELECT 1 FROM like_account AS forward " +
+		"		JOIN like_account AS backward " +
+		"			ON backward.liker_id = forward.liked_id AND backward.liked_id = forward.liker_id " +
+		"			AND backward.liker_role <> forward.liker_role " +
+		"		WHERE forward.liker_id = " + viewerPlaceholder + " AND forward.liked_id = account.id" +
+		"	))" +
+		") "
+}
+
+// blockedPairExclusionCondition skips the accounts the viewer has blocked or has been blocked by.
+func blockedPairExclusionCondition(viewerPlaceholder string) string {
+	return "NOT EXISTS(" +
+		"	SELECT 1 FROM block_account " +
+		"	WHERE (block_account.blocker_id = " + viewerPlaceholder + " AND block_account.blocked_id = account.id) " +
+		"		OR (block_account.blocker_id = account.id AND block_account.blocked_id = " + viewerPlaceholder + ")" +
+		") "
+}
+
+// accountHoldsRoleCondition keeps accounts that hold the role of the placeholder, an account can hold both roles.
+func accountHoldsRoleCondition(placeholder string) string {
+	return "EXISTS(" +
+		"	SELECT 1 FROM account_role " +
+		"	WHERE account_role.account_id = account.id AND account_role.role = " + placeholder +
+		") "
+}
+
+func (a *account) GetRoles(ctx context.Context, id int64) ([]entity.Role, error) {
+	query := "SELECT role FROM account_role WHERE account_id = $1 ORDER BY created_at, id;"
+	rows, err := a.conn.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	roles := make([]entity.Role, 0)
+	for rows.Next() {
+		var roleText string
+		if err := rows.Scan(&roleText); err != nil {
+			return nil, err
+		}
+		if role, err := entity.RoleFromString(roleText); err == nil {
+			roles = append(roles, role)
+		}
+	}
+	return roles, rows.Err()
+}
+
+func (a *account) HasRole(ctx context.Context, id int64, role entity.Role) (bool, error) {
+	query := "SELECT EXISTS(SELECT 1 FROM account_role WHERE account_id = $1 AND role = $2);"
+	var exists bool
+	err := a.conn.QueryRowContext(ctx, query, id, role.String()).Scan(&exists)
+	return exists, err
+}
+
+func (a *account) AddRole(ctx context.Context, id int64, role entity.Role) error {
+	query := "INSERT INTO account_role (account_id, role, created_at) VALUES ($1, $2, $3) " +
+		"ON CONFLICT (account_id, role) DO NOTHING;"
+	_, err := a.conn.ExecContext(ctx, query, id, role.String(), time.Now())
+	return err
+}
+
+func (a *account) RemoveRole(ctx context.Context, id int64, role entity.Role) error {
+	query := "DELETE FROM account_role WHERE account_id = $1 AND role = $2;"
+	_, err := a.conn.ExecContext(ctx, query, id, role.String())
+	return err
+}
+
+func (a *account) UpdateWalletAddress(ctx context.Context, id int64, walletAddress *string) error {
+	query := "UPDATE account SET " +
+		"	wallet_address = $1, " +
+		"	wallet_linked_at = CASE WHEN $1::TEXT IS NULL THEN NULL ELSE $2::TIMESTAMP END, " +
+		"	updated_at = $2 " +
+		"WHERE id = $3 AND deleted_at IS NULL;"
+	_, err := a.conn.ExecContext(ctx, query, walletAddress, time.Now(), id)
+	return err
+}
+
+func (a *account) GetPurgeableAccounts(ctx context.Context, deletedBefore time.Time, limit int64) ([]entity.Account, error) {
+	query := "SELECT " +
 		"	account.id, " +
 		"	account.telegram_id, " +
-		"	account.first_name, " +
-		"	account.middle_name, " +
-		"	account.last_name, " +
-		"	account.nickname, " +
-		"	account.role, " +
-		"	account.about_me, " +
-		"	account.gender, " +
-		"	account.country, " +
-		"	account.location, " +
+		"	account.company_id, " +
 		"	account.avatar_id, " +
-		"	avatar.file_name," +
-		"	avatar.path," +
-		"	avatar.created_at," +
-		"	avatar.updated_at," +
+		"	avatar.file_name, " +
 		"	account.document_id, " +
-		"	account.company_id, " +
-		"	company.name, " +
-		"	company.description, " +
-		"	company.created_at, " +
-		"	company.updated_at, " +
-		"	account.created_at, " +
-		"	account.updated_at " +
-		"FROM" +
-		"	account " +
-		"LEFT JOIN company ON account.company_id = company.id " +
-		"LEFT JOIN attachment as avatar ON account.avatar_id = avatar.id " +
-		"LEFT JOIN like_account ON like_account.liker_id = $1 AND account.id = like_account.liked_id " +
-		"LEFT JOIN dislike_account ON dislike_account.disliker_id = $2 AND account.id = dislike_account.disliked_id " +
-		"WHERE" +
-		"	account.role = $3 " +
-		"	AND account.id != $4 " +
-		"	AND like_account.id IS NULL " +
-		"	AND dislike_account.id IS NULL " +
-		"LIMIT $5;"
-	rows, err := a.conn.QueryContext(ctx, query, accountID, accountID, role.String(), accountID, limit)
+		"	document.file_name, " +
+		"	account.deleted_at " +
+		"FROM account " +
+		"LEFT JOIN attachment AS avatar ON avatar.id = account.avatar_id " +
+		"LEFT JOIN attachment AS document ON document.id = account.document_id " +
+		"WHERE account.deleted_at IS NOT NULL AND account.deleted_at < $1 " +
+		"ORDER BY account.deleted_at " +
+		"LIMIT $2;"
+	rows, err := a.conn.QueryContext(ctx, query, deletedBefore, limit)
 	if err != nil {
 		return nil, err
 	}
-	accounts := make([]entity.Account, 0, limit)
+	defer rows.Close()
+	accounts := make([]entity.Account, 0)
 	for rows.Next() {
 		var (
-			middleName         sql.NullString
-			nickname           sql.NullString
-			aboutMe            sql.NullString
-			companyID          sql.NullInt64
-			companyName        sql.NullString
-			companyDescription sql.NullString
-			companyCreatedAt   sql.NullTime
-			companyUpdatedAt   sql.NullTime
-			country            sql.NullString
-			location           sql.NullString
-			createdAt          sql.NullTime
-			updatedAt          sql.NullTime
-			avatarID           sql.NullInt64
-			avatarName         sql.NullString
-			avatarPath         sql.NullString
-			avatarCreatedAt    sql.NullTime
-			avatarUpdatedAt    sql.NullTime
-			documentID         sql.NullInt64
-			role               string
-			gender             string
+			companyID        sql.NullInt64
+			avatarID         sql.NullInt64
+			avatarFileName   sql.NullString
+			documentID       sql.NullInt64
+			documentFileName sql.NullString
+			deletedAt        sql.NullTime
 		)
 		var account entity.Account
-		err = rows.Scan(
+		err := rows.Scan(
 			&account.ID,
 			&account.TelegramID,
-			&account.FirstName,
-			&middleName,
-			&account.LastName,
-			&nickname,
-			&role,
-			&aboutMe,
-			&gender,
-			&country,
-			&location,
+			&companyID,
 			&avatarID,
-			&avatarName,
-			&avatarPath,
-			&avatarCreatedAt,
-			&avatarUpdatedAt,
+			&avatarFileName,
 			&documentID,
-			&companyID,
-			&companyName,
-			&companyDescription,
-			&companyCreatedAt,
-			&companyUpdatedAt,
-			&createdAt,
-			&updatedAt,
+			&documentFileName,
+			&deletedAt,
 		)
 		if err != nil {
 			return nil, err
 		}
-		account.Role, _ = entity.RoleFromString(role)
-		account.Gender, _ = entity.GenderFromString(gender)
-		if middleName.Valid {
-			account.MiddleName = &middleName.String
-		}
-		if aboutMe.Valid {
-			account.AboutMe = &aboutMe.String
-		}
-		if nickname.Valid {
-			account.Nickname = &nickname.String
-		}
 		if companyID.Valid {
-			var company = new(entity.Company)
-			company.ID = companyID.Int64
 			account.CompanyID = &companyID.Int64
-			account.Company = company
-		}
-		if companyName.Valid {
-			account.Company.Name = companyName.String
-		}
-		if companyDescription.Valid {
-			account.Company.Description = companyDescription.String
-		}
-		if companyCreatedAt.Valid {
-			account.Company.CreatedAt = &companyCreatedAt.Time
-		}
-		if companyUpdatedAt.Valid {
-			account.Company.UpdatedAt = &companyUpdatedAt.Time
-		}
-		if createdAt.Valid {
-			account.CreatedAt = &createdAt.Time
-		}
-		if updatedAt.Valid {
-			account.UpdatedAt = &updatedAt.Time
-		}
-		if country.Valid {
-			account.Country = &country.String
-		}
-		if location.Valid {
-			account.Location = &location.String
 		}
 		if avatarID.Valid {
-			var attachment = entity.Attachment{
-				ID:        avatarID.Int64,
-				Path:      new(string),
-				CreatedAt: new(time.Time),
-				UpdatedAt: new(time.Time),
+			account.AvatarAttachmentID = &avatarID.Int64
+			account.AvatarAttachment = &entity.Attachment{
+				ID:       avatarID.Int64,
+				FileName: avatarFileName.String,
 			}
-			account.AvatarAttachment = &attachment
-		}
-		if avatarName.Valid {
-			account.AvatarAttachment.FileName = avatarName.String
-		}
-		if avatarPath.Valid {
-			account.AvatarAttachment.Path = &avatarPath.String
-		}
-		if avatarCreatedAt.Valid {
-			account.AvatarAttachment.CreatedAt = &avatarCreatedAt.Time
-		}
-		if avatarUpdatedAt.Valid {
-			account.AvatarAttachment.UpdatedAt = &avatarUpdatedAt.Time
 		}
 		if documentID.Valid {
 			account.DocumentAttachmentID = &documentID.Int64
+			account.DocumentAttachment = &entity.Attachment{
+				ID:       documentID.Int64,
+				FileName: documentFileName.String,
+			}
 		}
-		if err != nil {
-			return nil, err
+		if deletedAt.Valid {
+			account.DeletedAt = &deletedAt.Time
 		}
 		accounts = append(accounts, account)
 	}
-	return accounts, nil
+	return accounts, rows.Err()
 }
 
-func (a *account) GetNumberAccountLikers(ctx context.Context, accountID int64) (*int64, error) {
-	query := "SELECT COUNT(*) as all_rows " +
-		"	FROM account " +
-		"	LEFT JOIN like_account ON account.id = like_account.liked_id " +
-		"	WHERE like_account.liked_id = $1;"
+func (a *account) HardDelete(ctx context.Context, id int64) error {
+	query := "DELETE FROM account WHERE id = $1;"
+	_, err := a.conn.ExecContext(ctx, query, id)
+	return err
+}
+
+func (a *account) GetNumberMatchableAccounts(ctx context.Context, accountID int64, filter entity.MatchableAccountFilter) (*int64, error) {
+	query := "SELECT " +
+		"	COUNT(*) " +
+		"FROM " +
+		"	account " +
+		"LEFT JOIN like_account ON like_account.liker_id = $1 AND account.id = like_account.liked_id AND like_account.liker_role = $5 " +
+		"LEFT JOIN dislike_account ON dislike_account.disliker_id = $1 AND account.id = dislike_account.disliked_id AND dislike_account.disliker_role = $5 " +
+		"WHERE " +
+		"	" + accountHoldsRoleCondition("$2") +
+		"	AND account.id != $1 " +
+		"	AND like_account.id IS NULL " +
+		"	AND dislike_account.id IS NULL " +
+		"	AND " + unmatchedPairExclusionCondition("$1", "$5") +
+		"	AND " + blockedPairExclusionCondition("$1") +
+		"	AND ($3 = FALSE OR account.verification_status = $4) " +
+		"	AND " + matchPreferenceCondition +
+		"	AND " + ActiveAccountCondition + ";"
 	var totalRows int64
-	if err := a.conn.QueryRowContext(ctx, query, accountID).Scan(&totalRows); err != nil {
+	err := a.conn.QueryRowContext(
+		ctx,
+		query,
+		accountID,
+		filter.Role.String(),
+		filter.VerifiedOnly,
+		entity.VerifiedVerificationStatus.String(),
+		filter.ViewerRole.String(),
+		pq.Array(filter.Countries),
+		pq.Array(filter.CategoryIDs),
+		pq.Array(filter.RequiredTags),
+		filter.HasCompany,
+	).Scan(&totalRows)
+	if err != nil {
 		return nil, err
 	}
 	return &totalRows, nil
 }
 
-func (a *account) GetAccountLikers(ctx context.Context, accountID int64, offset int64, limit int64) ([]entity.Account, error) {
+// GetMatchableCandidates returns the ranking features of the matchable accounts, at most poolSize of the most
+// recently active ones. An account is active when one of its sessions is used, an account without sessions
+// is as active as its last update.
+func (a *account) GetMatchableCandidates(ctx context.Context, accountID int64, filter entity.MatchableAccountFilter, poolSize int64) ([]entity.MatchCandidate, error) {
 	query := "SELECT" +
 		"	account.id, " +
-		"	account.telegram_id, " +
-		"	account.first_name, " +
-		"	account.middle_name, " +
-		"	account.last_name, " +
-		"	account.nickname, " +
-		"	account.role, " +
-		"	account.about_me, " +
-		"	account.gender, " +
-		"	account.country, " +
-		"	account.location, " +
-		"	account.avatar_id, " +
-		"	avatar.file_name," +
-		"	avatar.path," +
-		"	avatar.created_at," +
-		"	avatar.updated_at," +
-		"	account.document_id," +
-		"	account.company_id, " +
-		"	company.name, " +
-		"	company.description, " +
-		"	company.created_at, " +
-		"	company.updated_at, " +
-		"	account.created_at, " +
-		"	account.updated_at " +
+		"	(" +
+		"		SELECT COUNT(*) FROM account_tag AS candidate_tag " +
+		"		JOIN account_tag AS viewer_tag ON viewer_tag.tag_id = candidate_tag.tag_id AND viewer_tag.account_id = $1 " +
+		"		WHERE candidate_tag.account_id = account.id" +
+		"	) AS shared_tags, " +
+		"	(" +
+		"		SELECT COUNT(*) FROM account_category AS candidate_category " +
+		"		JOIN account_category AS viewer_category ON viewer_category.category_id = candidate_category.category_id AND viewer_category.account_id = $1 " +
+		"		WHERE candidate_category.account_id = account.id" +
+		"	) AS shared_categories, " +
+		"	COALESCE(LOWER(account.country) = LOWER(viewer.country), FALSE) AS same_country, " +
+		"	(CASE WHEN account.nickname IS NOT NULL THEN 1 ELSE 0 END) + " +
+		"	(CASE WHEN COALESCE(account.about_me, '') <> '' THEN 1 ELSE 0 END) + " +
+		"	(CASE WHEN account.avatar_id IS NOT NULL THEN 1 ELSE 0 END) + " +
+		"	(CASE WHEN COALESCE(account.location, '') <> '' THEN 1 ELSE 0 END) + " +
+		"	(CASE WHEN account.company_id IS NOT NULL THEN 1 ELSE 0 END) + " +
+		"	(CASE WHEN EXISTS(SELECT 1 FROM account_tag WHERE account_tag.account_id = account.id) THEN 1 ELSE 0 END) + " +
+		"	(CASE WHEN EXISTS(SELECT 1 FROM account_category WHERE account_category.account_id = account.id) THEN 1 ELSE 0 END) + " +
+		"	(CASE WHEN EXISTS(SELECT 1 FROM account_portfolio_item WHERE account_portfolio_item.account_id = account.id) THEN 1 ELSE 0 END) " +
+		"	AS filled_fields, " +
+		"	COALESCE(" +
+		"		(SELECT MAX(account_session.last_active_at) FROM account_session WHERE account_session.account_id = account.id), " +
+		"		account.updated_at, " +
+		"		account.created_at" +
+		"	) AS last_active_at, " +
+		"	EXISTS(" +
+		"		SELECT 1 FROM like_account AS backward " +
+		"		WHERE backward.liker_id = account.id AND backward.liked_id = $1 AND backward.liker_role = $2" +
+		"	) AS liked_viewer " +
 		"FROM" +
 		"	account " +
-		"LEFT JOIN company ON account.company_id = company.id " +
-		"LEFT JOIN attachment as avatar ON account.avatar_id = avatar.id " +
-		"LEFT JOIN like_account ON account.id = like_account.liker_id " +
+		"JOIN account AS viewer ON viewer.id = $1 " +
+		"LEFT JOIN like_account ON like_account.liker_id = $1 AND account.id = like_account.liked_id AND like_account.liker_role = $5 " +
+		"LEFT JOIN dislike_account ON dislike_account.disliker_id = $1 AND account.id = dislike_account.disliked_id AND dislike_account.disliker_role = $5 " +
 		"WHERE" +
-		"	like_account.liked_id = $1 " +
-		"LIMIT $2 " +
-		"OFFSET $3;"
-	rows, err := a.conn.QueryContext(ctx, query, accountID, limit, offset)
+		"	" + accountHoldsRoleCondition("$2") +
+		"	AND account.id != $1 " +
+		"	AND like_account.id IS NULL " +
+		"	AND dislike_account.id IS NULL " +
+		"	AND " + unmatchedPairExclusionCondition("$1", "$5") +
+		"	AND " + blockedPairExclusionCondition("$1") +
+		"	AND ($3 = FALSE OR account.verification_status = $4) " +
+		"	AND " + matchPreferenceCondition +
+		"	AND " + ActiveAccountCondition +
+		"ORDER BY last_active_at DESC, account.id " +
+		"LIMIT $10;"
+	rows, err := a.conn.QueryContext(
+		ctx,
+		query,
+		accountID,
+		filter.Role.String(),
+		filter.VerifiedOnly,
+		entity.VerifiedVerificationStatus.String(),
+		filter.ViewerRole.String(),
+		pq.Array(filter.Countries),
+		pq.Array(filter.CategoryIDs),
+		pq.Array(filter.RequiredTags),
+		filter.HasCompany,
+		poolSize,
+	)
 	if err != nil {
 		return nil, err
 	}
-	accounts := make([]entity.Account, 0, limit)
+	defer rows.Close()
+	candidates := make([]entity.MatchCandidate, 0)
 	for rows.Next() {
 		var (
-			middleName         sql.NullString
-			nickname           sql.NullString
-			aboutMe            sql.NullString
-			companyID          sql.NullInt64
-			companyName        sql.NullString
-			companyDescription sql.NullString
-			companyCreatedAt   sql.NullTime
-			companyUpdatedAt   sql.NullTime
-			country            sql.NullString
-			location           sql.NullString
-			createdAt          sql.NullTime
-			updatedAt          sql.NullTime
-			avatarID           sql.NullInt64
-			avatarName         sql.NullString
-			avatarPath         sql.NullString
-			avatarCreatedAt    sql.NullTime
-			avatarUpdatedAt    sql.NullTime
-			documentID         sql.NullInt64
-			role               string
-			gender             string
+			candidate    entity.MatchCandidate
+			lastActiveAt sql.NullTime
 		)
-		var account entity.Account
-		err = rows.Scan(
-			&account.ID,
-			&account.TelegramID,
-			&account.FirstName,
-			&middleName,
-			&account.LastName,
-			&nickname,
-			&role,
-			&aboutMe,
-			&gender,
-			&country,
-			&location,
-			&avatarID,
-			&avatarName,
-			&avatarPath,
-			&avatarCreatedAt,
-			&avatarUpdatedAt,
-			&documentID,
-			&companyID,
-			&companyName,
-			&companyDescription,
-			&companyCreatedAt,
-			&companyUpdatedAt,
-			&createdAt,
-			&updatedAt,
+		err := rows.Scan(
+			&candidate.AccountID,
+			&candidate.SharedTags,
+			&candidate.SharedCategories,
+			&candidate.SameCountry,
+			&candidate.FilledFields,
+			&lastActiveAt,
+			&candidate.LikedViewer,
 		)
 		if err != nil {
 			return nil, err
 		}
-		account.Role, _ = entity.RoleFromString(role)
-		account.Gender, _ = entity.GenderFromString(gender)
-		if middleName.Valid {
-			account.MiddleName = &middleName.String
-		}
-		if aboutMe.Valid {
-			account.AboutMe = &aboutMe.String
-		}
-		if nickname.Valid {
-			account.Nickname = &nickname.String
-		}
-		if companyID.Valid {
-			var company = new(entity.Company)
-			company.ID = companyID.Int64
-			account.CompanyID = &companyID.Int64
-			account.Company = company
-		}
-		if companyName.Valid {
-			account.Company.Name = companyName.String
-		}
-		if companyDescription.Valid {
-			account.Company.Description = companyDescription.String
-		}
-		if companyCreatedAt.Valid {
-			account.Company.CreatedAt = &companyCreatedAt.Time
-		}
-		if companyUpdatedAt.Valid {
-			account.Company.UpdatedAt = &companyUpdatedAt.Time
-		}
-		if createdAt.Valid {
-			account.CreatedAt = &createdAt.Time
-		}
-		if updatedAt.Valid {
-			account.UpdatedAt = &updatedAt.Time
-		}
-		if country.Valid {
-			account.Country = &country.String
-		}
-		if location.Valid {
-			account.Location = &location.String
-		}
-		if avatarID.Valid {
-			var attachment = entity.Attachment{
-				ID:        avatarID.Int64,
-				Path:      new(string),
-				CreatedAt: new(time.Time),
-				UpdatedAt: new(time.Time),
-			}
-			account.AvatarAttachment = &attachment
-		}
-		if avatarName.Valid {
-			account.AvatarAttachment.FileName = avatarName.String
-		}
-		if avatarPath.Valid {
-			account.AvatarAttachment.Path = &avatarPath.String
-		}
-		if avatarCreatedAt.Valid {
-			account.AvatarAttachment.CreatedAt = &avatarCreatedAt.Time
-		}
-		if avatarUpdatedAt.Valid {
-			account.AvatarAttachment.UpdatedAt = &avatarUpdatedAt.Time
+		if lastActiveAt.Valid {
+			candidate.LastActiveAt = &lastActiveAt.Time
 		}
-		if documentID.Valid {
-			account.DocumentAttachmentID = &documentID.Int64
+		candidates = append(candidates, candidate)
+	}
+	return candidates, rows.Err()
+}
+
+// GetListByIDs returns the active accounts with the ids in no particular order.
+func (a *account) GetListByIDs(ctx context.Context, ids []int64) ([]entity.Account, error) {
+	query := "SELECT" +
+		accountListColumns +
+		"FROM" +
+		"	account " +
+		accountListJoins +
+		"WHERE" +
+		"	account.id = ANY($1) " +
+		"	AND " + ActiveAccountCondition + ";"
+	rows, err := a.conn.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	accounts := make([]entity.Account, 0, len(ids))
+	for rows.Next() {
+		account, err := scanAccountListItem(rows)
+		if err != nil {
+			return nil, err
 		}
+		accounts = append(accounts, *account)
+	}
+	return accounts, rows.Err()
+}
+
+// GetNumberAccountLikers counts the accounts that liked the account while it acts in likedRole.
+func (a *account) GetNumberAccountLikers(ctx context.Context, accountID int64, likedRole entity.Role) (*int64, error) {
+	query := "SELECT COUNT(*) as all_rows " +
+		"	FROM account " +
+		"	LEFT JOIN like_account ON account.id = like_account.liker_id " +
+		"	WHERE like_account.liked_id = $1 AND like_account.liker_role = $2 " +
+		"	AND " + blockedPairExclusionCondition("$1") +
+		"	AND " + ActiveAccountCondition + ";"
+	var totalRows int64
+	if err := a.conn.QueryRowContext(ctx, query, accountID, likedRole.Opposite().String()).Scan(&totalRows); err != nil {
+		return nil, err
+	}
+	return &totalRows, nil
+}
+
+func (a *account) GetAccountLikers(ctx context.Context, accountID int64, likedRole entity.Role, offset int64, limit int64) ([]entity.Account, error) {
+	query := "SELECT" +
+		accountListColumns +
+		"FROM" +
+		"	account " +
+		accountListJoins +
+		"LEFT JOIN like_account ON account.id = like_account.liker_id " +
+		"WHERE" +
+		"	like_account.liked_id = $1 " +
+		"	AND like_account.liker_role = $2 " +
+		"	AND " + blockedPairExclusionCondition("$1") +
+		"	AND " + ActiveAccountCondition +
+		"ORDER BY like_account.created_at DESC, like_account.id DESC " +
+		"LIMIT $3 " +
+		"OFFSET $4;"
+	rows, err := a.conn.QueryContext(ctx, query, accountID, likedRole.Opposite().String(), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	accounts := make([]entity.Account, 0, limit)
+	for rows.Next() {
+		account, err := scanAccountListItem(rows)
 		if err != nil {
 			return nil, err
 		}
-		accounts = append(accounts, account)
+		accounts = append(accounts, *account)
 	}
-	return accounts, nil
+	return accounts, rows.Err()
 }
 
 func (a *account) ExistsLike(ctx context.Context, likeAccount entity.LikeAccount) (bool, error) {
-	query := "SELECT EXISTS(SELECT 1 FROM like_account WHERE liker_id = $1 AND liked_id = $2);"
+	query := "SELECT EXISTS(SELECT 1 FROM like_account WHERE liker_id = $1 AND liked_id = $2 AND liker_role = $3);"
 	var exists bool
-	err := a.conn.QueryRowContext(ctx, query, likeAccount.LikerID, likeAccount.LikedID).Scan(&exists)
+	err := a.conn.QueryRowContext(ctx, query, likeAccount.LikerID, likeAccount.LikedID, likeAccount.LikerRole.String()).Scan(&exists)
 	return exists, err
 }
 
+// IsMatched reports whether the accounts liked each other acting in opposite roles.
+func (a *account) IsMatched(ctx context.Context, accountID int64, otherAccountID int64) (bool, error) {
+	query := "SELECT EXISTS(" +
+		"	SELECT 1 FROM like_account AS forward " +
+		"	JOIN like_account AS backward " +
+		"		ON backward.liker_id = forward.liked_id AND backward.liked_id = forward.liker_id " +
+		"		AND backward.liker_role <> forward.liker_role " +
+		"	WHERE forward.liker_id = $1 AND forward.liked_id = $2" +
+		");"
+	var matched bool
+	err := a.conn.QueryRowContext(ctx, query, accountID, otherAccountID).Scan(&matched)
+	return matched, err
+}
+
+// BlockAccount records the block unless the account has already blocked the other one.
+func (a *account) BlockAccount(ctx context.Context, blockAccount entity.BlockAccount) error {
+	query := "INSERT INTO block_account (blocker_id, blocked_id, created_at) VALUES ($1, $2, $3) " +
+		"ON CONFLICT (blocker_id, blocked_id) DO NOTHING;"
+	_, err := a.conn.ExecContext(ctx, query, blockAccount.BlockerID, blockAccount.BlockedID, time.Now())
+	return err
+}
+
+// IsBlocked reports whether one of the accounts has blocked the other, the accounts must not see or contact each other then.
+func (a *account) IsBlocked(ctx context.Context, accountID int64, otherAccountID int64) (bool, error) {
+	query := "SELECT EXISTS(" +
+		"	SELECT 1 FROM block_account " +
+		"	WHERE (blocker_id = $1 AND blocked_id = $2) OR (blocker_id = $2 AND blocked_id = $1)" +
+		");"
+	var blocked bool
+	err := a.conn.QueryRowContext(ctx, query, accountID, otherAccountID).Scan(&blocked)
+	return blocked, err
+}
+
 func (a *account) LikeAccount(ctx context.Context, likeAccount entity.LikeAccount) error {
-	query := "INSERT INTO like_account (liker_id, liked_id) VALUES ($1, $2);"
-	_, err := a.conn.ExecContext(ctx, query, likeAccount.LikerID, likeAccount.LikedID)
+	query := "INSERT INTO like_account (liker_id, liked_id, liker_role) VALUES ($1, $2, $3);"
+	_, err := a.conn.ExecContext(ctx, query, likeAccount.LikerID, likeAccount.LikedID, likeAccount.LikerRole.String())
 	if err != nil {
 		return err
 	}
@@ -970,9 +1279,9 @@ func (a *account) LikeAccount(ctx context.Context, likeAccount entity.LikeAccoun
 func (a *account) DeleteLikeAccount(ctx context.Context, likeAccount entity.LikeAccount) error {
 	query := `
 		DELETE FROM like_account
-		WHERE liker_id = $1 AND liked_id = $2
+		WHERE liker_id = $1 AND liked_id = $2 AND liker_role = $3
 	`
-	_, err := a.conn.ExecContext(ctx, query, likeAccount.LikerID, likeAccount.LikedID)
+	_, err := a.conn.ExecContext(ctx, query, likeAccount.LikerID, likeAccount.LikedID, likeAccount.LikerRole.String())
 	if err != nil {
 		return err
 	}
@@ -980,15 +1289,15 @@ func (a *account) DeleteLikeAccount(ctx context.Context, likeAccount entity.Like
 }
 
 func (a *account) ExistsDislike(ctx context.Context, dislikeAccount entity.DislikeAccount) (bool, error) {
-	query := "SELECT EXISTS(SELECT 1 FROM dislike_account WHERE disliker_id = $1 AND disliked_id = $2);"
+	query := "SELECT EXISTS(SELECT 1 FROM dislike_account WHERE disliker_id = $1 AND disliked_id = $2 AND disliker_role = $3);"
 	var exists bool
-	err := a.conn.QueryRowContext(ctx, query, dislikeAccount.DislikerID, dislikeAccount.DislikedID).Scan(&exists)
+	err := a.conn.QueryRowContext(ctx, query, dislikeAccount.DislikerID, dislikeAccount.DislikedID, dislikeAccount.DislikerRole.String()).Scan(&exists)
 	return exists, err
 }
 
 func (a *account) DislikeAccount(ctx context.Context, dislikeAccount entity.DislikeAccount) error {
-	query := "INSERT INTO dislike_account (disliker_id, disliked_id) VALUES ($1, $2);"
-	_, err := a.conn.ExecContext(ctx, query, dislikeAccount.DislikerID, dislikeAccount.DislikedID)
+	query := "INSERT INTO dislike_account (disliker_id, disliked_id, disliker_role) VALUES ($1, $2, $3);"
+	_, err := a.conn.ExecContext(ctx, query, dislikeAccount.DislikerID, dislikeAccount.DislikedID, dislikeAccount.DislikerRole.String())
 	if err != nil {
 		return err
 	}
@@ -998,9 +1307,9 @@ func (a *account) DislikeAccount(ctx context.Context, dislikeAccount entity.Disl
 func (a *account) DeleteDislikeAccount(ctx context.Context, dislikeAccount entity.DislikeAccount) error {
 	query := `
 		DELETE FROM dislike_account
-		WHERE disliker_id = $1 AND disliked_id = $2
+		WHERE disliker_id = $1 AND disliked_id = $2 AND disliker_role = $3
 	`
-	_, err := a.conn.ExecContext(ctx, query, dislikeAccount.DislikerID, dislikeAccount.DislikedID)
+	_, err := a.conn.ExecContext(ctx, query, dislikeAccount.DislikerID, dislikeAccount.DislikedID, dislikeAccount.DislikerRole.String())
 	if err != nil {
 		return err
 	}
@@ -1018,3 +1327,417 @@ func (a *account) DeleteDislikes(ctx context.Context, accountID int64, pastDays
 	}
 	return nil
 }
+
+// GetLastSwipe returns the latest like or dislike the account made acting in the role.
+func (a *account) GetLastSwipe(ctx context.Context, accountID int64, role entity.Role) (*entity.Swipe, error) {
+	query := "SELECT target_id, liked, created_at FROM (" +
+		"	SELECT liked_id AS target_id, TRUE AS liked, created_at, id FROM like_account " +
+		"	WHERE liker_id = $1 AND liker_role = $2 " +
+		"	UNION ALL " +
+		"	SELECT disliked_id AS target_id, FALSE AS liked, created_at, id FROM dislike_account " +
+		"	WHERE disliker_id = $1 AND disliker_role = $2" +
+		") AS swipe " +
+		"ORDER BY created_at DESC NULLS LAST, id DESC " +
+		"LIMIT 1;"
+	var (
+		swipe     = entity.Swipe{Role: role}
+		createdAt sql.NullTime
+	)
+	err := a.conn.QueryRowContext(ctx, query, accountID, role.String()).Scan(&swipe.TargetID, &swipe.Liked, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+	if createdAt.Valid {
+		swipe.CreatedAt = &createdAt.Time
+	}
+	return &swipe, nil
+}
+
+func (a *account) Search(ctx context.Context, filter entity.AccountSearchFilter, offset int64, limit int64) ([]entity.Account, error) {
+	condition, args, rank := buildAccountSearchCondition(filter)
+	var order string
+	switch {
+	case filter.Sort == entity.RelevanceAccountSort && len(rank) > 0:
+		order = rank + " DESC, account.id DESC "
+	case filter.Sort == entity.OldestAccountSort:
+		order = "account.created_at ASC, account.id ASC "
+	default:
+		order = "account.created_at DESC, account.id DESC "
+	}
+	args = append(args, limit, offset)
+	query := "SELECT" +
+		accountListColumns +
+		"FROM" +
+		"	account " +
+		accountListJoins +
+		"WHERE " + condition +
+		"ORDER BY " + order +
+		"LIMIT $" + strconv.Itoa(len(args)-1) + " " +
+		"OFFSET $" + strconv.Itoa(len(args)) + ";"
+	rows, err := a.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	accounts := make([]entity.Account, 0, limit)
+	for rows.Next() {
+		account, err := scanAccountListItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, *account)
+	}
+	return accounts, rows.Err()
+}
+
+func (a *account) GetNumberSearchAccounts(ctx context.Context, filter entity.AccountSearchFilter) (*int64, error) {
+	condition, args, _ := buildAccountSearchCondition(filter)
+	query := "SELECT COUNT(*) FROM account WHERE " + condition + ";"
+	var totalRows int64
+	if err := a.conn.QueryRowContext(ctx, query, args...).Scan(&totalRows); err != nil {
+		return nil, err
+	}
+	return &totalRows, nil
+}
+
+// buildAccountSearchCondition returns the WHERE condition with its numbered args
+// and the rank expression of the full-text query, rank is empty without a query.
+func buildAccountSearchCondition(filter entity.AccountSearchFilter) (string, []any, string) {
+	var (
+		conditions = []string{ActiveAccountCondition}
+		args       = make([]any, 0)
+		rank       string
+	)
+	placeholder := func(arg any) string {
+		args = append(args, arg)
+		return "$" + strconv.Itoa(len(args))
+	}
+	var viewer string
+	if filter.ViewerID != nil {
+		viewer = placeholder(*filter.ViewerID)
+		conditions = append(conditions, blockedPairExclusionCondition(viewer))
+	}
+	if filter.Query != nil && len(strings.TrimSpace(*filter.Query)) > 0 {
+		tsQuery := "websearch_to_tsquery('simple', " + placeholder(*filter.Query) + ")"
+		conditions = append(conditions, "("+
+			"account.search_vector @@ "+tsQuery+" "+
+			"OR (to_tsvector('simple', account.last_name) @@ "+tsQuery+" AND "+privacyVisibleCondition("last_name", entity.DefaultAccountPrivacy(0).LastName, viewer)+")"+
+			") ")
+		rank = "ts_rank(account.search_vector, " + tsQuery + ")"
+	}
+	if filter.Role != nil {
+		conditions = append(conditions, accountHoldsRoleCondition(placeholder(filter.Role.String())))
+	}
+	if filter.Gender != nil {
+		conditions = append(conditions, "account.gender = "+placeholder(filter.Gender.String())+" ")
+	}
+	if filter.Country != nil {
+		conditions = append(conditions, "lower(account.country) = lower("+placeholder(*filter.Country)+") ")
+	}
+	if filter.HasCompany != nil {
+		// a company hidden from the viewer counts as no company
+		companyVisible := "(account.company_id IS NOT NULL AND " + privacyVisibleCondition("company", entity.DefaultAccountPrivacy(0).Company, viewer) + ") "
+		if *filter.HasCompany {
+			conditions = append(conditions, companyVisible)
+		} else {
+			conditions = append(conditions, "NOT "+companyVisible)
+		}
+	}
+	if len(filter.Tags) > 0 {
+		conditions = append(conditions, "EXISTS("+
+			"	SELECT 1 FROM account_tag "+
+			"	JOIN tag ON tag.id = account_tag.tag_id "+
+			"	WHERE account_tag.account_id = account.id AND tag.title = ANY("+placeholder(pq.Array(filter.Tags))+")"+
+			") ")
+	}
+	if len(filter.CategoryIDs) > 0 {
+		conditions = append(conditions, "EXISTS("+
+			"	SELECT 1 FROM account_category "+
+			"	WHERE account_category.account_id = account.id AND account_category.category_id = ANY("+placeholder(pq.Array(filter.CategoryIDs))+")"+
+			") ")
+	}
+	return strings.Join(conditions, "AND "), args, rank
+}
+
+// scanAccountListItem scans a row of account list columns joined with the company and the avatar.
+func scanAccountListItem(rows *sql.Rows) (*entity.Account, error) {
+	var (
+		middleName         sql.NullString
+		nickname           sql.NullString
+		aboutMe            sql.NullString
+		companyID          sql.NullInt64
+		companyName        sql.NullString
+		companyDescription sql.NullString
+		companyCreatedAt   sql.NullTime
+		companyUpdatedAt   sql.NullTime
+		country            sql.NullString
+		location           sql.NullString
+		createdAt          sql.NullTime
+		updatedAt          sql.NullTime
+		avatarID           sql.NullInt64
+		avatarName         sql.NullString
+		avatarPath         sql.NullString
+		avatarCreatedAt    sql.NullTime
+		avatarUpdatedAt    sql.NullTime
+		documentID         sql.NullInt64
+		walletAddress      sql.NullString
+		role               string
+		gender             string
+		verificationStatus string
+	)
+	var account entity.Account
+	err := rows.Scan(
+		&account.ID,
+		&account.TelegramID,
+		&account.FirstName,
+		&middleName,
+		&account.LastName,
+		&nickname,
+		&role,
+		&aboutMe,
+		&gender,
+		&country,
+		&location,
+		&avatarID,
+		&avatarName,
+		&avatarPath,
+		&avatarCreatedAt,
+		&avatarUpdatedAt,
+		&documentID,
+		&companyID,
+		&companyName,
+		&companyDescription,
+		&companyCreatedAt,
+		&companyUpdatedAt,
+		&verificationStatus,
+		&walletAddress,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	account.Role, _ = entity.RoleFromString(role)
+	account.Gender, _ = entity.GenderFromString(gender)
+	account.VerificationStatus, _ = entity.VerificationStatusFromString(verificationStatus)
+	if middleName.Valid {
+		account.MiddleName = &middleName.String
+	}
+	if aboutMe.Valid {
+		account.AboutMe = &aboutMe.String
+	}
+	if nickname.Valid {
+		account.Nickname = &nickname.String
+	}
+	if walletAddress.Valid {
+		account.WalletAddress = &walletAddress.String
+	}
+	if companyID.Valid {
+		account.CompanyID = &companyID.Int64
+		account.Company = &entity.Company{
+			ID:          companyID.Int64,
+			Name:        companyName.String,
+			Description: companyDescription.String,
+		}
+		if companyCreatedAt.Valid {
+			account.Company.CreatedAt = &companyCreatedAt.Time
+		}
+		if companyUpdatedAt.Valid {
+			account.Company.UpdatedAt = &companyUpdatedAt.Time
+		}
+	}
+	if createdAt.Valid {
+		account.CreatedAt = &createdAt.Time
+	}
+	if updatedAt.Valid {
+		account.UpdatedAt = &updatedAt.Time
+	}
+	if country.Valid {
+		account.Country = &country.String
+	}
+	if location.Valid {
+		account.Location = &location.String
+	}
+	if avatarID.Valid {
+		account.AvatarAttachmentID = &avatarID.Int64
+		account.AvatarAttachment = &entity.Attachment{
+			ID:       avatarID.Int64,
+			FileName: avatarName.String,
+		}
+		if avatarPath.Valid {
+			account.AvatarAttachment.Path = &avatarPath.String
+		}
+		if avatarCreatedAt.Valid {
+			account.AvatarAttachment.CreatedAt = &avatarCreatedAt.Time
+		}
+		if avatarUpdatedAt.Valid {
+			account.AvatarAttachment.UpdatedAt = &avatarUpdatedAt.Time
+		}
+	}
+	if documentID.Valid {
+		account.DocumentAttachmentID = &documentID.Int64
+	}
+	return &account, nil
+}
+
+func (a *account) GetLikesByLikerID(ctx context.Context, likerID int64) ([]entity.LikeAccount, error) {
+	query := "SELECT id, liker_id, liked_id, liker_role, created_at FROM like_account WHERE liker_id = $1 ORDER BY created_at, id;"
+	return a.getLikes(ctx, query, likerID)
+}
+
+func (a *account) GetLikesByLikedID(ctx context.Context, likedID int64) ([]entity.LikeAccount, error) {
+	query := "SELECT id, liker_id, liked_id, liker_role, created_at FROM like_account WHERE liked_id = $1 ORDER BY created_at, id;"
+	return a.getLikes(ctx, query, likedID)
+}
+
+func (a *account) getLikes(ctx context.Context, query string, accountID int64) ([]entity.LikeAccount, error) {
+	rows, err := a.conn.QueryContext(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	likes := make([]entity.LikeAccount, 0)
+	for rows.Next() {
+		var (
+			like      entity.LikeAccount
+			likerRole string
+			createdAt sql.NullTime
+		)
+		if err := rows.Scan(&like.ID, &like.LikerID, &like.LikedID, &likerRole, &createdAt); err != nil {
+			return nil, err
+		}
+		like.LikerRole, _ = entity.RoleFromString(likerRole)
+		if createdAt.Valid {
+			like.CreatedAt = &createdAt.Time
+		}
+		likes = append(likes, like)
+	}
+	return likes, rows.Err()
+}
+
+func (a *account) GetBlocksByBlockerID(ctx context.Context, blockerID int64) ([]entity.BlockAccount, error) {
+	query := "SELECT id, blocker_id, blocked_id, created_at FROM block_account WHERE blocker_id = $1 ORDER BY created_at, id;"
+	rows, err := a.conn.QueryContext(ctx, query, blockerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	blocks := make([]entity.BlockAccount, 0)
+	for rows.Next() {
+		var (
+			block     entity.BlockAccount
+			createdAt sql.NullTime
+		)
+		if err := rows.Scan(&block.ID, &block.BlockerID, &block.BlockedID, &createdAt); err != nil {
+			return nil, err
+		}
+		if createdAt.Valid {
+			block.CreatedAt = &createdAt.Time
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, rows.Err()
+}
+
+func (a *account) GetDislikesByDislikerID(ctx context.Context, dislikerID int64) ([]entity.DislikeAccount, error) {
+	query := "SELECT id, disliker_id, disliked_id, disliker_role, created_at FROM dislike_account WHERE disliker_id = $1 ORDER BY created_at, id;"
+	rows, err := a.conn.QueryContext(ctx, query, dislikerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	dislikes := make([]entity.DislikeAccount, 0)
+	for rows.Next() {
+		var (
+			dislike      entity.DislikeAccount
+			dislikerRole string
+			createdAt    sql.NullTime
+		)
+		if err := rows.Scan(&dislike.ID, &dislike.DislikerID, &dislike.DislikedID, &dislikerRole, &createdAt); err != nil {
+			return nil, err
+		}
+		dislike.DislikerRole, _ = entity.RoleFromString(dislikerRole)
+		if createdAt.Valid {
+			dislike.CreatedAt = &createdAt.Time
+		}
+		dislikes = append(dislikes, dislike)
+	}
+	return dislikes, rows.Err()
+}
+
+// SubmitVerification puts the account in the review queue, a previous review is discarded.
+func (a *account) SubmitVerification(ctx context.Context, id int64) error {
+	query := "UPDATE account SET " +
+		"	verification_status = $1, " +
+		"	verification_reason = NULL, " +
+		"	verification_reviewer_id = NULL, " +
+		"	verification_updated_at = $2 " +
+		"WHERE id = $3;"
+	_, err := a.conn.ExecContext(ctx, query, entity.PendingVerificationStatus.String(), time.Now(), id)
+	return err
+}
+
+// ReviewVerification moves a pending verification to the status and reports whether the account was pending.
+func (a *account) ReviewVerification(
+	ctx context.Context,
+	id int64,
+	reviewerID int64,
+	status entity.VerificationStatus,
+	reason *string,
+) (bool, error) {
+	query := "UPDATE account SET " +
+		"	verification_status = $1, " +
+		"	verification_reason = $2, " +
+		"	verification_reviewer_id = $3, " +
+		"	verification_updated_at = $4 " +
+		"WHERE id = $5 AND verification_status = $6 AND deleted_at IS NULL;"
+	result, err := a.conn.ExecContext(
+		ctx,
+		query,
+		status.String(),
+		reason,
+		reviewerID,
+		time.Now(),
+		id,
+		entity.PendingVerificationStatus.String(),
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (a *account) GetPendingVerificationIDs(ctx context.Context, offset int64, limit int64) ([]int64, error) {
+	query := "SELECT id FROM account " +
+		"WHERE verification_status = $1 AND deleted_at IS NULL " +
+		"ORDER BY verification_updated_at, id " +
+		"LIMIT $2 " +
+		"OFFSET $3;"
+	rows, err := a.conn.QueryContext(ctx, query, entity.PendingVerificationStatus.String(), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ids := make([]int64, 0, limit)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (a *account) GetNumberPendingVerifications(ctx context.Context) (*int64, error) {
+	query := "SELECT COUNT(*) FROM account WHERE verification_status = $1 AND deleted_at IS NULL;"
+	var totalRows int64
+	if err := a.conn.QueryRowContext(ctx, query, entity.PendingVerificationStatus.String()).Scan(&totalRows); err != nil {
+		return nil, err
+	}
+	return &totalRows, nil
+}
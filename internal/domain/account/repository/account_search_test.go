@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"go-tonify-backend/internal/domain/entity"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSearchVectorExcludesPrivateFields(t *testing.T) {
+	migrations, err := filepath.Glob(filepath.Join("..", "..", "..", "..", "db", "migration", "*.up.sql"))
+	if err != nil {
+		t.Fatal("fail to list migrations", err)
+	}
+	sort.Strings(migrations)
+	var searchVector string
+	for _, migration := range migrations {
+		content, err := os.ReadFile(migration)
+		if err != nil {
+			t.Fatal("fail to read migration", err)
+		}
+		if strings.Contains(string(content), "search_vector tsvector") {
+			searchVector = string(content)
+		}
+	}
+	if len(searchVector) == 0 {
+		t.Fatal("no migration defines the search vector")
+	}
+	for _, column := range []string{"last_name", "location", "company", "telegram_id"} {
+		if strings.Contains(searchVector, column) {
+			t.Errorf("the search vector includes the private field %s", column)
+		}
+	}
+}
+
+func TestAccountSearchConditionHidesLastName(t *testing.T) {
+	query := "Melnyk"
+	viewerID := int64(1)
+	tests := []struct {
+		name     string
+		viewerID *int64
+	}{
+		{name: "viewer", viewerID: &viewerID},
+		{name: "no viewer", viewerID: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, args, _ := buildAccountSearchCondition(entity.AccountSearchFilter{
+				ViewerID: tt.viewerID,
+				Query:    &query,
+			})
+			lastNameMatch := "to_tsvector('simple', account.last_name) @@ "
+			index := strings.Index(condition, lastNameMatch)
+			if index < 0 {
+				t.Fatal("the condition doesn't search the last name", condition)
+			}
+			tsQuery := "websearch_to_tsquery('simple', $" + strconv.Itoa(len(args)) + ")"
+			if !strings.HasPrefix(condition[index:], lastNameMatch+tsQuery+" AND (") {
+				t.Error("the last name match isn't gated by the privacy", condition)
+			}
+			if !strings.Contains(condition[index:], "SELECT account_privacy.last_name FROM account_privacy") {
+				t.Error("the last name match doesn't check the last name privacy", condition)
+			}
+			if tt.viewerID == nil && strings.Contains(condition, "'matches'") {
+				t.Error("the last name is searched for matches without a viewer", condition)
+			}
+		})
+	}
+}
+
+func TestAccountSearchConditionHidesCompany(t *testing.T) {
+	viewerID := int64(1)
+	for _, hasCompany := range []bool{true, false} {
+		condition, _, _ := buildAccountSearchCondition(entity.AccountSearchFilter{
+			ViewerID:   &viewerID,
+			HasCompany: &hasCompany,
+		})
+		if !strings.Contains(condition, "SELECT account_privacy.company FROM account_privacy") {
+			t.Errorf("has company %t doesn't check the company privacy: %s", hasCompany, condition)
+		}
+	}
+}
+
+func TestAccountSearchConditionExcludesBlockedPairs(t *testing.T) {
+	viewerID := int64(1)
+	condition, args, _ := buildAccountSearchCondition(entity.AccountSearchFilter{ViewerID: &viewerID})
+	expected := blockedPairExclusionCondition("$1")
+	if !strings.Contains(condition, expected) {
+		t.Errorf("the condition doesn't exclude blocked pairs in both directions: %s", condition)
+	}
+	if len(args) != 1 || args[0] != viewerID {
+		t.Errorf("expected the viewer as the only arg, got %v", args)
+	}
+}
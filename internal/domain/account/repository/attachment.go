@@ -13,6 +13,8 @@ type Attachment interface {
 	Update(ctx context.Context, attachment *entity.Attachment) error
 	GetByID(ctx context.Context, id int64) (*entity.Attachment, error)
 	Delete(ctx context.Context, id int64) error
+	Restore(ctx context.Context, id int64) error
+	HardDelete(ctx context.Context, id int64) error
 }
 
 type attachment struct {
@@ -110,3 +112,17 @@ func (a *attachment) Delete(ctx context.Context, id int64) error {
 	)
 	return err
 }
+
+func (a *attachment) Restore(ctx context.Context, id int64) error {
+	query := "UPDATE attachment SET " +
+		"	deleted_at = NULL " +
+		"WHERE id = $1;"
+	_, err := a.conn.ExecContext(ctx, query, id)
+	return err
+}
+
+func (a *attachment) HardDelete(ctx context.Context, id int64) error {
+	query := "DELETE FROM attachment WHERE id = $1;"
+	_, err := a.conn.ExecContext(ctx, query, id)
+	return err
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/psql"
+	"time"
+)
+
+type Audit interface {
+	Create(ctx context.Context, audit *entity.AccountAudit) (int64, error)
+	GetByAccountID(ctx context.Context, accountID int64, offset int64, limit int64) ([]entity.AccountAudit, error)
+	CountByAccountID(ctx context.Context, accountID int64) (int64, error)
+}
+
+type audit struct {
+	conn psql.Operation
+}
+
+func NewAudit(conn psql.Operation) Audit {
+	return &audit{
+		conn: conn,
+	}
+}
+
+func (a *audit) Create(ctx context.Context, audit *entity.AccountAudit) (int64, error) {
+	query := "INSERT INTO account_audit (" +
+		"	account_id, " +
+		"	actor_account_id, " +
+		"	action, " +
+		"	diff, " +
+		"	source_ip, " +
+		"	created_at" +
+		") VALUES ($1, $2, $3, $4, $5, $6) RETURNING id;"
+	var id int64
+	err := a.conn.QueryRowContext(
+		ctx,
+		query,
+		audit.AccountID,
+		audit.ActorAccountID,
+		audit.Action,
+		string(audit.Diff),
+		audit.SourceIP,
+		time.Now(),
+	).Scan(&id)
+	return id, err
+}
+
+func (a *audit) GetByAccountID(ctx context.Context, accountID int64, offset int64, limit int64) ([]entity.AccountAudit, error) {
+	query := "SELECT " +
+		"	id, " +
+		"	actor_account_id, " +
+		"	action, " +
+		"	diff, " +
+		"	source_ip, " +
+		"	created_at " +
+		"FROM account_audit " +
+		"WHERE account_id = $1 " +
+		"ORDER BY created_at DESC, id DESC " +
+		"LIMIT $2 " +
+		"OFFSET $3;"
+	rows, err := a.conn.QueryContext(ctx, query, accountID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	audits := make([]entity.AccountAudit, 0)
+	for rows.Next() {
+		var (
+			actorAccountID sql.NullInt64
+			sourceIP       sql.NullString
+			createdAt      sql.NullTime
+		)
+		var audit = entity.AccountAudit{
+			AccountID: accountID,
+		}
+		err = rows.Scan(
+			&audit.ID,
+			&actorAccountID,
+			&audit.Action,
+			&audit.Diff,
+			&sourceIP,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if actorAccountID.Valid {
+			audit.ActorAccountID = &actorAccountID.Int64
+		}
+		if sourceIP.Valid {
+			audit.SourceIP = &sourceIP.String
+		}
+		if createdAt.Valid {
+			audit.CreatedAt = &createdAt.Time
+		}
+		audits = append(audits, audit)
+	}
+	return audits, rows.Err()
+}
+
+func (a *audit) CountByAccountID(ctx context.Context, accountID int64) (int64, error) {
+	query := "SELECT COUNT(*) FROM account_audit WHERE account_id = $1;"
+	var count int64
+	err := a.conn.QueryRowContext(ctx, query, accountID).Scan(&count)
+	return count, err
+}
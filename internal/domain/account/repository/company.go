@@ -12,6 +12,8 @@ type Company interface {
 	Update(ctx context.Context, company *entity.Company) error
 	GetByID(ctx context.Context, id int64) (*entity.Company, error)
 	Delete(ctx context.Context, id int64) error
+	Restore(ctx context.Context, id int64) error
+	HardDelete(ctx context.Context, id int64) error
 }
 
 type company struct {
@@ -91,3 +93,17 @@ func (c *company) Delete(ctx context.Context, id int64) error {
 	_, err := c.conn.ExecContext(ctx, query, time.Now(), id)
 	return err
 }
+
+func (c *company) Restore(ctx context.Context, id int64) error {
+	query := "UPDATE company SET " +
+		"	deleted_at = NULL " +
+		"WHERE id = $1;"
+	_, err := c.conn.ExecContext(ctx, query, id)
+	return err
+}
+
+func (c *company) HardDelete(ctx context.Context, id int64) error {
+	query := "DELETE FROM company WHERE id = $1;"
+	_, err := c.conn.ExecContext(ctx, query, id)
+	return err
+}
@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/psql"
+	"time"
+)
+
+const accountExportColumns = "" +
+	"	id, " +
+	"	account_id, " +
+	"	status, " +
+	"	file_name, " +
+	"	failure_reason, " +
+	"	created_at, " +
+	"	updated_at, " +
+	"	completed_at, " +
+	"	expires_at "
+
+type Export interface {
+	Create(ctx context.Context, accountID int64) (*entity.AccountExport, error)
+	GetByID(ctx context.Context, accountID int64, id int64) (*entity.AccountExport, error)
+	GetInProgressByAccountID(ctx context.Context, accountID int64) (*entity.AccountExport, error)
+	GetAllByAccountID(ctx context.Context, accountID int64) ([]entity.AccountExport, error)
+	ClaimPending(ctx context.Context, staleBefore time.Time, limit int64) ([]entity.AccountExport, error)
+	Complete(ctx context.Context, id int64, fileName string, expiresAt time.Time) error
+	Fail(ctx context.Context, id int64, reason string) error
+	GetExpired(ctx context.Context, now time.Time, limit int64) ([]entity.AccountExport, error)
+	Expire(ctx context.Context, id int64) error
+}
+
+type export struct {
+	conn psql.Operation
+}
+
+func NewExport(conn psql.Operation) Export {
+	return &export{
+		conn: conn,
+	}
+}
+
+func (e *export) Create(ctx context.Context, accountID int64) (*entity.AccountExport, error) {
+	query := "INSERT INTO account_export (" +
+		"	account_id, " +
+		"	status, " +
+		"	created_at" +
+		") VALUES ($1, $2, $3) RETURNING " + accountExportColumns + ";"
+	row := e.conn.QueryRowContext(ctx, query, accountID, entity.PendingExportStatus.String(), time.Now())
+	return scanAccountExport(row.Scan)
+}
+
+func (e *export) GetByID(ctx context.Context, accountID int64, id int64) (*entity.AccountExport, error) {
+	query := "SELECT " + accountExportColumns +
+		"FROM account_export " +
+		"WHERE id = $1 AND account_id = $2;"
+	row := e.conn.QueryRowContext(ctx, query, id, accountID)
+	return scanAccountExport(row.Scan)
+}
+
+func (e *export) GetInProgressByAccountID(ctx context.Context, accountID int64) (*entity.AccountExport, error) {
+	query := "SELECT " + accountExportColumns +
+		"FROM account_export " +
+		"WHERE account_id = $1 AND status IN ($2, $3) " +
+		"ORDER BY created_at DESC, id DESC " +
+		"LIMIT 1;"
+	row := e.conn.QueryRowContext(
+		ctx,
+		query,
+		accountID,
+		entity.PendingExportStatus.String(),
+		entity.ProcessingExportStatus.String(),
+	)
+	return scanAccountExport(row.Scan)
+}
+
+func (e *export) GetAllByAccountID(ctx context.Context, accountID int64) ([]entity.AccountExport, error) {
+	query := "SELECT " + accountExportColumns +
+		"FROM account_export " +
+		"WHERE account_id = $1 " +
+		"ORDER BY created_at, id;"
+	rows, err := e.conn.QueryContext(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanAccountExports(rows)
+}
+
+// ClaimPending marks pending exports, and the processing ones not touched since staleBefore,
+// as processing and returns them, concurrent callers never claim the same export.
+func (e *export) ClaimPending(ctx context.Context, staleBefore time.Time, limit int64) ([]entity.AccountExport, error) {
+	query := "UPDATE account_export SET " +
+		"	status = $1, " +
+		"	updated_at = $2 " +
+		"WHERE id IN (" +
+		"	SELECT id FROM account_export " +
+		"	WHERE status = $3 OR (status = $1 AND updated_at < $4) " +
+		"	ORDER BY created_at " +
+		"	LIMIT $5 " +
+		"	FOR UPDATE SKIP LOCKED" +
+		") RETURNING " + accountExportColumns + ";"
+	rows, err := e.conn.QueryContext(
+		ctx,
+		query,
+		entity.ProcessingExportStatus.String(),
+		time.Now(),
+		entity.PendingExportStatus.String(),
+		staleBefore,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanAccountExports(rows)
+}
+
+func (e *export) Complete(ctx context.Context, id int64, fileName string, expiresAt time.Time) error {
+	query := "UPDATE account_export SET " +
+		"	status = $1, " +
+		"	file_name = $2, " +
+		"	updated_at = $3, " +
+		"	completed_at = $3, " +
+		"	expires_at = $4 " +
+		"WHERE id = $5;"
+	_, err := e.conn.ExecContext(ctx, query, entity.ReadyExportStatus.String(), fileName, time.Now(), expiresAt, id)
+	return err
+}
+
+func (e *export) Fail(ctx context.Context, id int64, reason string) error {
+	query := "UPDATE account_export SET " +
+		"	status = $1, " +
+		"	failure_reason = $2, " +
+		"	updated_at = $3 " +
+		"WHERE id = $4;"
+	_, err := e.conn.ExecContext(ctx, query, entity.FailedExportStatus.String(), reason, time.Now(), id)
+	return err
+}
+
+func (e *export) GetExpired(ctx context.Context, now time.Time, limit int64) ([]entity.AccountExport, error) {
+	query := "SELECT " + accountExportColumns +
+		"FROM account_export " +
+		"WHERE status = $1 AND expires_at <= $2 " +
+		"ORDER BY expires_at " +
+		"LIMIT $3;"
+	rows, err := e.conn.QueryContext(ctx, query, entity.ReadyExportStatus.String(), now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanAccountExports(rows)
+}
+
+func (e *export) Expire(ctx context.Context, id int64) error {
+	query := "UPDATE account_export SET " +
+		"	status = $1, " +
+		"	file_name = NULL, " +
+		"	updated_at = $2 " +
+		"WHERE id = $3;"
+	_, err := e.conn.ExecContext(ctx, query, entity.ExpiredExportStatus.String(), time.Now(), id)
+	return err
+}
+
+func scanAccountExports(rows *sql.Rows) ([]entity.AccountExport, error) {
+	exports := make([]entity.AccountExport, 0)
+	for rows.Next() {
+		export, err := scanAccountExport(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		exports = append(exports, *export)
+	}
+	return exports, rows.Err()
+}
+
+func scanAccountExport(scan func(dest ...any) error) (*entity.AccountExport, error) {
+	var (
+		status        string
+		fileName      sql.NullString
+		failureReason sql.NullString
+		createdAt     sql.NullTime
+		updatedAt     sql.NullTime
+		completedAt   sql.NullTime
+		expiresAt     sql.NullTime
+	)
+	var export entity.AccountExport
+	err := scan(
+		&export.ID,
+		&export.AccountID,
+		&status,
+		&fileName,
+		&failureReason,
+		&createdAt,
+		&updatedAt,
+		&completedAt,
+		&expiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	export.Status, _ = entity.ExportStatusFromString(status)
+	if fileName.Valid {
+		export.FileName = &fileName.String
+	}
+	if failureReason.Valid {
+		export.FailureReason = &failureReason.String
+	}
+	if createdAt.Valid {
+		export.CreatedAt = &createdAt.Time
+	}
+	if updatedAt.Valid {
+		export.UpdatedAt = &updatedAt.Time
+	}
+	if completedAt.Valid {
+		export.CompletedAt = &completedAt.Time
+	}
+	if expiresAt.Valid {
+		export.ExpiresAt = &expiresAt.Time
+	}
+	return &export, nil
+}
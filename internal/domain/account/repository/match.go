@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/psql"
+	"time"
+)
+
+// matchSideCondition keeps the matches where the account acts in the role of the placeholder.
+const matchSideCondition = "((match_account.client_id = $1 AND $2 = 'client') OR (match_account.freelancer_id = $1 AND $2 = 'freelancer')) "
+
+type Match interface {
+	Create(ctx context.Context, match *entity.Match) error
+	GetByID(ctx context.Context, id int64) (*entity.Match, error)
+	GetByPair(ctx context.Context, clientID int64, freelancerID int64) (*entity.Match, error)
+	CountByAccountID(ctx context.Context, accountID int64, role entity.Role) (int64, error)
+	GetListByAccountID(ctx context.Context, accountID int64, role entity.Role, offset int64, limit int64) ([]entity.Match, error)
+	GetAllByAccountID(ctx context.Context, accountID int64) ([]entity.Match, error)
+	Unmatch(ctx context.Context, id int64, unmatchedBy int64) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type match struct {
+	conn psql.Operation
+}
+
+func NewMatch(conn psql.Operation) Match {
+	return &match{
+		conn: conn,
+	}
+}
+
+// Create records the match unless the pair has already matched.
+func (m *match) Create(ctx context.Context, match *entity.Match) error {
+	query := "INSERT INTO match_account (client_id, freelancer_id, created_at) VALUES ($1, $2, $3) " +
+		"ON CONFLICT (client_id, freelancer_id) DO NOTHING;"
+	_, err := m.conn.ExecContext(ctx, query, match.ClientID, match.FreelancerID, time.Now())
+	return err
+}
+
+func (m *match) GetByID(ctx context.Context, id int64) (*entity.Match, error) {
+	query := "SELECT id, client_id, freelancer_id, unmatched_by, created_at, unmatched_at " +
+		"FROM match_account " +
+		"WHERE id = $1;"
+	return scanMatch(m.conn.QueryRowContext(ctx, query, id))
+}
+
+func (m *match) GetByPair(ctx context.Context, clientID int64, freelancerID int64) (*entity.Match, error) {
+	query := "SELECT id, client_id, freelancer_id, unmatched_by, created_at, unmatched_at " +
+		"FROM match_account " +
+		"WHERE client_id = $1 AND freelancer_id = $2;"
+	return scanMatch(m.conn.QueryRowContext(ctx, query, clientID, freelancerID))
+}
+
+// CountByAccountID counts the active matches of the account acting in the role, the partner must be active too.
+func (m *match) CountByAccountID(ctx context.Context, accountID int64, role entity.Role) (int64, error) {
+	query := "SELECT COUNT(*) " +
+		"FROM match_account " +
+		"JOIN account ON account.id = CASE WHEN match_account.client_id = $1 THEN match_account.freelancer_id ELSE match_account.client_id END " +
+		"WHERE " + matchSideCondition +
+		"	AND match_account.unmatched_at IS NULL " +
+		"	AND " + ActiveAccountCondition + ";"
+	var count int64
+	err := m.conn.QueryRowContext(ctx, query, accountID, role.String()).Scan(&count)
+	return count, err
+}
+
+func (m *match) GetListByAccountID(ctx context.Context, accountID int64, role entity.Role, offset int64, limit int64) ([]entity.Match, error) {
+	query := "SELECT " +
+		"	match_account.id, " +
+		"	match_account.client_id, " +
+		"	match_account.freelancer_id, " +
+		"	match_account.unmatched_by, " +
+		"	match_account.created_at, " +
+		"	match_account.unmatched_at " +
+		"FROM match_account " +
+		"JOIN account ON account.id = CASE WHEN match_account.client_id = $1 THEN match_account.freelancer_id ELSE match_account.client_id END " +
+		"WHERE " + matchSideCondition +
+		"	AND match_account.unmatched_at IS NULL " +
+		"	AND " + ActiveAccountCondition +
+		"ORDER BY match_account.created_at DESC, match_account.id DESC " +
+		"LIMIT $3 " +
+		"OFFSET $4;"
+	rows, err := m.conn.QueryContext(ctx, query, accountID, role.String(), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	matches := make([]entity.Match, 0)
+	for rows.Next() {
+		match, err := scanMatch(rows)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, *match)
+	}
+	return matches, rows.Err()
+}
+
+// Unmatch keeps the match with the time of the unmatch, so the pair doesn't appear in the feed again.
+func (m *match) Unmatch(ctx context.Context, id int64, unmatchedBy int64) error {
+	query := "UPDATE match_account SET unmatched_at = $1, unmatched_by = $2 WHERE id = $3 AND unmatched_at IS NULL;"
+	_, err := m.conn.ExecContext(ctx, query, time.Now(), unmatchedBy, id)
+	return err
+}
+
+// Delete removes the match as if it never formed, the pair may appear in the feed again.
+func (m *match) Delete(ctx context.Context, id int64) error {
+	query := "DELETE FROM match_account WHERE id = $1;"
+	_, err := m.conn.ExecContext(ctx, query, id)
+	return err
+}
+
+// GetAllByAccountID returns every match of the account in both roles including the ended ones.
+func (m *match) GetAllByAccountID(ctx context.Context, accountID int64) ([]entity.Match, error) {
+	query := "SELECT " +
+		"	id, " +
+		"	client_id, " +
+		"	freelancer_id, " +
+		"	unmatched_by, " +
+		"	created_at, " +
+		"	unmatched_at " +
+		"FROM match_account " +
+		"WHERE client_id = $1 OR freelancer_id = $1 " +
+		"ORDER BY created_at, id;"
+	rows, err := m.conn.QueryContext(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	matches := make([]entity.Match, 0)
+	for rows.Next() {
+		match, err := scanMatch(rows)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, *match)
+	}
+	return matches, rows.Err()
+}
+
+func scanMatch(row interface{ Scan(dest ...any) error }) (*entity.Match, error) {
+	var (
+		match       entity.Match
+		unmatchedBy sql.NullInt64
+		createdAt   sql.NullTime
+		unmatchedAt sql.NullTime
+	)
+	err := row.Scan(
+		&match.ID,
+		&match.ClientID,
+		&match.FreelancerID,
+		&unmatchedBy,
+		&createdAt,
+		&unmatchedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if unmatchedBy.Valid {
+		match.UnmatchedBy = &unmatchedBy.Int64
+	}
+	if createdAt.Valid {
+		match.CreatedAt = &createdAt.Time
+	}
+	if unmatchedAt.Valid {
+		match.UnmatchedAt = &unmatchedAt.Time
+	}
+	return &match, nil
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"github.com/lib/pq"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/psql"
+	"time"
+)
+
+type MatchPreference interface {
+	GetByAccountID(ctx context.Context, accountID int64) (*entity.MatchPreference, error)
+	Upsert(ctx context.Context, preference *entity.MatchPreference) error
+}
+
+type matchPreference struct {
+	conn psql.Operation
+}
+
+func NewMatchPreference(conn psql.Operation) MatchPreference {
+	return &matchPreference{
+		conn: conn,
+	}
+}
+
+// GetByAccountID falls back to entity.DefaultMatchPreference when the account has no preferences yet.
+func (m *matchPreference) GetByAccountID(ctx context.Context, accountID int64) (*entity.MatchPreference, error) {
+	query := "SELECT " +
+		"	countries, " +
+		"	category_ids, " +
+		"	required_tags, " +
+		"	verified_only, " +
+		"	has_company, " +
+		"	updated_at " +
+		"FROM match_preference WHERE account_id = $1;"
+	var (
+		preference = entity.MatchPreference{
+			AccountID: accountID,
+		}
+		hasCompany sql.NullBool
+		updatedAt  sql.NullTime
+	)
+	err := m.conn.QueryRowContext(ctx, query, accountID).Scan(
+		pq.Array(&preference.Countries),
+		pq.Array(&preference.CategoryIDs),
+		pq.Array(&preference.RequiredTags),
+		&preference.VerifiedOnly,
+		&hasCompany,
+		&updatedAt,
+	)
+	if err == sql.ErrNoRows {
+		defaultPreference := entity.DefaultMatchPreference(accountID)
+		return &defaultPreference, nil
+	} else if err != nil {
+		return nil, err
+	}
+	// an empty array is scanned into a nil slice.
+	if preference.Countries == nil {
+		preference.Countries = []string{}
+	}
+	if preference.CategoryIDs == nil {
+		preference.CategoryIDs = []int64{}
+	}
+	if preference.RequiredTags == nil {
+		preference.RequiredTags = []string{}
+	}
+	if hasCompany.Valid {
+		preference.HasCompany = &hasCompany.Bool
+	}
+	if updatedAt.Valid {
+		preference.UpdatedAt = &updatedAt.Time
+	}
+	return &preference, nil
+}
+
+func (m *matchPreference) Upsert(ctx context.Context, preference *entity.MatchPreference) error {
+	query := "INSERT INTO match_preference (" +
+		"	account_id, " +
+		"	countries, " +
+		"	category_ids, " +
+		"	required_tags, " +
+		"	verified_only, " +
+		"	has_company, " +
+		"	updated_at" +
+		") VALUES ($1, $2, $3, $4, $5, $6, $7) " +
+		"ON CONFLICT (account_id) DO UPDATE SET " +
+		"	countries = EXCLUDED.countries, " +
+		"	category_ids = EXCLUDED.category_ids, " +
+		"	required_tags = EXCLUDED.required_tags, " +
+		"	verified_only = EXCLUDED.verified_only, " +
+		"	has_company = EXCLUDED.has_company, " +
+		"	updated_at = EXCLUDED.updated_at;"
+	_, err := m.conn.ExecContext(
+		ctx,
+		query,
+		preference.AccountID,
+		pq.Array(preference.Countries),
+		pq.Array(preference.CategoryIDs),
+		pq.Array(preference.RequiredTags),
+		preference.VerifiedOnly,
+		preference.HasCompany,
+		time.Now(),
+	)
+	return err
+}
@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/psql"
+	"time"
+)
+
+const portfolioItemColumns = "" +
+	"	account_portfolio_item.id, " +
+	"	account_portfolio_item.account_id, " +
+	"	account_portfolio_item.attachment_id, " +
+	"	account_portfolio_item.title, " +
+	"	account_portfolio_item.description, " +
+	"	account_portfolio_item.external_link, " +
+	"	account_portfolio_item.sort_order, " +
+	"	account_portfolio_item.created_at, " +
+	"	account_portfolio_item.updated_at, " +
+	"	attachment.file_name, " +
+	"	attachment.path, " +
+	"	attachment.created_at, " +
+	"	attachment.updated_at "
+
+type Portfolio interface {
+	Create(ctx context.Context, portfolioItem *entity.PortfolioItem) (*int64, error)
+	GetByID(ctx context.Context, accountID int64, id int64) (*entity.PortfolioItem, error)
+	GetAllByAccountID(ctx context.Context, accountID int64) ([]entity.PortfolioItem, error)
+	CountByAccountID(ctx context.Context, accountID int64) (*int64, error)
+	Update(ctx context.Context, portfolioItem *entity.PortfolioItem) error
+	UpdateSortOrder(ctx context.Context, accountID int64, id int64, sortOrder int64) error
+	Delete(ctx context.Context, accountID int64, id int64) error
+}
+
+type portfolio struct {
+	conn psql.Operation
+}
+
+func NewPortfolio(conn psql.Operation) Portfolio {
+	return &portfolio{
+		conn: conn,
+	}
+}
+
+func (p *portfolio) Create(ctx context.Context, portfolioItem *entity.PortfolioItem) (*int64, error) {
+	query := "INSERT INTO account_portfolio_item (" +
+		"	account_id, " +
+		"	attachment_id, " +
+		"	title, " +
+		"	description, " +
+		"	external_link, " +
+		"	sort_order, " +
+		"	created_at" +
+		") VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id;"
+	var id int64
+	err := p.conn.QueryRowContext(
+		ctx,
+		query,
+		portfolioItem.AccountID,
+		portfolioItem.AttachmentID,
+		portfolioItem.Title,
+		portfolioItem.Description,
+		portfolioItem.ExternalLink,
+		portfolioItem.SortOrder,
+		time.Now(),
+	).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func (p *portfolio) GetByID(ctx context.Context, accountID int64, id int64) (*entity.PortfolioItem, error) {
+	query := "SELECT " + portfolioItemColumns +
+		"FROM account_portfolio_item " +
+		"INNER JOIN attachment ON attachment.id = account_portfolio_item.attachment_id " +
+		"WHERE account_portfolio_item.id = $1 AND account_portfolio_item.account_id = $2;"
+	row := p.conn.QueryRowContext(ctx, query, id, accountID)
+	return scanPortfolioItem(row.Scan)
+}
+
+func (p *portfolio) GetAllByAccountID(ctx context.Context, accountID int64) ([]entity.PortfolioItem, error) {
+	query := "SELECT " + portfolioItemColumns +
+		"FROM account_portfolio_item " +
+		"INNER JOIN attachment ON attachment.id = account_portfolio_item.attachment_id " +
+		"WHERE account_portfolio_item.account_id = $1 " +
+		"ORDER BY account_portfolio_item.sort_order, account_portfolio_item.id;"
+	rows, err := p.conn.QueryContext(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	portfolioItems := make([]entity.PortfolioItem, 0)
+	for rows.Next() {
+		portfolioItem, err := scanPortfolioItem(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		portfolioItems = append(portfolioItems, *portfolioItem)
+	}
+	return portfolioItems, rows.Err()
+}
+
+func (p *portfolio) CountByAccountID(ctx context.Context, accountID int64) (*int64, error) {
+	query := "SELECT COUNT(*) FROM account_portfolio_item WHERE account_id = $1;"
+	var count int64
+	if err := p.conn.QueryRowContext(ctx, query, accountID).Scan(&count); err != nil {
+		return nil, err
+	}
+	return &count, nil
+}
+
+func (p *portfolio) Update(ctx context.Context, portfolioItem *entity.PortfolioItem) error {
+	query := "UPDATE account_portfolio_item SET " +
+		"	title = $1, " +
+		"	description = $2, " +
+		"	external_link = $3, " +
+		"	updated_at = $4 " +
+		"WHERE id = $5 AND account_id = $6;"
+	_, err := p.conn.ExecContext(
+		ctx,
+		query,
+		portfolioItem.Title,
+		portfolioItem.Description,
+		portfolioItem.ExternalLink,
+		time.Now(),
+		portfolioItem.ID,
+		portfolioItem.AccountID,
+	)
+	return err
+}
+
+func (p *portfolio) UpdateSortOrder(ctx context.Context, accountID int64, id int64, sortOrder int64) error {
+	query := "UPDATE account_portfolio_item SET " +
+		"	sort_order = $1, " +
+		"	updated_at = $2 " +
+		"WHERE id = $3 AND account_id = $4;"
+	_, err := p.conn.ExecContext(ctx, query, sortOrder, time.Now(), id, accountID)
+	return err
+}
+
+func (p *portfolio) Delete(ctx context.Context, accountID int64, id int64) error {
+	query := "DELETE FROM account_portfolio_item WHERE id = $1 AND account_id = $2;"
+	_, err := p.conn.ExecContext(ctx, query, id, accountID)
+	return err
+}
+
+func scanPortfolioItem(scan func(dest ...any) error) (*entity.PortfolioItem, error) {
+	var (
+		description         sql.NullString
+		externalLink        sql.NullString
+		createdAt           sql.NullTime
+		updatedAt           sql.NullTime
+		attachmentPath      sql.NullString
+		attachmentCreatedAt sql.NullTime
+		attachmentUpdatedAt sql.NullTime
+	)
+	var (
+		portfolioItem entity.PortfolioItem
+		attachment    entity.Attachment
+	)
+	err := scan(
+		&portfolioItem.ID,
+		&portfolioItem.AccountID,
+		&portfolioItem.AttachmentID,
+		&portfolioItem.Title,
+		&description,
+		&externalLink,
+		&portfolioItem.SortOrder,
+		&createdAt,
+		&updatedAt,
+		&attachment.FileName,
+		&attachmentPath,
+		&attachmentCreatedAt,
+		&attachmentUpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if description.Valid {
+		portfolioItem.Description = &description.String
+	}
+	if externalLink.Valid {
+		portfolioItem.ExternalLink = &externalLink.String
+	}
+	if createdAt.Valid {
+		portfolioItem.CreatedAt = &createdAt.Time
+	}
+	if updatedAt.Valid {
+		portfolioItem.UpdatedAt = &updatedAt.Time
+	}
+	attachment.ID = portfolioItem.AttachmentID
+	if attachmentPath.Valid {
+		attachment.Path = &attachmentPath.String
+	}
+	if attachmentCreatedAt.Valid {
+		attachment.CreatedAt = &attachmentCreatedAt.Time
+	}
+	if attachmentUpdatedAt.Valid {
+		attachment.UpdatedAt = &attachmentUpdatedAt.Time
+	}
+	portfolioItem.Attachment = &attachment
+	return &portfolioItem, nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/psql"
+	"time"
+)
+
+type Privacy interface {
+	GetByAccountID(ctx context.Context, accountID int64) (*entity.AccountPrivacy, error)
+	Upsert(ctx context.Context, privacy *entity.AccountPrivacy) error
+}
+
+type privacy struct {
+	conn psql.Operation
+}
+
+func NewPrivacy(conn psql.Operation) Privacy {
+	return &privacy{
+		conn: conn,
+	}
+}
+
+// GetByAccountID falls back to entity.DefaultAccountPrivacy when the account has no settings yet.
+func (p *privacy) GetByAccountID(ctx context.Context, accountID int64) (*entity.AccountPrivacy, error) {
+	query := "SELECT " +
+		"	last_name, " +
+		"	location, " +
+		"	company, " +
+		"	document_attachment, " +
+		"	telegram_id, " +
+		"	updated_at " +
+		"FROM account_privacy WHERE account_id = $1;"
+	var (
+		lastName           string
+		location           string
+		company            string
+		documentAttachment string
+		telegramID         string
+		updatedAt          sql.NullTime
+	)
+	err := p.conn.QueryRowContext(ctx, query, accountID).Scan(
+		&lastName,
+		&location,
+		&company,
+		&documentAttachment,
+		&telegramID,
+		&updatedAt,
+	)
+	if err == sql.ErrNoRows {
+		defaultPrivacy := entity.DefaultAccountPrivacy(accountID)
+		return &defaultPrivacy, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var privacy = entity.AccountPrivacy{
+		AccountID: accountID,
+	}
+	privacy.LastName, _ = entity.VisibilityFromString(lastName)
+	privacy.Location, _ = entity.VisibilityFromString(location)
+	privacy.Company, _ = entity.VisibilityFromString(company)
+	privacy.DocumentAttachment, _ = entity.VisibilityFromString(documentAttachment)
+	privacy.TelegramID, _ = entity.VisibilityFromString(telegramID)
+	if updatedAt.Valid {
+		privacy.UpdatedAt = &updatedAt.Time
+	}
+	return &privacy, nil
+}
+
+func (p *privacy) Upsert(ctx context.Context, privacy *entity.AccountPrivacy) error {
+	query := "INSERT INTO account_privacy (" +
+		"	account_id, " +
+		"	last_name, " +
+		"	location, " +
+		"	company, " +
+		"	document_attachment, " +
+		"	telegram_id, " +
+		"	updated_at" +
+		") VALUES ($1, $2, $3, $4, $5, $6, $7) " +
+		"ON CONFLICT (account_id) DO UPDATE SET " +
+		"	last_name = EXCLUDED.last_name, " +
+		"	location = EXCLUDED.location, " +
+		"	company = EXCLUDED.company, " +
+		"	document_attachment = EXCLUDED.document_attachment, " +
+		"	telegram_id = EXCLUDED.telegram_id, " +
+		"	updated_at = EXCLUDED.updated_at;"
+	_, err := p.conn.ExecContext(
+		ctx,
+		query,
+		privacy.AccountID,
+		privacy.LastName.String(),
+		privacy.Location.String(),
+		privacy.Company.String(),
+		privacy.DocumentAttachment.String(),
+		privacy.TelegramID.String(),
+		time.Now(),
+	)
+	return err
+}
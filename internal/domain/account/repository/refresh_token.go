@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/psql"
+	"time"
+)
+
+type RefreshToken interface {
+	Create(ctx context.Context, refreshToken *entity.RefreshToken) (*int64, error)
+	GetByTokenID(ctx context.Context, tokenID string) (*entity.RefreshToken, error)
+	MarkUsed(ctx context.Context, tokenID string) (bool, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+}
+
+type refreshToken struct {
+	conn psql.Operation
+}
+
+func NewRefreshToken(conn psql.Operation) RefreshToken {
+	return &refreshToken{
+		conn: conn,
+	}
+}
+
+func (r *refreshToken) Create(ctx context.Context, refreshToken *entity.RefreshToken) (*int64, error) {
+	query := "INSERT INTO refresh_token (" +
+		"	account_id, " +
+		"	token_id, " +
+		"	family_id, " +
+		"	expires_at, " +
+		"	created_at" +
+		") VALUES ($1, $2, $3, $4, $5) " +
+		"RETURNING id;"
+	var id int64
+	err := r.conn.QueryRowContext(
+		ctx,
+		query,
+		refreshToken.AccountID,
+		refreshToken.TokenID,
+		refreshToken.FamilyID,
+		refreshToken.ExpiresAt,
+		time.Now(),
+	).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func (r *refreshToken) GetByTokenID(ctx context.Context, tokenID string) (*entity.RefreshToken, error) {
+	query := "SELECT " +
+		"	id, " +
+		"	account_id, " +
+		"	family_id, " +
+		"	expires_at, " +
+		"	used_at, " +
+		"	revoked_at, " +
+		"	created_at " +
+		"FROM refresh_token WHERE token_id = $1;"
+	var (
+		usedAt    sql.NullTime
+		revokedAt sql.NullTime
+		createdAt sql.NullTime
+	)
+	var refreshToken = entity.RefreshToken{
+		TokenID: tokenID,
+	}
+	err := r.conn.QueryRowContext(ctx, query, tokenID).Scan(
+		&refreshToken.ID,
+		&refreshToken.AccountID,
+		&refreshToken.FamilyID,
+		&refreshToken.ExpiresAt,
+		&usedAt,
+		&revokedAt,
+		&createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if usedAt.Valid {
+		refreshToken.UsedAt = &usedAt.Time
+	}
+	if revokedAt.Valid {
+		refreshToken.RevokedAt = &revokedAt.Time
+	}
+	if createdAt.Valid {
+		refreshToken.CreatedAt = &createdAt.Time
+	}
+	return &refreshToken, nil
+}
+
+// MarkUsed consumes the token and reports false when it has already been used or revoked,
+// so two concurrent refreshes with the same token cannot both succeed.
+func (r *refreshToken) MarkUsed(ctx context.Context, tokenID string) (bool, error) {
+	query := "UPDATE refresh_token SET " +
+		"	used_at = $1 " +
+		"WHERE token_id = $2 AND used_at IS NULL AND revoked_at IS NULL;"
+	result, err := r.conn.ExecContext(ctx, query, time.Now(), tokenID)
+	if err != nil {
+		return false, err
+	}
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affectedRows == 1, nil
+}
+
+func (r *refreshToken) RevokeFamily(ctx context.Context, familyID string) error {
+	query := "UPDATE refresh_token SET " +
+		"	revoked_at = $1 " +
+		"WHERE family_id = $2 AND revoked_at IS NULL;"
+	_, err := r.conn.ExecContext(ctx, query, time.Now(), familyID)
+	return err
+}
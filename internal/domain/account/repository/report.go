@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/psql"
+	"time"
+)
+
+const reportColumns = "" +
+	"	report.id, " +
+	"	report.reporter_id, " +
+	"	report.reported_id, " +
+	"	report.reason, " +
+	"	report.description, " +
+	"	report.attachment_id, " +
+	"	report.status, " +
+	"	report.reviewer_id, " +
+	"	report.created_at, " +
+	"	report.reviewed_at, " +
+	"	attachment.file_name, " +
+	"	attachment.path, " +
+	"	attachment.created_at, " +
+	"	attachment.updated_at "
+
+type Report interface {
+	Create(ctx context.Context, report *entity.Report) (*int64, error)
+	GetByID(ctx context.Context, id int64) (*entity.Report, error)
+	CountByStatus(ctx context.Context, status entity.ReportStatus) (int64, error)
+	GetListByStatus(ctx context.Context, status entity.ReportStatus, offset int64, limit int64) ([]entity.Report, error)
+	GetAllByReporterID(ctx context.Context, reporterID int64) ([]entity.Report, error)
+	Review(ctx context.Context, id int64, reviewerID int64, status entity.ReportStatus) (bool, error)
+}
+
+type report struct {
+	conn psql.Operation
+}
+
+func NewReport(conn psql.Operation) Report {
+	return &report{
+		conn: conn,
+	}
+}
+
+func (r *report) Create(ctx context.Context, report *entity.Report) (*int64, error) {
+	query := "INSERT INTO report (" +
+		"	reporter_id, " +
+		"	reported_id, " +
+		"	reason, " +
+		"	description, " +
+		"	attachment_id, " +
+		"	status, " +
+		"	created_at" +
+		") VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id;"
+	var id int64
+	err := r.conn.QueryRowContext(
+		ctx,
+		query,
+		report.ReporterID,
+		report.ReportedID,
+		report.Reason.String(),
+		report.Description,
+		report.AttachmentID,
+		entity.PendingReportStatus.String(),
+		time.Now(),
+	).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func (r *report) GetByID(ctx context.Context, id int64) (*entity.Report, error) {
+	query := "SELECT " + reportColumns +
+		"FROM report " +
+		"LEFT JOIN attachment ON attachment.id = report.attachment_id " +
+		"WHERE report.id = $1;"
+	return scanReport(r.conn.QueryRowContext(ctx, query, id).Scan)
+}
+
+func (r *report) CountByStatus(ctx context.Context, status entity.ReportStatus) (int64, error) {
+	query := "SELECT COUNT(*) FROM report WHERE status = $1;"
+	var count int64
+	err := r.conn.QueryRowContext(ctx, query, status.String()).Scan(&count)
+	return count, err
+}
+
+// GetListByStatus returns the reports with the status, the oldest report first.
+func (r *report) GetListByStatus(ctx context.Context, status entity.ReportStatus, offset int64, limit int64) ([]entity.Report, error) {
+	query := "SELECT " + reportColumns +
+		"FROM report " +
+		"LEFT JOIN attachment ON attachment.id = report.attachment_id " +
+		"WHERE report.status = $1 " +
+		"ORDER BY report.created_at, report.id " +
+		"LIMIT $2 " +
+		"OFFSET $3;"
+	rows, err := r.conn.QueryContext(ctx, query, status.String(), limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	reports := make([]entity.Report, 0, limit)
+	for rows.Next() {
+		report, err := scanReport(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, *report)
+	}
+	return reports, rows.Err()
+}
+
+// GetAllByReporterID returns every report the account has filed, the oldest report first.
+func (r *report) GetAllByReporterID(ctx context.Context, reporterID int64) ([]entity.Report, error) {
+	query := "SELECT " + reportColumns +
+		"FROM report " +
+		"LEFT JOIN attachment ON attachment.id = report.attachment_id " +
+		"WHERE report.reporter_id = $1 " +
+		"ORDER BY report.created_at, report.id;"
+	rows, err := r.conn.QueryContext(ctx, query, reporterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	reports := make([]entity.Report, 0)
+	for rows.Next() {
+		report, err := scanReport(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, *report)
+	}
+	return reports, rows.Err()
+}
+
+// Review closes the report with the status, it reports false when the report is not pending.
+func (r *report) Review(ctx context.Context, id int64, reviewerID int64, status entity.ReportStatus) (bool, error) {
+	query := "UPDATE report SET " +
+		"	status = $1, " +
+		"	reviewer_id = $2, " +
+		"	reviewed_at = $3 " +
+		"WHERE id = $4 AND status = $5;"
+	result, err := r.conn.ExecContext(ctx, query, status.String(), reviewerID, time.Now(), id, entity.PendingReportStatus.String())
+	if err != nil {
+		return false, err
+	}
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affectedRows > 0, nil
+}
+
+func scanReport(scan func(dest ...any) error) (*entity.Report, error) {
+	var (
+		reporterID          sql.NullInt64
+		reason              string
+		description         sql.NullString
+		attachmentID        sql.NullInt64
+		status              string
+		reviewerID          sql.NullInt64
+		createdAt           sql.NullTime
+		reviewedAt          sql.NullTime
+		attachmentFileName  sql.NullString
+		attachmentPath      sql.NullString
+		attachmentCreatedAt sql.NullTime
+		attachmentUpdatedAt sql.NullTime
+	)
+	var report entity.Report
+	err := scan(
+		&report.ID,
+		&reporterID,
+		&report.ReportedID,
+		&reason,
+		&description,
+		&attachmentID,
+		&status,
+		&reviewerID,
+		&createdAt,
+		&reviewedAt,
+		&attachmentFileName,
+		&attachmentPath,
+		&attachmentCreatedAt,
+		&attachmentUpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if report.Reason, err = entity.ReportReasonFromString(reason); err != nil {
+		return nil, err
+	}
+	if report.Status, err = entity.ReportStatusFromString(status); err != nil {
+		return nil, err
+	}
+	if reporterID.Valid {
+		report.ReporterID = &reporterID.Int64
+	}
+	if description.Valid {
+		report.Description = &description.String
+	}
+	if reviewerID.Valid {
+		report.ReviewerID = &reviewerID.Int64
+	}
+	if createdAt.Valid {
+		report.CreatedAt = &createdAt.Time
+	}
+	if reviewedAt.Valid {
+		report.ReviewedAt = &reviewedAt.Time
+	}
+	if attachmentID.Valid {
+		report.AttachmentID = &attachmentID.Int64
+		attachment := entity.Attachment{
+			ID:       attachmentID.Int64,
+			FileName: attachmentFileName.String,
+		}
+		if attachmentPath.Valid {
+			attachment.Path = &attachmentPath.String
+		}
+		if attachmentCreatedAt.Valid {
+			attachment.CreatedAt = &attachmentCreatedAt.Time
+		}
+		if attachmentUpdatedAt.Valid {
+			attachment.UpdatedAt = &attachmentUpdatedAt.Time
+		}
+		report.Attachment = &attachment
+	}
+	return &report, nil
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/psql"
+	"time"
+)
+
+type Rewind interface {
+	Create(ctx context.Context, rewind *entity.MatchRewind) error
+	CountSince(ctx context.Context, accountID int64, since time.Time) (int64, error)
+}
+
+type rewind struct {
+	conn psql.Operation
+}
+
+func NewRewind(conn psql.Operation) Rewind {
+	return &rewind{
+		conn: conn,
+	}
+}
+
+func (r *rewind) Create(ctx context.Context, rewind *entity.MatchRewind) error {
+	query := "INSERT INTO match_rewind (account_id, target_id, action, created_at) VALUES ($1, $2, $3, $4);"
+	_, err := r.conn.ExecContext(ctx, query, rewind.AccountID, rewind.TargetID, rewind.Action, time.Now())
+	return err
+}
+
+func (r *rewind) CountSince(ctx context.Context, accountID int64, since time.Time) (int64, error) {
+	query := "SELECT COUNT(*) FROM match_rewind WHERE account_id = $1 AND created_at >= $2;"
+	var count int64
+	err := r.conn.QueryRowContext(ctx, query, accountID, since).Scan(&count)
+	return count, err
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/psql"
+	"time"
+)
+
+type Sanction interface {
+	Create(ctx context.Context, sanction *entity.AccountSanction) (int64, error)
+	GetByAccountID(ctx context.Context, accountID int64, offset int64, limit int64) ([]entity.AccountSanction, error)
+	CountByAccountID(ctx context.Context, accountID int64) (int64, error)
+}
+
+type sanction struct {
+	conn psql.Operation
+}
+
+func NewSanction(conn psql.Operation) Sanction {
+	return &sanction{
+		conn: conn,
+	}
+}
+
+func (s *sanction) Create(ctx context.Context, sanction *entity.AccountSanction) (int64, error) {
+	query := "INSERT INTO account_sanction (" +
+		"	account_id, " +
+		"	staff_account_id, " +
+		"	action, " +
+		"	reason, " +
+		"	suspended_until, " +
+		"	created_at" +
+		") VALUES ($1, $2, $3, $4, $5, $6) RETURNING id;"
+	var id int64
+	err := s.conn.QueryRowContext(
+		ctx,
+		query,
+		sanction.AccountID,
+		sanction.StaffAccountID,
+		sanction.Action,
+		sanction.Reason,
+		sanction.SuspendedUntil,
+		time.Now(),
+	).Scan(&id)
+	return id, err
+}
+
+func (s *sanction) GetByAccountID(ctx context.Context, accountID int64, offset int64, limit int64) ([]entity.AccountSanction, error) {
+	query := "SELECT " +
+		"	id, " +
+		"	staff_account_id, " +
+		"	action, " +
+		"	reason, " +
+		"	suspended_until, " +
+		"	created_at " +
+		"FROM account_sanction " +
+		"WHERE account_id = $1 " +
+		"ORDER BY created_at DESC, id DESC " +
+		"LIMIT $2 " +
+		"OFFSET $3;"
+	rows, err := s.conn.QueryContext(ctx, query, accountID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	sanctions := make([]entity.AccountSanction, 0)
+	for rows.Next() {
+		var (
+			staffAccountID sql.NullInt64
+			suspendedUntil sql.NullTime
+			createdAt      sql.NullTime
+		)
+		var sanction = entity.AccountSanction{
+			AccountID: accountID,
+		}
+		err = rows.Scan(
+			&sanction.ID,
+			&staffAccountID,
+			&sanction.Action,
+			&sanction.Reason,
+			&suspendedUntil,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if staffAccountID.Valid {
+			sanction.StaffAccountID = &staffAccountID.Int64
+		}
+		if suspendedUntil.Valid {
+			sanction.SuspendedUntil = &suspendedUntil.Time
+		}
+		if createdAt.Valid {
+			sanction.CreatedAt = &createdAt.Time
+		}
+		sanctions = append(sanctions, sanction)
+	}
+	return sanctions, rows.Err()
+}
+
+func (s *sanction) CountByAccountID(ctx context.Context, accountID int64) (int64, error) {
+	query := "SELECT COUNT(*) FROM account_sanction WHERE account_id = $1;"
+	var count int64
+	err := s.conn.QueryRowContext(ctx, query, accountID).Scan(&count)
+	return count, err
+}
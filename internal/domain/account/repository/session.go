@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/psql"
+	"time"
+)
+
+type Session interface {
+	Create(ctx context.Context, session *entity.Session) error
+	GetByID(ctx context.Context, id string) (*entity.Session, error)
+	GetActiveByAccountID(ctx context.Context, accountID int64) ([]entity.Session, error)
+	GetAllByAccountID(ctx context.Context, accountID int64) ([]entity.Session, error)
+	Touch(ctx context.Context, id string) error
+	Revoke(ctx context.Context, id string) error
+}
+
+type session struct {
+	conn psql.Operation
+}
+
+func NewSession(conn psql.Operation) Session {
+	return &session{
+		conn: conn,
+	}
+}
+
+func (s *session) Create(ctx context.Context, session *entity.Session) error {
+	query := "INSERT INTO account_session (" +
+		"	id, " +
+		"	account_id, " +
+		"	device, " +
+		"	platform, " +
+		"	user_agent, " +
+		"	ip, " +
+		"	created_at, " +
+		"	last_active_at" +
+		") VALUES ($1, $2, $3, $4, $5, $6, $7, $8);"
+	now := time.Now()
+	_, err := s.conn.ExecContext(
+		ctx,
+		query,
+		session.ID,
+		session.AccountID,
+		session.Device,
+		session.Platform,
+		session.UserAgent,
+		session.IP,
+		now,
+		now,
+	)
+	return err
+}
+
+func (s *session) GetByID(ctx context.Context, id string) (*entity.Session, error) {
+	query := "SELECT " +
+		"	account_id, " +
+		"	device, " +
+		"	platform, " +
+		"	user_agent, " +
+		"	ip, " +
+		"	created_at, " +
+		"	last_active_at, " +
+		"	revoked_at " +
+		"FROM account_session WHERE id = $1;"
+	var session = entity.Session{
+		ID: id,
+	}
+	var (
+		platform     sql.NullString
+		userAgent    sql.NullString
+		ip           sql.NullString
+		createdAt    sql.NullTime
+		lastActiveAt sql.NullTime
+		revokedAt    sql.NullTime
+	)
+	err := s.conn.QueryRowContext(ctx, query, id).Scan(
+		&session.AccountID,
+		&session.Device,
+		&platform,
+		&userAgent,
+		&ip,
+		&createdAt,
+		&lastActiveAt,
+		&revokedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if platform.Valid {
+		session.Platform = &platform.String
+	}
+	if userAgent.Valid {
+		session.UserAgent = &userAgent.String
+	}
+	if ip.Valid {
+		session.IP = &ip.String
+	}
+	if createdAt.Valid {
+		session.CreatedAt = &createdAt.Time
+	}
+	if lastActiveAt.Valid {
+		session.LastActiveAt = &lastActiveAt.Time
+	}
+	if revokedAt.Valid {
+		session.RevokedAt = &revokedAt.Time
+	}
+	return &session, nil
+}
+
+func (s *session) GetActiveByAccountID(ctx context.Context, accountID int64) ([]entity.Session, error) {
+	query := "SELECT " + sessionColumns +
+		"FROM account_session " +
+		"WHERE account_id = $1 AND revoked_at IS NULL " +
+		"ORDER BY last_active_at DESC;"
+	return s.querySessions(ctx, query, accountID)
+}
+
+// GetAllByAccountID returns the sessions of the account including the revoked ones.
+func (s *session) GetAllByAccountID(ctx context.Context, accountID int64) ([]entity.Session, error) {
+	query := "SELECT " + sessionColumns +
+		"FROM account_session " +
+		"WHERE account_id = $1 " +
+		"ORDER BY created_at, id;"
+	return s.querySessions(ctx, query, accountID)
+}
+
+// sessionColumns are scanned by querySessions.
+const sessionColumns = "" +
+	"	id, " +
+	"	device, " +
+	"	platform, " +
+	"	user_agent, " +
+	"	ip, " +
+	"	created_at, " +
+	"	last_active_at, " +
+	"	revoked_at "
+
+func (s *session) querySessions(ctx context.Context, query string, accountID int64) ([]entity.Session, error) {
+	rows, err := s.conn.QueryContext(ctx, query, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	sessions := make([]entity.Session, 0)
+	for rows.Next() {
+		var (
+			platform     sql.NullString
+			userAgent    sql.NullString
+			ip           sql.NullString
+			createdAt    sql.NullTime
+			lastActiveAt sql.NullTime
+			revokedAt    sql.NullTime
+		)
+		var session = entity.Session{
+			AccountID: accountID,
+		}
+		err = rows.Scan(
+			&session.ID,
+			&session.Device,
+			&platform,
+			&userAgent,
+			&ip,
+			&createdAt,
+			&lastActiveAt,
+			&revokedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if platform.Valid {
+			session.Platform = &platform.String
+		}
+		if userAgent.Valid {
+			session.UserAgent = &userAgent.String
+		}
+		if ip.Valid {
+			session.IP = &ip.String
+		}
+		if createdAt.Valid {
+			session.CreatedAt = &createdAt.Time
+		}
+		if lastActiveAt.Valid {
+			session.LastActiveAt = &lastActiveAt.Time
+		}
+		if revokedAt.Valid {
+			session.RevokedAt = &revokedAt.Time
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, rows.Err()
+}
+
+// Touch refreshes last_active_at at most once per entity.SessionActivityResolution,
+// so authorizing every request does not turn into a write per request.
+func (s *session) Touch(ctx context.Context, id string) error {
+	query := "UPDATE account_session SET " +
+		"	last_active_at = $1 " +
+		"WHERE id = $2 AND last_active_at < $3;"
+	now := time.Now()
+	_, err := s.conn.ExecContext(ctx, query, now, id, now.Add(-entity.SessionActivityResolution))
+	return err
+}
+
+func (s *session) Revoke(ctx context.Context, id string) error {
+	query := "UPDATE account_session SET " +
+		"	revoked_at = $1 " +
+		"WHERE id = $2 AND revoked_at IS NULL;"
+	_, err := s.conn.ExecContext(ctx, query, time.Now(), id)
+	return err
+}
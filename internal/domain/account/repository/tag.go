@@ -13,6 +13,7 @@ type Tag interface {
 	ExistTagWithTitle(ctx context.Context, title string) (bool, error)
 	HasTagRelationship(ctx context.Context, tagID int64) (bool, error)
 	AddAccountTag(ctx context.Context, accountTag *entity.AccountTag) error
+	RemoveAccountTag(ctx context.Context, accountTag *entity.AccountTag) error
 	RemoveAllFromAccountID(ctx context.Context, accountID int64) error
 	GetTagsByAccountID(ctx context.Context, accountID int64) ([]entity.Tag, error)
 	GetTagByTitle(ctx context.Context, title string) (*entity.Tag, error)
@@ -67,6 +68,12 @@ func (t *tag) AddAccountTag(ctx context.Context, accountTag *entity.AccountTag)
 	return nil
 }
 
+func (t *tag) RemoveAccountTag(ctx context.Context, accountTag *entity.AccountTag) error {
+	query := "DELETE FROM account_tag WHERE account_id = $1 AND tag_id = $2;"
+	_, err := t.conn.ExecContext(ctx, query, accountTag.AccountID, accountTag.TagID)
+	return err
+}
+
 func (t *tag) RemoveAllFromAccountID(ctx context.Context, accountID int64) error {
 	query := "DELETE FROM account_tag WHERE account_id = $1;"
 	_, err := t.conn.ExecContext(ctx, query, accountID)
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/psql"
+	"time"
+)
+
+type Wallet interface {
+	CreateNonce(ctx context.Context, nonce *entity.WalletNonce) (*int64, error)
+	ConsumeNonce(ctx context.Context, accountID int64, payload string, now time.Time) (bool, error)
+}
+
+type wallet struct {
+	conn psql.Operation
+}
+
+func NewWallet(conn psql.Operation) Wallet {
+	return &wallet{
+		conn: conn,
+	}
+}
+
+// CreateNonce records the nonce and drops the expired and used nonces of the account.
+func (w *wallet) CreateNonce(ctx context.Context, nonce *entity.WalletNonce) (*int64, error) {
+	cleanupQuery := "DELETE FROM account_wallet_nonce " +
+		"WHERE account_id = $1 AND (used_at IS NOT NULL OR expires_at <= $2);"
+	now := time.Now()
+	if _, err := w.conn.ExecContext(ctx, cleanupQuery, nonce.AccountID, now); err != nil {
+		return nil, err
+	}
+	query := "INSERT INTO account_wallet_nonce (" +
+		"	account_id, " +
+		"	payload, " +
+		"	created_at, " +
+		"	expires_at" +
+		") VALUES ($1, $2, $3, $4) RETURNING id;"
+	var id int64
+	err := w.conn.QueryRowContext(ctx, query, nonce.AccountID, nonce.Payload, now, nonce.ExpiresAt).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// ConsumeNonce marks the nonce as used, it returns false when the nonce doesn't belong to the account,
+// has been used already or has expired.
+func (w *wallet) ConsumeNonce(ctx context.Context, accountID int64, payload string, now time.Time) (bool, error) {
+	query := "UPDATE account_wallet_nonce SET used_at = $1 " +
+		"WHERE account_id = $2 AND payload = $3 AND used_at IS NULL AND expires_at > $1 " +
+		"RETURNING id;"
+	rows, err := w.conn.QueryContext(ctx, query, now, accountID, payload)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	consumed := rows.Next()
+	return consumed, rows.Err()
+}
@@ -3,8 +3,6 @@ package usecase
 import (
 	"context"
 	"database/sql"
-	"fmt"
-	"github.com/google/uuid"
 	"go-tonify-backend/internal/container"
 	accountConverter "go-tonify-backend/internal/domain/account/converter"
 	"go-tonify-backend/internal/domain/account/model"
@@ -13,41 +11,58 @@ import (
 	categoryRepository "go-tonify-backend/internal/domain/category/repository"
 	"go-tonify-backend/internal/domain/entity"
 	"go-tonify-backend/internal/domain/filestorage"
+	commonModel "go-tonify-backend/internal/domain/model"
 	"go-tonify-backend/internal/domain/provider/transaction"
 	"go-tonify-backend/internal/utils"
 	"go-tonify-backend/pkg/jwt"
+	jwtModel "go-tonify-backend/pkg/jwt/model"
 	"go-tonify-backend/pkg/logger"
-	"go-tonify-backend/pkg/telegram"
-	"mime/multipart"
+	"go-tonify-backend/pkg/psql"
+	telegramModel "go-tonify-backend/pkg/telegram/model"
 	"regexp"
 	"strings"
+	"time"
 )
 
+var nicknameRegexp = regexp.MustCompile(`^@[\w\d_-]+$`)
+
 type Account interface {
-	CreateAccount(ctx context.Context, createAccount model.CreateAccount) (*int64, error)
-	GeneratePairToken(accountID int64) (*model.PairToken, error)
+	CreateAccount(ctx context.Context, createAccount model.CreateAccount, source model.AuditSource) (*int64, error)
+	GeneratePairToken(ctx context.Context, accountID int64, device model.Device) (*model.PairToken, error)
+	RefreshPairToken(ctx context.Context, refreshToken string) (*model.PairToken, error)
 	AuthenticationTelegram(ctx context.Context, telegramInitData string) (*int64, error)
-	ParseAccessToken(accessToken string) (*int64, error)
+	AuthenticationTelegramWidget(ctx context.Context, telegramLoginWidget model.TelegramLoginWidget) (*int64, error)
+	Authorize(ctx context.Context, accessToken string) (*model.Authorization, error)
 	GetDetailsAccount(ctx context.Context, id int64) (*model.Account, error)
-	EditAccount(ctx context.Context, editAccount model.EditAccount) error
-	DeleteAccount(ctx context.Context, accountID int64) error
+	GetPublicAccount(ctx context.Context, viewerID int64, id int64) (*model.Account, error)
+	SearchAccounts(ctx context.Context, viewerID int64, searchAccounts model.SearchAccounts) (*commonModel.Pagination[model.Account], error)
+	IsNicknameAvailable(ctx context.Context, nickname string) (bool, error)
+	EditAccount(ctx context.Context, editAccount model.EditAccount, source model.AuditSource) error
+	PatchAccount(ctx context.Context, patchAccount model.PatchAccount, source model.AuditSource) error
+	DeleteAccount(ctx context.Context, accountID int64, source model.AuditSource) error
+	RestoreAccount(ctx context.Context, telegramInitData string) (*int64, error)
+	PurgeDeletedAccounts(ctx context.Context, limit int64) (int, error)
 	AccountHasRole(ctx context.Context, accountID int64, role model.Role) (bool, error)
-	ChangeRole(ctx context.Context, accountID int64, role model.Role) error
+	DefaultRole(ctx context.Context, accountID int64) (model.Role, error)
+	ChangeRole(ctx context.Context, accountID int64, role model.Role, source model.AuditSource) error
+	AddRole(ctx context.Context, accountID int64, role model.Role, source model.AuditSource) error
+	RemoveRole(ctx context.Context, accountID int64, role model.Role, source model.AuditSource) error
 }
 
 type account struct {
-	container            container.Container
-	fileStorage          filestorage.FileStorage
-	accountRepository    accountRepository.Account
-	attachmentRepository accountRepository.Attachment
-	tagRepository        accountRepository.Tag
-	categoryRepository   categoryRepository.Category
-	transactionProvider  *transaction.Provider
-}
-
-type uploadFile struct {
-	Name string
-	File multipart.File
+	container              container.Container
+	fileStorage            filestorage.FileStorage
+	accountRepository      accountRepository.Account
+	attachmentRepository   accountRepository.Attachment
+	tagRepository          accountRepository.Tag
+	refreshTokenRepository accountRepository.RefreshToken
+	sessionRepository      accountRepository.Session
+	privacyRepository      accountRepository.Privacy
+	portfolioRepository    accountRepository.Portfolio
+	categoryRepository     categoryRepository.Category
+	transactionProvider    *transaction.Provider
+	exportRepository       accountRepository.Export
+	reportRepository       accountRepository.Report
 }
 
 func NewAccount(
@@ -56,47 +71,49 @@ func NewAccount(
 	accountRepository accountRepository.Account,
 	attachmentRepository accountRepository.Attachment,
 	tagRepository accountRepository.Tag,
+	refreshTokenRepository accountRepository.RefreshToken,
+	sessionRepository accountRepository.Session,
+	privacyRepository accountRepository.Privacy,
+	portfolioRepository accountRepository.Portfolio,
 	categoryRepository categoryRepository.Category,
 	transactionProvider *transaction.Provider,
+	exportRepository accountRepository.Export,
+	reportRepository accountRepository.Report,
 ) Account {
 	return &account{
-		container:            container,
-		fileStorage:          fileStorage,
-		accountRepository:    accountRepository,
-		attachmentRepository: attachmentRepository,
-		tagRepository:        tagRepository,
-		categoryRepository:   categoryRepository,
-		transactionProvider:  transactionProvider,
+		container:              container,
+		fileStorage:            fileStorage,
+		accountRepository:      accountRepository,
+		attachmentRepository:   attachmentRepository,
+		tagRepository:          tagRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		sessionRepository:      sessionRepository,
+		privacyRepository:      privacyRepository,
+		portfolioRepository:    portfolioRepository,
+		categoryRepository:     categoryRepository,
+		transactionProvider:    transactionProvider,
+		exportRepository:       exportRepository,
+		reportRepository:       reportRepository,
 	}
 }
 
-func (a *account) CreateAccount(ctx context.Context, createAccount model.CreateAccount) (*int64, error) {
+func (a *account) CreateAccount(ctx context.Context, createAccount model.CreateAccount, source model.AuditSource) (*int64, error) {
 	log := a.container.GetLogger()
 	var (
 		accountID                *int64
 		documentAttachmentEntity *entity.Attachment
 		avatarAttachmentEntity   *entity.Attachment
 	)
-	var initData = telegram.InitData{
-		Token: a.container.GetTelegramBotToken(),
-	}
-	telegramInitModel, err := initData.Decode(createAccount.TelegramInitData)
-	if err != nil {
-		log.Error("fail to decode telegram init data", logger.FError(err))
-		return nil, model.DecodeTelegramInitDataError
-	}
-	validTelegramInitData, err := initData.Validate(telegramInitModel)
+	telegramInitModel, err := a.verifyTelegramInitData(createAccount.TelegramInitData)
 	if err != nil {
-		log.Error("fail to validate telegram init data", logger.FError(err))
-		return nil, model.InvalidTelegramInitDataError
-	}
-	if !validTelegramInitData {
-		log.Error("not valid telegram init data", logger.FError(err))
-		return nil, model.InvalidTelegramInitDataError
+		return nil, err
 	}
 	isDeletedAccountWithTelegramID, err := a.accountRepository.IsDeletedAccountByTelegramID(ctx, telegramInitModel.TelegramUser.ID)
 	if isDeletedAccountWithTelegramID {
 		log.Error("account is already deleted", logger.F("telegram_id", telegramInitModel.TelegramUser.ID))
+		if _, err := a.getRestorableAccount(ctx, telegramInitModel.TelegramUser.ID); err == nil {
+			return nil, model.RestorableAccountError
+		}
 		return nil, model.DuplicateAccountWithTelegramIDError
 	}
 	existAccountWithTelegramID, err := a.accountRepository.ExistsWithTelegramID(ctx, telegramInitModel.TelegramUser.ID)
@@ -125,6 +142,12 @@ func (a *account) CreateAccount(ctx context.Context, createAccount model.CreateA
 		log.Error("unknown role from string", logger.FError(err))
 		return nil, err
 	}
+	if createAccount.Nickname != nil {
+		if err := checkNicknameAvailable(ctx, a.accountRepository, 0, *createAccount.Nickname); err != nil {
+			log.Error("nickname is not available", logger.FError(err), logger.F("nickname", *createAccount.Nickname))
+			return nil, err
+		}
+	}
 	err = a.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
 		var (
 			companyID *int64
@@ -148,7 +171,7 @@ func (a *account) CreateAccount(ctx context.Context, createAccount model.CreateA
 		avatarFileHeader := createAccount.AvatarFileHeader
 		documentFileHeader := createAccount.DocumentFileHeader
 		if avatarFileHeader != nil {
-			avatarAttachmentEntity, err = a.uploadAndPrepareAttachmentEntity(avatarFileHeader)
+			avatarAttachmentEntity, err = uploadAndPrepareAttachmentEntity(log, a.fileStorage, avatarFileHeader)
 			if err != nil {
 				log.Error("fail to upload and prepare a avatar attachment entity", logger.FError(err))
 				return err
@@ -169,7 +192,7 @@ func (a *account) CreateAccount(ctx context.Context, createAccount model.CreateA
 			avatarAttachmentEntity.ID = *avatarAttachmentEntityID
 		}
 		if documentFileHeader != nil {
-			documentAttachmentEntity, err = a.uploadAndPrepareAttachmentEntity(documentFileHeader)
+			documentAttachmentEntity, err = uploadAndPrepareAttachmentEntity(log, a.fileStorage, documentFileHeader)
 			if err != nil {
 				log.Error("fail to upload and prepare a document attachment entity", logger.FError(err))
 				return err
@@ -217,8 +240,18 @@ func (a *account) CreateAccount(ctx context.Context, createAccount model.CreateA
 		accountID, err = composed.Account.Create(ctx, &accountEntity)
 		if err != nil {
 			log.Error("fail to record account in db", logger.FError(err))
+			return nicknameConflictError(err)
+		}
+		if err := composed.Account.AddRole(ctx, *accountID, role); err != nil {
+			log.Error("fail to add role to account", logger.FError(err))
 			return err
 		}
+		if documentAttachmentEntity != nil {
+			if err := composed.Account.SubmitVerification(ctx, *accountID); err != nil {
+				log.Error("fail to submit account verification", logger.FError(err))
+				return err
+			}
+		}
 		if createAccount.HasTags() {
 			tags := convertTags(*createAccount.Tags)
 			for _, tag := range tags {
@@ -249,6 +282,19 @@ func (a *account) CreateAccount(ctx context.Context, createAccount model.CreateA
 				}
 			}
 		}
+		if createAccount.HasCompany() {
+			accountEntity.Company = &entity.Company{
+				Name:        *createAccount.CompanyName,
+				Description: *createAccount.CompanyDescription,
+			}
+		}
+		accountEntity.AvatarAttachment = avatarAttachmentEntity
+		accountEntity.DocumentAttachment = documentAttachmentEntity
+		diff := diffAccountAudit(accountAuditSnapshot(nil), accountAuditSnapshot(&accountEntity))
+		if err := recordAccountAudit(ctx, composed.Audit, *accountID, model.CreateAuditAction, source, diff); err != nil {
+			log.Error("fail to record account audit", logger.FError(err))
+			return err
+		}
 		return nil
 	})
 	if err != nil && avatarAttachmentEntity != nil {
@@ -268,7 +314,7 @@ func (a *account) CreateAccount(ctx context.Context, createAccount model.CreateA
 	return accountID, nil
 }
 
-func (a *account) EditAccount(ctx context.Context, editAccount model.EditAccount) error {
+func (a *account) EditAccount(ctx context.Context, editAccount model.EditAccount, source model.AuditSource) error {
 	log := a.container.GetLogger()
 	gender, err := entity.GenderFromString(editAccount.Gender)
 	if err != nil {
@@ -297,6 +343,13 @@ func (a *account) EditAccount(ctx context.Context, editAccount model.EditAccount
 				return err
 			}
 		}
+		before := accountAuditSnapshot(account)
+		if editAccount.Nickname != nil && !isSameNickname(account.Nickname, editAccount.Nickname) {
+			if err := checkNicknameAvailable(ctx, composed.Account, account.ID, *editAccount.Nickname); err != nil {
+				log.Error("nickname is not available", logger.FError(err), logger.F("nickname", *editAccount.Nickname))
+				return err
+			}
+		}
 		var (
 			oldAvatarFileName   *string
 			oldDocumentFileName *string
@@ -350,7 +403,7 @@ func (a *account) EditAccount(ctx context.Context, editAccount model.EditAccount
 			account.Company = newCompany
 		}
 		if editAccount.AvatarFileHeader != nil {
-			newAvatarAttachmentEntity, err = a.uploadAndPrepareAttachmentEntity(editAccount.AvatarFileHeader)
+			newAvatarAttachmentEntity, err = uploadAndPrepareAttachmentEntity(log, a.fileStorage, editAccount.AvatarFileHeader)
 			if err != nil {
 				log.Error(
 					"fail to upload avatar attachment and prepare entity for db",
@@ -358,6 +411,7 @@ func (a *account) EditAccount(ctx context.Context, editAccount model.EditAccount
 				)
 				return err
 			}
+			account.AvatarAttachment = newAvatarAttachmentEntity
 			if account.AvatarAttachmentID != nil {
 				newAvatarAttachmentEntity.ID = *account.AvatarAttachmentID
 				if err := a.attachmentRepository.Update(ctx, newAvatarAttachmentEntity); err != nil {
@@ -381,11 +435,12 @@ func (a *account) EditAccount(ctx context.Context, editAccount model.EditAccount
 			}
 		}
 		if editAccount.DocumentFileHeader != nil {
-			newDocumentAttachmentEntity, err = a.uploadAndPrepareAttachmentEntity(editAccount.DocumentFileHeader)
+			newDocumentAttachmentEntity, err = uploadAndPrepareAttachmentEntity(log, a.fileStorage, editAccount.DocumentFileHeader)
 			if err != nil {
 				log.Error("fail to upload document attachment and prepare entity for db", logger.FError(err))
 				return err
 			}
+			account.DocumentAttachment = newDocumentAttachmentEntity
 			if account.DocumentAttachmentID != nil {
 				newDocumentAttachmentEntity.ID = *account.DocumentAttachmentID
 				if err := a.attachmentRepository.Update(ctx, newDocumentAttachmentEntity); err != nil {
@@ -409,62 +464,19 @@ func (a *account) EditAccount(ctx context.Context, editAccount model.EditAccount
 			}
 		}
 
-		oldTags, err := composed.Tag.GetTagsByAccountID(ctx, account.ID)
-		if err != nil {
-			log.Error("fail to get tags by ")
-			return err
-		}
-		if err := composed.Tag.RemoveAllFromAccountID(ctx, account.ID); err != nil {
-			log.Error("fail to remove tags from account", logger.FError(err))
-			return err
-		}
-		for _, oldTag := range oldTags {
-			if err := composed.Tag.Cleanup(ctx, oldTag.ID); err != nil {
-				log.Error("fail to cleanup tag", logger.FError(err))
-				return err
-			}
-		}
-		newTags := make([]string, 0, 0)
+		newTags := make([]string, 0)
 		if editAccount.Tags != nil {
 			newTags = *editAccount.Tags
 		}
-		for _, tagTitle := range newTags {
-			var tag = entity.Tag{
-				Title: tagTitle,
-			}
-			tagID, err := composed.Tag.CreateIfNeeded(ctx, &tag)
-			if err != nil {
-				log.Error("fail to create tag", logger.FError(err))
-				return err
-			}
-			if tagID == nil {
-				log.Error("tag_id has nil value")
-				return model.NilError
-			}
-			accountTag := entity.AccountTag{
-				AccountID: account.ID,
-				TagID:     *tagID,
-			}
-			err = composed.Tag.AddAccountTag(ctx, &accountTag)
-			if err != nil {
-				log.Error("fail to get tag by id", logger.FError(err))
-				return err
-			}
-		}
-
-		if err := composed.Category.DeleteCategoriesFromAccount(ctx, account.ID); err != nil {
-			log.Error("fail to delete categories from account", logger.FError(err))
+		if err := a.syncAccountTags(ctx, composed, account.ID, newTags); err != nil {
 			return err
 		}
 		var categoryIDs = make([]int64, 0)
 		if editAccount.CategoryIDs != nil {
 			categoryIDs = *editAccount.CategoryIDs
 		}
-		for _, categoryID := range categoryIDs {
-			if err := composed.Category.AddCategoryToAccount(ctx, categoryID, account.ID); err != nil {
-				log.Error("fail to bind category to account", logger.FError(err))
-				return err
-			}
+		if err := a.syncAccountCategories(ctx, composed, account.ID, categoryIDs); err != nil {
+			return err
 		}
 		account.FirstName = editAccount.FirstName
 		account.MiddleName = editAccount.MiddleName
@@ -477,6 +489,21 @@ func (a *account) EditAccount(ctx context.Context, editAccount model.EditAccount
 		account.Location = &editAccount.Location
 		if err := composed.Account.Update(ctx, account); err != nil {
 			log.Error("fail to update entity", logger.FError(err))
+			return nicknameConflictError(err)
+		}
+		if err := composed.Account.AddRole(ctx, account.ID, account.Role); err != nil {
+			log.Error("fail to add role to account", logger.FError(err))
+			return err
+		}
+		if newDocumentAttachmentEntity != nil {
+			if err := composed.Account.SubmitVerification(ctx, account.ID); err != nil {
+				log.Error("fail to submit account verification", logger.FError(err))
+				return err
+			}
+		}
+		diff := diffAccountAudit(before, accountAuditSnapshot(account))
+		if err := recordAccountAudit(ctx, composed.Audit, account.ID, model.EditAuditAction, source, diff); err != nil {
+			log.Error("fail to record account audit", logger.FError(err))
 			return err
 		}
 		if oldAvatarFileName != nil {
@@ -507,222 +534,1195 @@ func (a *account) EditAccount(ctx context.Context, editAccount model.EditAccount
 	return nil
 }
 
-func (a *account) GeneratePairToken(accountID int64) (*model.PairToken, error) {
-	log := a.container.GetLogger()
-	pairToken, err := jwt.NewJWT(a.container.GetJWTSecretKey()).GeneratePairJWT(accountID)
-	if err != nil {
-		log.Error("fail to generate pair token", logger.FError(err))
-		return nil, err
-	}
-	return &model.PairToken{
-		Access:  pairToken.Access,
-		Refresh: pairToken.Refresh,
-	}, nil
-}
-
-func (a *account) ParseAccessToken(accessToken string) (*int64, error) {
+func (a *account) PatchAccount(ctx context.Context, patchAccount model.PatchAccount, source model.AuditSource) error {
 	log := a.container.GetLogger()
-	accessClaimsToken, err := jwt.NewJWT(a.container.GetJWTSecretKey()).ParseAccessToken(accessToken)
+	err := a.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		account, err := composed.Account.GetFullDetailByID(ctx, patchAccount.ID)
+		if err != nil {
+			log.Error("fail to get account by id", logger.FError(err))
+			switch err {
+			case sql.ErrNoRows:
+				return model.EntityNotFoundError
+			default:
+				return err
+			}
+		}
+		before := accountAuditSnapshot(account)
+		previousNickname := account.Nickname
+		if err := applyAccountPatch(account, patchAccount); err != nil {
+			log.Error("fail to apply account patch", logger.FError(err), logger.F("account_id", account.ID))
+			return err
+		}
+		if patchAccount.Nickname.Set && !isSameNickname(previousNickname, account.Nickname) {
+			if err := checkNicknameAvailable(ctx, composed.Account, account.ID, *account.Nickname); err != nil {
+				log.Error("nickname is not available", logger.FError(err), logger.F("nickname", *account.Nickname))
+				return err
+			}
+		}
+		if patchAccount.Company.Set {
+			if err := a.patchAccountCompany(ctx, composed, account, patchAccount.Company.Value); err != nil {
+				return err
+			}
+		}
+		if patchAccount.Tags.Set {
+			tags := make([]string, 0)
+			if patchAccount.Tags.Value != nil {
+				tags = *patchAccount.Tags.Value
+			}
+			if err := a.syncAccountTags(ctx, composed, account.ID, tags); err != nil {
+				return err
+			}
+		}
+		if patchAccount.CategoryIDs.Set {
+			categoryIDs := make([]int64, 0)
+			if patchAccount.CategoryIDs.Value != nil {
+				categoryIDs = *patchAccount.CategoryIDs.Value
+			}
+			if err := a.syncAccountCategories(ctx, composed, account.ID, categoryIDs); err != nil {
+				return err
+			}
+		}
+		if err := composed.Account.Update(ctx, account); err != nil {
+			log.Error("fail to update entity", logger.FError(err))
+			return nicknameConflictError(err)
+		}
+		if err := composed.Account.AddRole(ctx, account.ID, account.Role); err != nil {
+			log.Error("fail to add role to account", logger.FError(err))
+			return err
+		}
+		diff := diffAccountAudit(before, accountAuditSnapshot(account))
+		if err := recordAccountAudit(ctx, composed.Audit, account.ID, model.EditAuditAction, source, diff); err != nil {
+			log.Error("fail to record account audit", logger.FError(err))
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		log.Error("fail to parse/validate access token", logger.FError(err))
-		return nil, err
-	}
-	if accessClaimsToken == nil {
-		err := model.NilError
-		log.Error("fail to get nil error", logger.FError(err))
-		return nil, err
+		log.Error("fail to perform transaction while patching account", logger.FError(err))
+		return err
 	}
-	return &accessClaimsToken.ID, err
+	return nil
 }
 
-func (a *account) AuthenticationTelegram(ctx context.Context, telegramInitData string) (*int64, error) {
+// patchAccountCompany removes the company on null, otherwise it merges the patch
+// into the existing company or creates a new one, a new company requires a name.
+func (a *account) patchAccountCompany(
+	ctx context.Context,
+	composed transaction.ComposedRepository,
+	account *entity.Account,
+	patchCompany *model.PatchCompany,
+) error {
 	log := a.container.GetLogger()
-	var initData = telegram.InitData{
-		Token: a.container.GetTelegramBotToken(),
-	}
-	telegramInitModel, err := initData.Decode(telegramInitData)
-	if err != nil {
-		log.Error("fail to decode telegram init data from string", logger.FError(err))
-		return nil, err
+	if patchCompany == nil {
+		if !account.HasCompany() {
+			return nil
+		}
+		if err := composed.Company.Delete(ctx, *account.CompanyID); err != nil {
+			log.Error("fail to delete company by id", logger.FError(err))
+			return err
+		}
+		account.CompanyID = nil
+		account.Company = nil
+		return nil
 	}
-	ok, err := initData.Validate(telegramInitModel)
-	if err != nil {
-		log.Error("fail to process validate telegram init data", logger.FError(err))
-		return nil, err
+	if patchCompany.Name.Set && (patchCompany.Name.Value == nil || len(*patchCompany.Name.Value) == 0) {
+		return model.InvalidAccountPatchError
 	}
-	if !ok {
-		err = model.InvalidTelegramInitDataError
-		log.Error("invalid telegram initialization data provided", logger.FError(err))
-		return nil, err
+	var description string
+	if patchCompany.Description.Value != nil {
+		description = *patchCompany.Description.Value
 	}
-	accountEntity, err := a.accountRepository.GetByTelegramID(ctx, telegramInitModel.TelegramUser.ID)
-	if err != nil {
-		log.Error("can't retrieve telegram by id", logger.FError(err))
-		switch err {
-		case sql.ErrNoRows:
-			return nil, model.EntityNotFoundError
-		default:
-			return nil, err
+	if account.HasCompany() {
+		company := account.Company
+		if patchCompany.Name.Set {
+			company.Name = *patchCompany.Name.Value
 		}
-	}
-	if accountEntity == nil {
-		err = model.NilError
-		log.Error("fail to get account by id", logger.FError(err))
-		return nil, nil
-	}
-	return &accountEntity.ID, nil
-}
-
-func (a *account) GetDetailsAccount(ctx context.Context, id int64) (*model.Account, error) {
-	log := a.container.GetLogger()
-	account, err := a.accountRepository.GetFullDetailByID(ctx, id)
-	if err != nil {
-		log.Error("fail to get account by id", logger.FError(err))
-		switch err {
-		case sql.ErrNoRows:
-			return nil, model.EntityNotFoundError
-		default:
-			return nil, err
+		if patchCompany.Description.Set {
+			company.Description = description
+		}
+		if err := composed.Company.Update(ctx, company); err != nil {
+			log.Error("fail to update company by id", logger.FError(err))
+			return err
 		}
+		return nil
 	}
-	accountModel := accountConverter.ConvertEntity2AccountModel(account)
-	tags, err := a.tagRepository.GetTagsByAccountID(ctx, id)
-	if err != nil {
-		log.Error("fail to get tags by account_id", logger.F("account_id", id))
-		return nil, err
+	if !patchCompany.Name.Set {
+		return model.InvalidAccountPatchError
 	}
-	tagModels := accountConverter.ConvertEntities2TagModels(tags)
-	accountModel.Tags = &tagModels
-	categories, err := a.categoryRepository.GetCategoriesByAccountID(ctx, id)
-	if err != nil {
-		log.Error("fail to get categories by account_id", logger.F("account_id", id))
-		return nil, err
+	company := entity.Company{
+		Name:        *patchCompany.Name.Value,
+		Description: description,
 	}
-	categoryModels := categoryConverter.ConvertEntities2CategoriesModel(categories)
-	accountModel.Categories = &categoryModels
-
-	return accountModel, nil
-}
-
-func (a *account) AccountHasRole(ctx context.Context, accountID int64, role model.Role) (bool, error) {
-	log := a.container.GetLogger()
-	account, err := a.accountRepository.GetByID(ctx, accountID)
+	companyID, err := composed.Company.Create(ctx, &company)
 	if err != nil {
-		log.Error("fail to get by id", logger.FError(err))
-		return false, nil
+		log.Error("fail to create company for account", logger.F("account_id", account.ID), logger.FError(err))
+		return err
 	}
-	return account.Role.String() == string(role), nil
+	account.CompanyID = companyID
+	return nil
 }
 
-func (a *account) DeleteAccount(ctx context.Context, accountID int64) error {
+// syncAccountTags adds and removes only the tags that differ from the current ones,
+// tags no longer used by any account are cleaned up.
+func (a *account) syncAccountTags(ctx context.Context, composed transaction.ComposedRepository, accountID int64, titles []string) error {
 	log := a.container.GetLogger()
-	account, err := a.accountRepository.GetFullDetailByID(ctx, accountID)
+	oldTags, err := composed.Tag.GetTagsByAccountID(ctx, accountID)
 	if err != nil {
-		log.Error("fail to get profile by id", logger.FError(err))
-		switch err {
-		case sql.ErrNoRows:
-			return model.EntityNotFoundError
-		default:
+		log.Error("fail to get tags by account id", logger.FError(err))
+		return err
+	}
+	newTitles := make(map[string]struct{}, len(titles))
+	for _, title := range convertTags(titles) {
+		if len(title) == 0 {
+			continue
+		}
+		newTitles[title] = struct{}{}
+	}
+	for _, oldTag := range oldTags {
+		if _, ok := newTitles[oldTag.Title]; ok {
+			delete(newTitles, oldTag.Title)
+			continue
+		}
+		accountTag := entity.AccountTag{
+			AccountID: accountID,
+			TagID:     oldTag.ID,
+		}
+		if err := composed.Tag.RemoveAccountTag(ctx, &accountTag); err != nil {
+			log.Error("fail to remove tag from account", logger.FError(err))
+			return err
+		}
+		if err := composed.Tag.Cleanup(ctx, oldTag.ID); err != nil {
+			log.Error("fail to cleanup tag", logger.FError(err))
 			return err
 		}
 	}
-	err = a.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
-		if err = composed.Account.Delete(ctx, account.ID); err != nil {
-			log.Error("fail to delete account from db", logger.FError(err))
+	for title := range newTitles {
+		tag := entity.Tag{
+			Title: title,
+		}
+		tagID, err := composed.Tag.CreateIfNeeded(ctx, &tag)
+		if err != nil {
+			log.Error("fail to create tag", logger.FError(err))
 			return err
 		}
-		if companyID := account.CompanyID; companyID != nil {
-			err = composed.Company.Delete(ctx, *companyID)
-			if err != nil {
-				log.Error("fail to delete company from db", logger.FError(err))
-				return err
-			}
+		if tagID == nil {
+			log.Error("tag_id has nil value")
+			return model.NilError
 		}
-		if avatarAttachmentID := account.AvatarAttachmentID; avatarAttachmentID != nil {
-			err = composed.Attachment.Delete(ctx, *avatarAttachmentID)
-			if err != nil {
-				log.Error("fail to delete avatar attachment from db", logger.FError(err))
-				return err
-			}
+		accountTag := entity.AccountTag{
+			AccountID: accountID,
+			TagID:     *tagID,
 		}
-		if documentAttachmentID := account.DocumentAttachmentID; documentAttachmentID != nil {
-			err := composed.Attachment.Delete(ctx, *documentAttachmentID)
-			if err != nil {
-				log.Error("fail to delete document attachment from db", logger.FError(err))
-				return err
-			}
+		if err := composed.Tag.AddAccountTag(ctx, &accountTag); err != nil {
+			log.Error("fail to add tag to account", logger.FError(err))
+			return err
 		}
-		return nil
-	})
-	if err != nil {
-		log.Error("fail to delete a account and associate data to it from db", logger.FError(err))
-		return err
 	}
 	return nil
 }
-func (a *account) ChangeRole(ctx context.Context, accountID int64, role model.Role) error {
+
+// syncAccountCategories binds and unbinds only the categories that differ from the current ones.
+func (a *account) syncAccountCategories(ctx context.Context, composed transaction.ComposedRepository, accountID int64, categoryIDs []int64) error {
 	log := a.container.GetLogger()
-	accountEntity, err := a.accountRepository.GetByID(ctx, accountID)
+	oldCategories, err := composed.Category.GetCategoriesByAccountID(ctx, accountID)
 	if err != nil {
-		log.Error("fail to get account by id", logger.FError(err))
+		log.Error("fail to get categories by account id", logger.FError(err))
 		return err
 	}
-	accountEntity.Role, err = entity.RoleFromString(string(role))
-	if err != nil {
-		log.Error("fail to parse role", logger.FError(err))
-		return err
+	newCategoryIDs := make(map[int64]struct{}, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		newCategoryIDs[categoryID] = struct{}{}
 	}
-	if err = a.accountRepository.Update(ctx, accountEntity); err != nil {
-		log.Error("fail to update account", logger.FError(err))
-		return err
+	for _, oldCategory := range oldCategories {
+		if _, ok := newCategoryIDs[oldCategory.ID]; ok {
+			delete(newCategoryIDs, oldCategory.ID)
+			continue
+		}
+		if err := composed.Category.RemoveCategoryFromAccount(ctx, oldCategory.ID, accountID); err != nil {
+			log.Error("fail to unbind category from account", logger.FError(err))
+			return err
+		}
+	}
+	for categoryID := range newCategoryIDs {
+		if err := composed.Category.AddCategoryToAccount(ctx, categoryID, accountID); err != nil {
+			log.Error("fail to bind category to account", logger.FError(err))
+			return err
+		}
 	}
 	return nil
 }
 
-func (a *account) uploadAndPrepareAttachmentEntity(fileHeader *multipart.FileHeader) (*entity.Attachment, error) {
+func (a *account) GeneratePairToken(ctx context.Context, accountID int64, device model.Device) (*model.PairToken, error) {
 	log := a.container.GetLogger()
-	attachmentUploadFile, err := a.unpackFileHeader(fileHeader)
+	pairToken, err := a.jwtProvider().GeneratePairJWT(accountID)
 	if err != nil {
-		log.Error("fail to unpack a file header", logger.FError(err))
+		log.Error("fail to generate pair token", logger.FError(err))
 		return nil, err
 	}
-	if attachmentUploadFile == nil {
-		log.Error("avatar upload file has nil error")
-		return nil, model.NilError
-	}
-	attachmentFilePath, err := a.fileStorage.UploadFile(attachmentUploadFile.Name, attachmentUploadFile.File)
+	err = a.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		sessionEntity := entity.Session{
+			ID:        pairToken.FamilyID,
+			AccountID: accountID,
+			Device:    utils.DeviceName(device.UserAgent, device.Platform),
+			Platform:  utils.NewNonEmptyString(device.Platform),
+			UserAgent: utils.NewNonEmptyString(device.UserAgent),
+			IP:        utils.NewNonEmptyString(device.IP),
+		}
+		if err := composed.Session.Create(ctx, &sessionEntity); err != nil {
+			log.Error("fail to create session", logger.FError(err))
+			return err
+		}
+		refreshTokenEntity := entity.RefreshToken{
+			AccountID: accountID,
+			TokenID:   pairToken.RefreshID,
+			FamilyID:  pairToken.FamilyID,
+			ExpiresAt: pairToken.RefreshExpiresAt,
+		}
+		if _, err := composed.RefreshToken.Create(ctx, &refreshTokenEntity); err != nil {
+			log.Error("fail to record refresh token", logger.FError(err))
+			return err
+		}
+		return nil
+	})
 	if err != nil {
-		log.Error("fail to upload avatar file to file storage", logger.FError(err))
+		log.Error("fail to perform transaction while generating pair token", logger.FError(err))
 		return nil, err
 	}
-	attachment := entity.Attachment{
-		FileName: attachmentUploadFile.Name,
-		Path:     attachmentFilePath,
-	}
-	return &attachment, nil
-}
-
-func (a *account) cleanupFileStore(name string) error {
-	return a.fileStorage.DeleteFile(name)
+	return &model.PairToken{
+		Access:  pairToken.Access,
+		Refresh: pairToken.Refresh,
+	}, nil
 }
 
-func (a *account) unpackFileHeader(fileHeader *multipart.FileHeader) (*uploadFile, error) {
+func (a *account) RefreshPairToken(ctx context.Context, refreshToken string) (*model.PairToken, error) {
 	log := a.container.GetLogger()
-	file, err := fileHeader.Open()
+	jwtProvider := a.jwtProvider()
+	refreshClaimsToken, err := jwtProvider.ParseRefreshToken(refreshToken)
 	if err != nil {
-		log.Error("fail to open avatar file", logger.FError(err))
-		return nil, err
+		log.Error("fail to parse/validate refresh token", logger.FError(err))
+		return nil, model.InvalidRefreshTokenError
 	}
-	fileExt, err := utils.ExtFromFileName(fileHeader.Filename)
-	if err != nil {
-		log.Error("fail to extract extension from filename", logger.FError(err))
+	var (
+		pairToken *jwtModel.PairToken
+		reused    bool
+	)
+	err = a.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		refreshTokenEntity, err := composed.RefreshToken.GetByTokenID(ctx, refreshClaimsToken.RegisteredClaims.ID)
+		if err != nil {
+			log.Error("fail to get refresh token by token id", logger.FError(err))
+			switch err {
+			case sql.ErrNoRows:
+				return model.InvalidRefreshTokenError
+			default:
+				return err
+			}
+		}
+		if refreshTokenEntity.AccountID != refreshClaimsToken.ID ||
+			refreshTokenEntity.FamilyID != refreshClaimsToken.FamilyID {
+			log.Error("refresh token does not match its record", logger.F("token_id", refreshTokenEntity.TokenID))
+			return model.InvalidRefreshTokenError
+		}
+		if refreshTokenEntity.RevokedAt != nil || time.Now().After(refreshTokenEntity.ExpiresAt) {
+			log.Error("refresh token is revoked or expired", logger.F("token_id", refreshTokenEntity.TokenID))
+			return model.InvalidRefreshTokenError
+		}
+		sessionEntity, err := composed.Session.GetByID(ctx, refreshTokenEntity.FamilyID)
+		if err != nil {
+			log.Error("fail to get session by id", logger.FError(err))
+			switch err {
+			case sql.ErrNoRows:
+				return model.InvalidRefreshTokenError
+			default:
+				return err
+			}
+		}
+		if sessionEntity.RevokedAt != nil {
+			log.Error("session has been revoked", logger.F("session_id", sessionEntity.ID))
+			return model.SessionRevokedError
+		}
+		marked, err := composed.RefreshToken.MarkUsed(ctx, refreshTokenEntity.TokenID)
+		if err != nil {
+			log.Error("fail to mark refresh token as used", logger.FError(err))
+			return err
+		}
+		if !marked {
+			reused = true
+			if err := composed.Session.Revoke(ctx, sessionEntity.ID); err != nil {
+				log.Error("fail to revoke session", logger.FError(err))
+				return err
+			}
+			return composed.RefreshToken.RevokeFamily(ctx, refreshTokenEntity.FamilyID)
+		}
+		accountEntity, err := composed.Account.GetStatusByID(ctx, refreshTokenEntity.AccountID)
+		if err != nil {
+			log.Error("fail to get account status by id", logger.FError(err))
+			switch err {
+			case sql.ErrNoRows:
+				return model.EntityNotFoundError
+			default:
+				return err
+			}
+		}
+		if err := accountStatusError(accountEntity); err != nil {
+			log.Error("account is restricted", logger.F("account_id", accountEntity.ID))
+			return err
+		}
+		pairToken, err = jwtProvider.RotatePairJWT(refreshClaimsToken)
+		if err != nil {
+			log.Error("fail to rotate pair token", logger.FError(err))
+			return err
+		}
+		if err := composed.Session.Touch(ctx, sessionEntity.ID); err != nil {
+			log.Error("fail to touch session", logger.FError(err))
+			return err
+		}
+		newRefreshTokenEntity := entity.RefreshToken{
+			AccountID: refreshTokenEntity.AccountID,
+			TokenID:   pairToken.RefreshID,
+			FamilyID:  pairToken.FamilyID,
+			ExpiresAt: pairToken.RefreshExpiresAt,
+		}
+		if _, err := composed.RefreshToken.Create(ctx, &newRefreshTokenEntity); err != nil {
+			log.Error("fail to record refresh token", logger.FError(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while refreshing pair token", logger.FError(err))
+		return nil, err
+	}
+	if reused {
+		log.Warn(
+			"refresh token reuse detected, token family has been revoked",
+			logger.F("account_id", refreshClaimsToken.ID),
+			logger.F("family_id", refreshClaimsToken.FamilyID),
+		)
+		return nil, model.RefreshTokenReusedError
+	}
+	if pairToken == nil {
+		log.Error("pair token has nil value")
+		return nil, model.NilError
+	}
+	return &model.PairToken{
+		Access:  pairToken.Access,
+		Refresh: pairToken.Refresh,
+	}, nil
+}
+
+func (a *account) Authorize(ctx context.Context, accessToken string) (*model.Authorization, error) {
+	log := a.container.GetLogger()
+	accessClaimsToken, err := a.jwtProvider().ParseAccessToken(accessToken)
+	if err != nil {
+		log.Error("fail to parse/validate access token", logger.FError(err))
+		return nil, err
+	}
+	if accessClaimsToken == nil {
+		err := model.NilError
+		log.Error("fail to get nil error", logger.FError(err))
+		return nil, err
+	}
+	sessionEntity, err := a.sessionRepository.GetByID(ctx, accessClaimsToken.SessionID)
+	if err != nil {
+		log.Error("fail to get session by id", logger.FError(err))
+		switch err {
+		case sql.ErrNoRows:
+			return nil, model.SessionRevokedError
+		default:
+			return nil, err
+		}
+	}
+	if sessionEntity.AccountID != accessClaimsToken.ID || sessionEntity.RevokedAt != nil {
+		log.Error("session has been revoked", logger.F("session_id", sessionEntity.ID))
+		return nil, model.SessionRevokedError
+	}
+	accountEntity, err := a.accountRepository.GetStatusByID(ctx, accessClaimsToken.ID)
+	if err != nil {
+		log.Error("fail to get account status by id", logger.FError(err))
+		switch err {
+		case sql.ErrNoRows:
+			return nil, model.EntityNotFoundError
+		default:
+			return nil, err
+		}
+	}
+	if err := accountStatusError(accountEntity); err != nil {
+		log.Error("account is restricted", logger.F("account_id", accountEntity.ID))
+		return nil, err
+	}
+	// the session is touched only when its activity is outdated, a read must not turn into a write
+	if sessionEntity.ActivityOutdated(time.Now()) {
+		if err := a.sessionRepository.Touch(ctx, sessionEntity.ID); err != nil {
+			log.Warn("fail to touch session", logger.FError(err))
+		}
+	}
+	return &model.Authorization{
+		AccountID: accessClaimsToken.ID,
+		SessionID: sessionEntity.ID,
+	}, nil
+}
+
+func (a *account) AuthenticationTelegram(ctx context.Context, telegramInitData string) (*int64, error) {
+	telegramInitModel, err := a.verifyTelegramInitData(telegramInitData)
+	if err != nil {
+		return nil, err
+	}
+	return a.getAccountIDByTelegramID(ctx, telegramInitModel.TelegramUser.ID)
+}
+
+func (a *account) AuthenticationTelegramWidget(ctx context.Context, telegramLoginWidget model.TelegramLoginWidget) (*int64, error) {
+	log := a.container.GetLogger()
+	loginWidget := a.container.GetTelegramLoginWidget()
+	telegramLoginWidgetModel := telegramModel.TelegramLoginWidget{
+		ID:        telegramLoginWidget.ID,
+		FirstName: telegramLoginWidget.FirstName,
+		AuthDate:  telegramLoginWidget.AuthDate,
+		Hash:      telegramLoginWidget.Hash,
+	}
+	if telegramLoginWidget.LastName != nil {
+		telegramLoginWidgetModel.LastName = *telegramLoginWidget.LastName
+	}
+	if telegramLoginWidget.Username != nil {
+		telegramLoginWidgetModel.Username = *telegramLoginWidget.Username
+	}
+	if telegramLoginWidget.PhotoURL != nil {
+		telegramLoginWidgetModel.PhotoURL = *telegramLoginWidget.PhotoURL
+	}
+	if err := loginWidget.Validate(&telegramLoginWidgetModel); err != nil {
+		log.Error("not valid telegram login widget data", logger.FError(err))
+		switch err {
+		case telegramModel.TelegramInitDataExpiredError:
+			return nil, model.ExpiredTelegramInitDataError
+		case telegramModel.MissingBotTokenError:
+			return nil, err
+		default:
+			return nil, model.InvalidTelegramInitDataError
+		}
+	}
+	return a.getAccountIDByTelegramID(ctx, telegramLoginWidget.ID)
+}
+
+func (a *account) getAccountIDByTelegramID(ctx context.Context, telegramID int64) (*int64, error) {
+	log := a.container.GetLogger()
+	accountEntity, err := a.accountRepository.GetByTelegramID(ctx, telegramID)
+	if err != nil {
+		log.Error("can't retrieve telegram by id", logger.FError(err))
+		switch err {
+		case sql.ErrNoRows:
+			if _, err := a.getRestorableAccount(ctx, telegramID); err == nil {
+				return nil, model.RestorableAccountError
+			}
+			return nil, model.EntityNotFoundError
+		default:
+			return nil, err
+		}
+	}
+	if accountEntity == nil {
+		err = model.NilError
+		log.Error("fail to get account by id", logger.FError(err))
+		return nil, nil
+	}
+	if err := accountStatusError(accountEntity); err != nil {
+		log.Error("account is restricted", logger.F("account_id", accountEntity.ID))
+		return nil, err
+	}
+	return &accountEntity.ID, nil
+}
+
+func (a *account) GetDetailsAccount(ctx context.Context, id int64) (*model.Account, error) {
+	log := a.container.GetLogger()
+	account, err := a.accountRepository.GetFullDetailByID(ctx, id)
+	if err != nil {
+		log.Error("fail to get account by id", logger.FError(err))
+		switch err {
+		case sql.ErrNoRows:
+			return nil, model.EntityNotFoundError
+		default:
+			return nil, err
+		}
+	}
+	accountModel := accountConverter.ConvertEntity2AccountModel(account)
+	tags, err := a.tagRepository.GetTagsByAccountID(ctx, id)
+	if err != nil {
+		log.Error("fail to get tags by account_id", logger.F("account_id", id))
+		return nil, err
+	}
+	tagModels := accountConverter.ConvertEntities2TagModels(tags)
+	accountModel.Tags = &tagModels
+	categories, err := a.categoryRepository.GetCategoriesByAccountID(ctx, id)
+	if err != nil {
+		log.Error("fail to get categories by account_id", logger.F("account_id", id))
+		return nil, err
+	}
+	categoryModels := categoryConverter.ConvertEntities2CategoriesModel(categories)
+	accountModel.Categories = &categoryModels
+	portfolioItems, err := a.portfolioRepository.GetAllByAccountID(ctx, id)
+	if err != nil {
+		log.Error("fail to get portfolio items by account_id", logger.F("account_id", id))
+		return nil, err
+	}
+	portfolioItemModels := accountConverter.ConvertEntities2PortfolioItemModels(portfolioItems)
+	accountModel.Portfolio = &portfolioItemModels
+	roles, err := a.accountRepository.GetRoles(ctx, id)
+	if err != nil {
+		log.Error("fail to get roles by account_id", logger.F("account_id", id))
+		return nil, err
+	}
+	roleModels := accountConverter.ConvertEntities2RoleModels(roles)
+	accountModel.Roles = &roleModels
+	accountModel.Audience = model.OwnerAudience
+
+	return accountModel, nil
+}
+
+// GetPublicAccount returns the account as the viewer is allowed to see it,
+// suspended and banned accounts are not shown to anyone, blocked accounts are not shown to each other.
+func (a *account) GetPublicAccount(ctx context.Context, viewerID int64, id int64) (*model.Account, error) {
+	log := a.container.GetLogger()
+	accountEntity, err := a.accountRepository.GetStatusByID(ctx, id)
+	if err != nil {
+		log.Error("fail to get account status by id", logger.FError(err), logger.F("account_id", id))
+		switch err {
+		case sql.ErrNoRows:
+			return nil, model.EntityNotFoundError
+		default:
+			return nil, err
+		}
+	}
+	if viewerID != id && accountEntity.IsRestricted(time.Now()) {
+		return nil, model.EntityNotFoundError
+	}
+	if viewerID != id {
+		blocked, err := a.accountRepository.IsBlocked(ctx, viewerID, id)
+		if err != nil {
+			log.Error("fail to check block between accounts", logger.FError(err), logger.F("account_id", id))
+			return nil, err
+		}
+		if blocked {
+			return nil, model.EntityNotFoundError
+		}
+	}
+	accountModel, err := a.GetDetailsAccount(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyPrivacy(ctx, a.accountRepository, a.privacyRepository, viewerID, accountModel); err != nil {
+		log.Error("fail to apply privacy", logger.FError(err), logger.F("account_id", id))
+		return nil, err
+	}
+	return accountModel, nil
+}
+
+func (a *account) SearchAccounts(
+	ctx context.Context,
+	viewerID int64,
+	searchAccounts model.SearchAccounts,
+) (*commonModel.Pagination[model.Account], error) {
+	log := a.container.GetLogger()
+	filter, err := convertSearchAccountsModel2Filter(searchAccounts)
+	if err != nil {
+		log.Error("fail to convert search accounts", logger.FError(err))
+		return nil, model.InvalidSearchFilterError
+	}
+	filter.ViewerID = &viewerID
+	numberOfAccounts, err := a.accountRepository.GetNumberSearchAccounts(ctx, *filter)
+	if err != nil {
+		log.Error("fail to get number of search accounts", logger.FError(err))
+		return nil, err
+	}
+	if numberOfAccounts == nil {
+		log.Error("number_of_accounts has nil value")
+		return nil, model.NilError
+	}
+	accountEntities, err := a.accountRepository.Search(ctx, *filter, searchAccounts.Offset, searchAccounts.Limit)
+	if err != nil {
+		log.Error("fail to search accounts", logger.FError(err))
+		return nil, err
+	}
+	accountModels := make([]model.Account, 0, len(accountEntities))
+	for _, accountEntity := range accountEntities {
+		accountModel := accountConverter.ConvertEntity2AccountModel(&accountEntity)
+		tags, err := a.tagRepository.GetTagsByAccountID(ctx, accountModel.ID)
+		if err != nil {
+			log.Error("fail to get tags by account_id", logger.FError(err), logger.F("account_id", accountModel.ID))
+			return nil, err
+		}
+		tagModels := accountConverter.ConvertEntities2TagModels(tags)
+		accountModel.Tags = &tagModels
+		categories, err := a.categoryRepository.GetCategoriesByAccountID(ctx, accountModel.ID)
+		if err != nil {
+			log.Error("fail to get categories by account_id", logger.FError(err), logger.F("account_id", accountModel.ID))
+			return nil, err
+		}
+		categoryModels := categoryConverter.ConvertEntities2CategoriesModel(categories)
+		accountModel.Categories = &categoryModels
+		if err := applyPrivacy(ctx, a.accountRepository, a.privacyRepository, viewerID, accountModel); err != nil {
+			log.Error("fail to apply privacy", logger.FError(err), logger.F("account_id", accountModel.ID))
+			return nil, err
+		}
+		accountModels = append(accountModels, *accountModel)
+	}
+	pagination := commonModel.Pagination[model.Account]{
+		Offset: searchAccounts.Offset,
+		Limit:  searchAccounts.Limit,
+		Total:  *numberOfAccounts,
+		Data:   accountModels,
+	}
+	return &pagination, nil
+}
+
+// IsNicknameAvailable reports whether a new account or a nickname change can take the nickname.
+func (a *account) IsNicknameAvailable(ctx context.Context, nickname string) (bool, error) {
+	log := a.container.GetLogger()
+	if !nicknameRegexp.MatchString(nickname) {
+		return false, model.InvalidNicknameError
+	}
+	err := checkNicknameAvailable(ctx, a.accountRepository, 0, nickname)
+	switch err {
+	case nil:
+		return true, nil
+	case model.NicknameUnavailableError:
+		return false, nil
+	default:
+		log.Error("fail to check nickname availability", logger.FError(err), logger.F("nickname", nickname))
+		return false, err
+	}
+}
+
+// AccountHasRole reports whether the account holds the role, an account can hold both roles at once.
+func (a *account) AccountHasRole(ctx context.Context, accountID int64, role model.Role) (bool, error) {
+	log := a.container.GetLogger()
+	roleEntity, err := entity.RoleFromString(string(role))
+	if err != nil {
+		return false, nil
+	}
+	hasRole, err := a.accountRepository.HasRole(ctx, accountID, roleEntity)
+	if err != nil {
+		log.Error("fail to check role of account", logger.FError(err), logger.F("account_id", accountID))
+		return false, err
+	}
+	return hasRole, nil
+}
+
+// DefaultRole returns the role the account acts in when a request doesn't choose one.
+func (a *account) DefaultRole(ctx context.Context, accountID int64) (model.Role, error) {
+	log := a.container.GetLogger()
+	account, err := a.accountRepository.GetByID(ctx, accountID)
+	if err != nil {
+		log.Error("fail to get by id", logger.FError(err))
+		switch err {
+		case sql.ErrNoRows:
+			return model.UnknownRole, model.EntityNotFoundError
+		default:
+			return model.UnknownRole, err
+		}
+	}
+	return accountConverter.ConvertEntity2RoleModel(account.Role), nil
+}
+
+func (a *account) DeleteAccount(ctx context.Context, accountID int64, source model.AuditSource) error {
+	log := a.container.GetLogger()
+	account, err := a.accountRepository.GetFullDetailByID(ctx, accountID)
+	if err != nil {
+		log.Error("fail to get profile by id", logger.FError(err))
+		switch err {
+		case sql.ErrNoRows:
+			return model.EntityNotFoundError
+		default:
+			return err
+		}
+	}
+	err = a.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		if err = composed.Account.Delete(ctx, account.ID); err != nil {
+			log.Error("fail to delete account from db", logger.FError(err))
+			return err
+		}
+		if companyID := account.CompanyID; companyID != nil {
+			err = composed.Company.Delete(ctx, *companyID)
+			if err != nil {
+				log.Error("fail to delete company from db", logger.FError(err))
+				return err
+			}
+		}
+		if avatarAttachmentID := account.AvatarAttachmentID; avatarAttachmentID != nil {
+			err = composed.Attachment.Delete(ctx, *avatarAttachmentID)
+			if err != nil {
+				log.Error("fail to delete avatar attachment from db", logger.FError(err))
+				return err
+			}
+		}
+		if documentAttachmentID := account.DocumentAttachmentID; documentAttachmentID != nil {
+			err := composed.Attachment.Delete(ctx, *documentAttachmentID)
+			if err != nil {
+				log.Error("fail to delete document attachment from db", logger.FError(err))
+				return err
+			}
+		}
+		diff := map[string]auditChange{
+			"deleted": {Old: false, New: true},
+		}
+		if err := recordAccountAudit(ctx, composed.Audit, account.ID, model.DeleteAuditAction, source, diff); err != nil {
+			log.Error("fail to record account audit", logger.FError(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to delete a account and associate data to it from db", logger.FError(err))
+		return err
+	}
+	return nil
+}
+func (a *account) RestoreAccount(ctx context.Context, telegramInitData string) (*int64, error) {
+	log := a.container.GetLogger()
+	telegramInitModel, err := a.verifyTelegramInitData(telegramInitData)
+	if err != nil {
 		return nil, err
 	}
-	fileName := uuid.NewString()
-	return &uploadFile{
-		Name: fmt.Sprintf("%s%s", fileName, *fileExt),
-		File: file,
-	}, nil
+	accountEntity, err := a.getRestorableAccount(ctx, telegramInitModel.TelegramUser.ID)
+	if err != nil {
+		return nil, err
+	}
+	err = a.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		if err := composed.Account.Restore(ctx, accountEntity.ID); err != nil {
+			log.Error("fail to restore account", logger.FError(err))
+			return err
+		}
+		if companyID := accountEntity.CompanyID; companyID != nil {
+			if err := composed.Company.Restore(ctx, *companyID); err != nil {
+				log.Error("fail to restore company", logger.FError(err))
+				return err
+			}
+		}
+		if avatarAttachmentID := accountEntity.AvatarAttachmentID; avatarAttachmentID != nil {
+			if err := composed.Attachment.Restore(ctx, *avatarAttachmentID); err != nil {
+				log.Error("fail to restore avatar attachment", logger.FError(err))
+				return err
+			}
+		}
+		if documentAttachmentID := accountEntity.DocumentAttachmentID; documentAttachmentID != nil {
+			if err := composed.Attachment.Restore(ctx, *documentAttachmentID); err != nil {
+				log.Error("fail to restore document attachment", logger.FError(err))
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while restoring account", logger.FError(err))
+		return nil, restoreConflictError(err)
+	}
+	return &accountEntity.ID, nil
+}
+
+// restoreConflictError explains a unique index violation of the restore: another account has taken
+// the nickname or the wallet after the restore has checked them.
+func restoreConflictError(err error) error {
+	switch {
+	case psql.IsUniqueViolation(err, accountRepository.NicknameUniqueIndex):
+		return model.RestoreNicknameTakenError
+	case psql.IsUniqueViolation(err, accountRepository.WalletAddressUniqueIndex):
+		return model.RestoreWalletTakenError
+	default:
+		return err
+	}
+}
+
+// PurgeDeletedAccounts hard-deletes up to limit accounts whose deletion grace period is over,
+// it returns the number of purged accounts.
+func (a *account) PurgeDeletedAccounts(ctx context.Context, limit int64) (int, error) {
+	log := a.container.GetLogger()
+	deletedBefore := time.Now().Add(-a.container.GetAccountConfig().DeletionGracePeriod)
+	accountEntities, err := a.accountRepository.GetPurgeableAccounts(ctx, deletedBefore, limit)
+	if err != nil {
+		log.Error("fail to get purgeable accounts", logger.FError(err))
+		return 0, err
+	}
+	purged := 0
+	for _, accountEntity := range accountEntities {
+		if err := a.purgeAccount(ctx, accountEntity); err != nil {
+			log.Error("fail to purge account", logger.FError(err), logger.F("account_id", accountEntity.ID))
+			continue
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+func (a *account) purgeAccount(ctx context.Context, accountEntity entity.Account) error {
+	log := a.container.GetLogger()
+	tagEntities, err := a.tagRepository.GetTagsByAccountID(ctx, accountEntity.ID)
+	if err != nil {
+		log.Error("fail to get tags by account id", logger.FError(err))
+		return err
+	}
+	portfolioItemEntities, err := a.portfolioRepository.GetAllByAccountID(ctx, accountEntity.ID)
+	if err != nil {
+		log.Error("fail to get portfolio items by account id", logger.FError(err))
+		return err
+	}
+	exportEntities, err := a.exportRepository.GetAllByAccountID(ctx, accountEntity.ID)
+	if err != nil {
+		log.Error("fail to get exports by account id", logger.FError(err))
+		return err
+	}
+	reportEntities, err := a.reportRepository.GetAllByReporterID(ctx, accountEntity.ID)
+	if err != nil {
+		log.Error("fail to get reports by reporter id", logger.FError(err))
+		return err
+	}
+	attachmentEntities := []*entity.Attachment{accountEntity.AvatarAttachment, accountEntity.DocumentAttachment}
+	for _, portfolioItemEntity := range portfolioItemEntities {
+		attachmentEntities = append(attachmentEntities, portfolioItemEntity.Attachment)
+	}
+	// the reports stay for the moderation without the files the account has uploaded
+	for _, reportEntity := range reportEntities {
+		attachmentEntities = append(attachmentEntities, reportEntity.Attachment)
+	}
+	err = a.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		if err := composed.Account.HardDelete(ctx, accountEntity.ID); err != nil {
+			log.Error("fail to hard delete account", logger.FError(err))
+			return err
+		}
+		for _, tagEntity := range tagEntities {
+			if err := composed.Tag.Cleanup(ctx, tagEntity.ID); err != nil {
+				log.Error("fail to cleanup tag", logger.FError(err), logger.F("tag_id", tagEntity.ID))
+				return err
+			}
+		}
+		if companyID := accountEntity.CompanyID; companyID != nil {
+			if err := composed.Company.HardDelete(ctx, *companyID); err != nil {
+				log.Error("fail to hard delete company", logger.FError(err))
+				return err
+			}
+		}
+		for _, attachmentEntity := range attachmentEntities {
+			if attachmentEntity == nil {
+				continue
+			}
+			if err := composed.Attachment.HardDelete(ctx, attachmentEntity.ID); err != nil {
+				log.Error("fail to hard delete attachment", logger.FError(err))
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while purging account", logger.FError(err))
+		return err
+	}
+	for _, attachmentEntity := range attachmentEntities {
+		if attachmentEntity == nil || len(attachmentEntity.FileName) == 0 {
+			continue
+		}
+		if err := a.cleanupFileStore(attachmentEntity.FileName); err != nil {
+			log.Error("fail to delete attachment from file storage", logger.FError(err), logger.F("file_name", attachmentEntity.FileName))
+		}
+	}
+	for _, exportEntity := range exportEntities {
+		if exportEntity.FileName == nil {
+			continue
+		}
+		if err := a.cleanupFileStore(*exportEntity.FileName); err != nil {
+			log.Error("fail to delete export archive from file storage", logger.FError(err), logger.F("file_name", *exportEntity.FileName))
+		}
+	}
+	log.Debug("account has been purged", logger.F("account_id", accountEntity.ID))
+	return nil
+}
+
+// ChangeRole makes the role the default one of the account, the account keeps the other role
+// together with its likes, dislikes and tasks.
+func (a *account) ChangeRole(ctx context.Context, accountID int64, role model.Role, source model.AuditSource) error {
+	log := a.container.GetLogger()
+	newRole, err := entity.RoleFromString(string(role))
+	if err != nil {
+		log.Error("fail to parse role", logger.FError(err))
+		return err
+	}
+	err = a.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		accountEntity, err := composed.Account.GetByID(ctx, accountID)
+		if err != nil {
+			log.Error("fail to get account by id", logger.FError(err))
+			return err
+		}
+		diff, err := a.addRole(ctx, composed, accountID, newRole)
+		if err != nil {
+			return err
+		}
+		previousRole := accountEntity.Role
+		accountEntity.Role = newRole
+		if err := composed.Account.Update(ctx, accountEntity); err != nil {
+			log.Error("fail to update account", logger.FError(err))
+			return err
+		}
+		if previousRole != newRole {
+			diff["role"] = auditChange{Old: previousRole.String(), New: newRole.String()}
+		}
+		if err := recordAccountAudit(ctx, composed.Audit, accountID, model.RoleChangeAuditAction, source, diff); err != nil {
+			log.Error("fail to record account audit", logger.FError(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while changing role", logger.FError(err))
+		return err
+	}
+	return nil
+}
+
+func (a *account) AddRole(ctx context.Context, accountID int64, role model.Role, source model.AuditSource) error {
+	log := a.container.GetLogger()
+	newRole, err := entity.RoleFromString(string(role))
+	if err != nil {
+		log.Error("fail to parse role", logger.FError(err))
+		return err
+	}
+	err = a.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		diff, err := a.addRole(ctx, composed, accountID, newRole)
+		if err != nil {
+			return err
+		}
+		if err := recordAccountAudit(ctx, composed.Audit, accountID, model.RoleChangeAuditAction, source, diff); err != nil {
+			log.Error("fail to record account audit", logger.FError(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while adding role", logger.FError(err))
+		return err
+	}
+	return nil
+}
+
+// RemoveRole takes the role away from the account, the last role can't be removed.
+// When the removed role is the default one, the remaining role becomes the default.
+func (a *account) RemoveRole(ctx context.Context, accountID int64, role model.Role, source model.AuditSource) error {
+	log := a.container.GetLogger()
+	removedRole, err := entity.RoleFromString(string(role))
+	if err != nil {
+		log.Error("fail to parse role", logger.FError(err))
+		return err
+	}
+	err = a.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		accountEntity, err := composed.Account.GetByID(ctx, accountID)
+		if err != nil {
+			log.Error("fail to get account by id", logger.FError(err))
+			return err
+		}
+		roles, err := composed.Account.GetRoles(ctx, accountID)
+		if err != nil {
+			log.Error("fail to get roles of account", logger.FError(err))
+			return err
+		}
+		remainingRoles := make([]entity.Role, 0, len(roles))
+		for _, heldRole := range roles {
+			if heldRole != removedRole {
+				remainingRoles = append(remainingRoles, heldRole)
+			}
+		}
+		if len(remainingRoles) == len(roles) {
+			return model.RoleNotHeldError
+		}
+		if len(remainingRoles) == 0 {
+			return model.LastRoleError
+		}
+		if err := composed.Account.RemoveRole(ctx, accountID, removedRole); err != nil {
+			log.Error("fail to remove role of account", logger.FError(err))
+			return err
+		}
+		diff := map[string]auditChange{
+			"roles": {Old: roleNames(roles), New: roleNames(remainingRoles)},
+		}
+		if accountEntity.Role == removedRole {
+			accountEntity.Role = remainingRoles[0]
+			if err := composed.Account.Update(ctx, accountEntity); err != nil {
+				log.Error("fail to update account", logger.FError(err))
+				return err
+			}
+			diff["role"] = auditChange{Old: removedRole.String(), New: accountEntity.Role.String()}
+		}
+		if err := recordAccountAudit(ctx, composed.Audit, accountID, model.RoleChangeAuditAction, source, diff); err != nil {
+			log.Error("fail to record account audit", logger.FError(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while removing role", logger.FError(err))
+		return err
+	}
+	return nil
+}
+
+// addRole gives the role to the account and returns the audit diff of the held roles, the diff is empty
+// when the account already holds the role.
+func (a *account) addRole(ctx context.Context, composed transaction.ComposedRepository, accountID int64, role entity.Role) (map[string]auditChange, error) {
+	log := a.container.GetLogger()
+	roles, err := composed.Account.GetRoles(ctx, accountID)
+	if err != nil {
+		log.Error("fail to get roles of account", logger.FError(err))
+		return nil, err
+	}
+	diff := make(map[string]auditChange)
+	for _, heldRole := range roles {
+		if heldRole == role {
+			return diff, nil
+		}
+	}
+	if err := composed.Account.AddRole(ctx, accountID, role); err != nil {
+		log.Error("fail to add role to account", logger.FError(err))
+		return nil, err
+	}
+	diff["roles"] = auditChange{Old: roleNames(roles), New: roleNames(append(roles, role))}
+	return diff, nil
+}
+
+func roleNames(roles []entity.Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.String())
+	}
+	return names
+}
+
+// getRestorableAccount returns the deleted account of the telegram id while its grace period lasts.
+func (a *account) getRestorableAccount(ctx context.Context, telegramID int64) (*entity.Account, error) {
+	log := a.container.GetLogger()
+	accountEntity, err := a.accountRepository.GetDeletedByTelegramID(ctx, telegramID)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			return nil, model.EntityNotFoundError
+		default:
+			log.Error("fail to get deleted account by telegram id", logger.FError(err))
+			return nil, err
+		}
+	}
+	gracePeriod := a.container.GetAccountConfig().DeletionGracePeriod
+	if accountEntity.DeletedAt == nil || time.Since(*accountEntity.DeletedAt) > gracePeriod {
+		return nil, model.RestorePeriodExpiredError
+	}
+	return accountEntity, nil
+}
+
+func (a *account) verifyTelegramInitData(telegramInitData string) (*telegramModel.TelegramInitData, error) {
+	log := a.container.GetLogger()
+	initData := a.container.GetTelegramInitData()
+	telegramInitModel, err := initData.Decode(telegramInitData)
+	if err != nil {
+		log.Error("fail to decode telegram init data", logger.FError(err))
+		return nil, model.DecodeTelegramInitDataError
+	}
+	if err := initData.Validate(telegramInitModel); err != nil {
+		log.Error("not valid telegram init data", logger.FError(err))
+		switch err {
+		case telegramModel.TelegramInitDataExpiredError:
+			return nil, model.ExpiredTelegramInitDataError
+		case telegramModel.InvalidPublicKeyError, telegramModel.UnknownValidationModeError:
+			return nil, err
+		default:
+			return nil, model.InvalidTelegramInitDataError
+		}
+	}
+	return telegramInitModel, nil
+}
+
+func (a *account) jwtProvider() *jwt.JWT {
+	return jwt.NewJWT(
+		a.container.GetJWTKeyring(),
+		a.container.GetAccessJWTExpiresIn(),
+		a.container.GetRefreshJWTExpiresIn(),
+	)
+}
+
+func (a *account) cleanupFileStore(name string) error {
+	return a.fileStorage.DeleteFile(name)
+}
+
+// accountStatusError reports why a suspended or banned account can't be used, nil otherwise.
+func accountStatusError(accountEntity *entity.Account) error {
+	if !accountEntity.IsRestricted(time.Now()) {
+		return nil
+	}
+	if accountEntity.Status == entity.BannedAccountStatus {
+		return model.AccountBannedError
+	}
+	return model.AccountSuspendedError
+}
+
+// applyAccountPatch merges the plain fields of the patch into the account,
+// fields stored as NOT NULL can't be cleared except about_me, which becomes empty.
+func applyAccountPatch(account *entity.Account, patchAccount model.PatchAccount) error {
+	requiredFields := []struct {
+		field  commonModel.Optional[string]
+		target *string
+	}{
+		{field: patchAccount.FirstName, target: &account.FirstName},
+		{field: patchAccount.LastName, target: &account.LastName},
+	}
+	for _, requiredField := range requiredFields {
+		if !requiredField.field.Set {
+			continue
+		}
+		if requiredField.field.Value == nil || len(strings.TrimSpace(*requiredField.field.Value)) == 0 {
+			return model.InvalidAccountPatchError
+		}
+		*requiredField.target = *requiredField.field.Value
+	}
+	requiredPointerFields := []struct {
+		field  commonModel.Optional[string]
+		target **string
+	}{
+		{field: patchAccount.Country, target: &account.Country},
+		{field: patchAccount.Location, target: &account.Location},
+		{field: patchAccount.Nickname, target: &account.Nickname},
+	}
+	for _, requiredPointerField := range requiredPointerFields {
+		if !requiredPointerField.field.Set {
+			continue
+		}
+		if requiredPointerField.field.Value == nil || len(strings.TrimSpace(*requiredPointerField.field.Value)) == 0 {
+			return model.InvalidAccountPatchError
+		}
+		*requiredPointerField.target = requiredPointerField.field.Value
+	}
+	if patchAccount.Nickname.Set && !nicknameRegexp.MatchString(*patchAccount.Nickname.Value) {
+		return model.InvalidAccountPatchError
+	}
+	if patchAccount.MiddleName.Set {
+		account.MiddleName = patchAccount.MiddleName.Value
+	}
+	if patchAccount.AboutMe.Set {
+		aboutMe := ""
+		if patchAccount.AboutMe.Value != nil {
+			aboutMe = *patchAccount.AboutMe.Value
+		}
+		account.AboutMe = &aboutMe
+	}
+	if patchAccount.Gender.Set {
+		if patchAccount.Gender.Value == nil {
+			return model.InvalidAccountPatchError
+		}
+		gender, err := entity.GenderFromString(*patchAccount.Gender.Value)
+		if err != nil {
+			return model.InvalidAccountPatchError
+		}
+		account.Gender = gender
+	}
+	if patchAccount.Role.Set {
+		if patchAccount.Role.Value == nil {
+			return model.InvalidAccountPatchError
+		}
+		role, err := entity.RoleFromString(*patchAccount.Role.Value)
+		if err != nil {
+			return model.InvalidAccountPatchError
+		}
+		account.Role = role
+	}
+	return nil
 }
 
 func convertTags(tags []string) []string {
@@ -735,3 +1735,33 @@ func convertTags(tags []string) []string {
 	}
 	return convertedTags
 }
+
+func convertSearchAccountsModel2Filter(searchAccounts model.SearchAccounts) (*entity.AccountSearchFilter, error) {
+	filter := entity.AccountSearchFilter{
+		Query:       searchAccounts.Query,
+		Tags:        searchAccounts.Tags,
+		CategoryIDs: searchAccounts.CategoryIDs,
+		Country:     searchAccounts.Country,
+		HasCompany:  searchAccounts.HasCompany,
+	}
+	if searchAccounts.Role != nil {
+		role, err := entity.RoleFromString(string(*searchAccounts.Role))
+		if err != nil {
+			return nil, err
+		}
+		filter.Role = &role
+	}
+	if searchAccounts.Gender != nil {
+		gender, err := entity.GenderFromString(*searchAccounts.Gender)
+		if err != nil {
+			return nil, err
+		}
+		filter.Gender = &gender
+	}
+	sort, err := entity.AccountSortFromString(string(searchAccounts.Sort))
+	if err != nil {
+		return nil, err
+	}
+	filter.Sort = sort
+	return &filter, nil
+}
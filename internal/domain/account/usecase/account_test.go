@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"errors"
+	"github.com/lib/pq"
+	"go-tonify-backend/internal/domain/account/model"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	"testing"
+)
+
+func TestRestoreConflictError(t *testing.T) {
+	otherErr := errors.New("connection refused")
+	tests := []struct {
+		name     string
+		err      error
+		expected error
+	}{
+		{name: "nickname taken", err: &pq.Error{Code: "23505", Constraint: accountRepository.NicknameUniqueIndex}, expected: model.RestoreNicknameTakenError},
+		{name: "wallet taken", err: &pq.Error{Code: "23505", Constraint: accountRepository.WalletAddressUniqueIndex}, expected: model.RestoreWalletTakenError},
+		{name: "other unique index", err: &pq.Error{Code: "23505", Constraint: "account_telegram_id_key"}},
+		{name: "other error", err: otherErr, expected: otherErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := tt.expected
+			if expected == nil {
+				expected = tt.err
+			}
+			if actual := restoreConflictError(tt.err); actual != expected {
+				t.Errorf("expected %v, got %v", expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/internal/domain/filestorage"
+	"go-tonify-backend/internal/utils"
+	"go-tonify-backend/pkg/logger"
+	"mime/multipart"
+)
+
+type uploadFile struct {
+	Name string
+	File multipart.File
+}
+
+func uploadAndPrepareAttachmentEntity(
+	log logger.Logger,
+	fileStorage filestorage.FileStorage,
+	fileHeader *multipart.FileHeader,
+) (*entity.Attachment, error) {
+	attachmentUploadFile, err := unpackFileHeader(log, fileHeader)
+	if err != nil {
+		log.Error("fail to unpack a file header", logger.FError(err))
+		return nil, err
+	}
+	if attachmentUploadFile == nil {
+		log.Error("attachment upload file has nil value")
+		return nil, model.NilError
+	}
+	attachmentFilePath, err := fileStorage.UploadFile(attachmentUploadFile.Name, attachmentUploadFile.File)
+	if err != nil {
+		log.Error("fail to upload attachment file to file storage", logger.FError(err))
+		return nil, err
+	}
+	attachment := entity.Attachment{
+		FileName: attachmentUploadFile.Name,
+		Path:     attachmentFilePath,
+	}
+	return &attachment, nil
+}
+
+func unpackFileHeader(log logger.Logger, fileHeader *multipart.FileHeader) (*uploadFile, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Error("fail to open attachment file", logger.FError(err))
+		return nil, err
+	}
+	fileExt, err := utils.ExtFromFileName(fileHeader.Filename)
+	if err != nil {
+		log.Error("fail to extract extension from filename", logger.FError(err))
+		return nil, err
+	}
+	fileName := uuid.NewString()
+	return &uploadFile{
+		Name: fmt.Sprintf("%s%s", fileName, *fileExt),
+		File: file,
+	}, nil
+}
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"go-tonify-backend/internal/container"
+	accountConverter "go-tonify-backend/internal/domain/account/converter"
+	"go-tonify-backend/internal/domain/account/model"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	"go-tonify-backend/internal/domain/entity"
+	commonModel "go-tonify-backend/internal/domain/model"
+	"go-tonify-backend/pkg/logger"
+)
+
+type Audit interface {
+	GetAccountAudit(ctx context.Context, accountID int64, offset int64, limit int64) (*commonModel.Pagination[model.AccountAudit], error)
+}
+
+type audit struct {
+	container       container.Container
+	auditRepository accountRepository.Audit
+}
+
+func NewAudit(
+	container container.Container,
+	auditRepository accountRepository.Audit,
+) Audit {
+	return &audit{
+		container:       container,
+		auditRepository: auditRepository,
+	}
+}
+
+func (a *audit) GetAccountAudit(ctx context.Context, accountID int64, offset int64, limit int64) (*commonModel.Pagination[model.AccountAudit], error) {
+	log := a.container.GetLogger()
+	total, err := a.auditRepository.CountByAccountID(ctx, accountID)
+	if err != nil {
+		log.Error("fail to count account audit", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	auditEntities, err := a.auditRepository.GetByAccountID(ctx, accountID, offset, limit)
+	if err != nil {
+		log.Error("fail to get account audit", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	pagination := commonModel.Pagination[model.AccountAudit]{
+		Offset: offset,
+		Limit:  limit,
+		Total:  total,
+		Data:   accountConverter.ConvertEntities2AuditModels(auditEntities),
+	}
+	return &pagination, nil
+}
+
+type auditChange struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// accountAuditSnapshot keeps the audited fields of the account, a nil account has no fields.
+func accountAuditSnapshot(account *entity.Account) map[string]any {
+	snapshot := make(map[string]any)
+	if account == nil {
+		return snapshot
+	}
+	optional := func(value *string) any {
+		if value == nil {
+			return nil
+		}
+		return *value
+	}
+	snapshot["first_name"] = account.FirstName
+	snapshot["middle_name"] = optional(account.MiddleName)
+	snapshot["last_name"] = account.LastName
+	snapshot["nickname"] = optional(account.Nickname)
+	snapshot["role"] = account.Role.String()
+	snapshot["about_me"] = optional(account.AboutMe)
+	snapshot["gender"] = account.Gender.String()
+	snapshot["country"] = optional(account.Country)
+	snapshot["location"] = optional(account.Location)
+	snapshot["company_name"] = nil
+	snapshot["company_description"] = nil
+	if account.Company != nil {
+		snapshot["company_name"] = account.Company.Name
+		snapshot["company_description"] = account.Company.Description
+	}
+	snapshot["avatar"] = nil
+	if account.AvatarAttachment != nil {
+		snapshot["avatar"] = account.AvatarAttachment.FileName
+	}
+	snapshot["document"] = nil
+	if account.DocumentAttachment != nil {
+		snapshot["document"] = account.DocumentAttachment.FileName
+	}
+	return snapshot
+}
+
+// diffAccountAudit returns the fields whose values differ between the snapshots.
+func diffAccountAudit(before map[string]any, after map[string]any) map[string]auditChange {
+	diff := make(map[string]auditChange)
+	for field, newValue := range after {
+		if oldValue := before[field]; oldValue != newValue {
+			diff[field] = auditChange{Old: oldValue, New: newValue}
+		}
+	}
+	for field, oldValue := range before {
+		if _, ok := after[field]; !ok && oldValue != nil {
+			diff[field] = auditChange{Old: oldValue, New: nil}
+		}
+	}
+	return diff
+}
+
+// recordAccountAudit writes the audit entry with the repository of the running transaction,
+// so the entry is kept only when the change itself is committed.
+func recordAccountAudit(
+	ctx context.Context,
+	auditRepository accountRepository.Audit,
+	accountID int64,
+	action model.AuditAction,
+	source model.AuditSource,
+	diff map[string]auditChange,
+) error {
+	diffData, err := json.Marshal(diff)
+	if err != nil {
+		return err
+	}
+	actorID := source.ActorID
+	if actorID == nil {
+		actorID = &accountID
+	}
+	auditEntity := entity.AccountAudit{
+		AccountID:      accountID,
+		ActorAccountID: actorID,
+		Action:         string(action),
+		Diff:           diffData,
+	}
+	if len(source.IP) > 0 {
+		auditEntity.SourceIP = &source.IP
+	}
+	_, err = auditRepository.Create(ctx, &auditEntity)
+	return err
+}
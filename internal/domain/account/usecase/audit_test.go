@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"go-tonify-backend/internal/domain/entity"
+	"testing"
+)
+
+func TestDiffAccountAudit(t *testing.T) {
+	aboutMe := "backend developer"
+	before := entity.Account{
+		FirstName: "Pavel",
+		LastName:  "Melnyk",
+		Role:      entity.FreelancerRole,
+		Gender:    entity.MaleGender,
+		Company:   &entity.Company{Name: "Tonify", Description: "ton freelance"},
+	}
+	after := before
+	after.FirstName = "Pavlo"
+	after.AboutMe = &aboutMe
+	after.Company = nil
+	tests := []struct {
+		name   string
+		before *entity.Account
+		after  *entity.Account
+		expect map[string]auditChange
+	}{
+		{
+			name:   "unchanged account has no diff",
+			before: &before,
+			after:  &before,
+			expect: map[string]auditChange{},
+		},
+		{
+			name:   "changed fields only",
+			before: &before,
+			after:  &after,
+			expect: map[string]auditChange{
+				"first_name":          {Old: "Pavel", New: "Pavlo"},
+				"about_me":            {Old: nil, New: aboutMe},
+				"company_name":        {Old: "Tonify", New: nil},
+				"company_description": {Old: "ton freelance", New: nil},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := diffAccountAudit(accountAuditSnapshot(tt.before), accountAuditSnapshot(tt.after))
+			if len(diff) != len(tt.expect) {
+				t.Fatalf("expected %d changed fields, got %d: %v", len(tt.expect), len(diff), diff)
+			}
+			for field, change := range tt.expect {
+				if diff[field] != change {
+					t.Errorf("field %s: expected %v, got %v", field, change, diff[field])
+				}
+			}
+		})
+	}
+}
+
+func TestDiffAccountAuditCreate(t *testing.T) {
+	diff := diffAccountAudit(accountAuditSnapshot(nil), accountAuditSnapshot(&entity.Account{FirstName: "Pavel"}))
+	change, ok := diff["first_name"]
+	if !ok || change.Old != nil || change.New != "Pavel" {
+		t.Fatalf("expected first_name to be created, got %v", diff)
+	}
+	if _, ok := diff["nickname"]; ok {
+		t.Fatalf("expected empty nickname to be left out, got %v", diff)
+	}
+}
@@ -0,0 +1,429 @@
+package usecase
+
+import (
+	"archive/zip"
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/google/uuid"
+	"go-tonify-backend/internal/container"
+	accountConverter "go-tonify-backend/internal/domain/account/converter"
+	"go-tonify-backend/internal/domain/account/model"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	categoryRepository "go-tonify-backend/internal/domain/category/repository"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/internal/domain/filestorage"
+	taskRepository "go-tonify-backend/internal/domain/task/repository"
+	"go-tonify-backend/pkg/logger"
+	"go-tonify-backend/pkg/telegram/bot"
+	botModel "go-tonify-backend/pkg/telegram/bot/model"
+	"io"
+	"os"
+	"time"
+)
+
+// exportStaleTimeout is how long an export may stay in processing before another worker reclaims it.
+const exportStaleTimeout = 30 * time.Minute
+
+// exportFailureReason is stored for every failed export, the actual error is only logged
+// since the reason is shown to the account.
+const exportFailureReason = "the archive couldn't be built, request a new export"
+
+type Export interface {
+	RequestExport(ctx context.Context, accountID int64) (*model.AccountExport, error)
+	GetExport(ctx context.Context, accountID int64, id int64) (*model.AccountExport, error)
+	DownloadExport(ctx context.Context, accountID int64, id int64) (io.ReadCloser, *model.AccountExport, error)
+	ProcessPendingExports(ctx context.Context, limit int64) (int, error)
+	ExpireExports(ctx context.Context, limit int64) (int, error)
+}
+
+type export struct {
+	container                 container.Container
+	fileStorage               filestorage.FileStorage
+	telegramBotClient         bot.Client
+	exportRepository          accountRepository.Export
+	accountRepository         accountRepository.Account
+	tagRepository             accountRepository.Tag
+	categoryRepository        categoryRepository.Category
+	taskRepository            taskRepository.Task
+	portfolioRepository       accountRepository.Portfolio
+	matchRepository           accountRepository.Match
+	reportRepository          accountRepository.Report
+	sessionRepository         accountRepository.Session
+	sanctionRepository        accountRepository.Sanction
+	privacyRepository         accountRepository.Privacy
+	matchPreferenceRepository accountRepository.MatchPreference
+}
+
+func NewExport(
+	container container.Container,
+	fileStorage filestorage.FileStorage,
+	telegramBotClient bot.Client,
+	exportRepository accountRepository.Export,
+	accountRepository accountRepository.Account,
+	tagRepository accountRepository.Tag,
+	categoryRepository categoryRepository.Category,
+	taskRepository taskRepository.Task,
+	portfolioRepository accountRepository.Portfolio,
+	matchRepository accountRepository.Match,
+	reportRepository accountRepository.Report,
+	sessionRepository accountRepository.Session,
+	sanctionRepository accountRepository.Sanction,
+	privacyRepository accountRepository.Privacy,
+	matchPreferenceRepository accountRepository.MatchPreference,
+) Export {
+	return &export{
+		container:                 container,
+		fileStorage:               fileStorage,
+		telegramBotClient:         telegramBotClient,
+		exportRepository:          exportRepository,
+		accountRepository:         accountRepository,
+		tagRepository:             tagRepository,
+		categoryRepository:        categoryRepository,
+		taskRepository:            taskRepository,
+		portfolioRepository:       portfolioRepository,
+		matchRepository:           matchRepository,
+		reportRepository:          reportRepository,
+		sessionRepository:         sessionRepository,
+		sanctionRepository:        sanctionRepository,
+		privacyRepository:         privacyRepository,
+		matchPreferenceRepository: matchPreferenceRepository,
+	}
+}
+
+// RequestExport queues a new export, an export that is still in progress is returned instead of queueing another one.
+func (e *export) RequestExport(ctx context.Context, accountID int64) (*model.AccountExport, error) {
+	log := e.container.GetLogger()
+	exportEntity, err := e.exportRepository.GetInProgressByAccountID(ctx, accountID)
+	switch err {
+	case nil:
+		return accountConverter.ConvertEntity2ExportModel(exportEntity), nil
+	case sql.ErrNoRows:
+	default:
+		log.Error("fail to get export in progress", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	exportEntity, err = e.exportRepository.Create(ctx, accountID)
+	if err != nil {
+		log.Error("fail to create export", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	return accountConverter.ConvertEntity2ExportModel(exportEntity), nil
+}
+
+func (e *export) GetExport(ctx context.Context, accountID int64, id int64) (*model.AccountExport, error) {
+	log := e.container.GetLogger()
+	exportEntity, err := e.exportRepository.GetByID(ctx, accountID, id)
+	if err != nil {
+		log.Error("fail to get export by id", logger.FError(err), logger.F("export_id", id))
+		switch err {
+		case sql.ErrNoRows:
+			return nil, model.EntityNotFoundError
+		default:
+			return nil, err
+		}
+	}
+	return accountConverter.ConvertEntity2ExportModel(exportEntity), nil
+}
+
+func (e *export) DownloadExport(ctx context.Context, accountID int64, id int64) (io.ReadCloser, *model.AccountExport, error) {
+	log := e.container.GetLogger()
+	exportModel, err := e.GetExport(ctx, accountID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch exportModel.Status {
+	case model.ReadyExportStatus:
+	case model.ExpiredExportStatus:
+		return nil, nil, model.ExportExpiredError
+	default:
+		return nil, nil, model.ExportNotReadyError
+	}
+	if exportModel.ExpiresAt != nil && !exportModel.ExpiresAt.After(time.Now()) {
+		return nil, nil, model.ExportExpiredError
+	}
+	if exportModel.FileName == nil {
+		log.Error("ready export has no file", logger.F("export_id", id))
+		return nil, nil, model.NilError
+	}
+	file, err := e.fileStorage.DownloadFile(*exportModel.FileName)
+	if err != nil {
+		log.Error("fail to download export from file storage", logger.FError(err), logger.F("export_id", id))
+		return nil, nil, err
+	}
+	return file, exportModel, nil
+}
+
+func (e *export) ProcessPendingExports(ctx context.Context, limit int64) (int, error) {
+	log := e.container.GetLogger()
+	exportEntities, err := e.exportRepository.ClaimPending(ctx, time.Now().Add(-exportStaleTimeout), limit)
+	if err != nil {
+		log.Error("fail to claim pending exports", logger.FError(err))
+		return 0, err
+	}
+	for _, exportEntity := range exportEntities {
+		e.process(ctx, exportEntity)
+	}
+	return len(exportEntities), nil
+}
+
+func (e *export) ExpireExports(ctx context.Context, limit int64) (int, error) {
+	log := e.container.GetLogger()
+	exportEntities, err := e.exportRepository.GetExpired(ctx, time.Now(), limit)
+	if err != nil {
+		log.Error("fail to get expired exports", logger.FError(err))
+		return 0, err
+	}
+	for _, exportEntity := range exportEntities {
+		if fileName := exportEntity.FileName; fileName != nil {
+			if err := e.fileStorage.DeleteFile(*fileName); err != nil {
+				log.Error("fail to delete export from file storage", logger.FError(err), logger.F("export_id", exportEntity.ID))
+				continue
+			}
+		}
+		if err := e.exportRepository.Expire(ctx, exportEntity.ID); err != nil {
+			log.Error("fail to expire export", logger.FError(err), logger.F("export_id", exportEntity.ID))
+			return 0, err
+		}
+	}
+	return len(exportEntities), nil
+}
+
+func (e *export) process(ctx context.Context, exportEntity entity.AccountExport) {
+	log := e.container.GetLogger()
+	accountEntity, err := e.accountRepository.GetFullDetailByID(ctx, exportEntity.AccountID)
+	if err != nil {
+		log.Error("fail to get account by id", logger.FError(err), logger.F("account_id", exportEntity.AccountID))
+		e.fail(ctx, exportEntity.ID)
+		return
+	}
+	fileName, err := e.buildArchive(ctx, exportEntity, accountEntity)
+	if err != nil {
+		log.Error("fail to build export archive", logger.FError(err), logger.F("export_id", exportEntity.ID))
+		e.fail(ctx, exportEntity.ID)
+		return
+	}
+	expiresAt := time.Now().Add(e.container.GetAccountConfig().ExportTTL)
+	if err := e.exportRepository.Complete(ctx, exportEntity.ID, *fileName, expiresAt); err != nil {
+		log.Error("fail to complete export", logger.FError(err), logger.F("export_id", exportEntity.ID))
+		return
+	}
+	if err := e.notify(accountEntity.TelegramID, expiresAt); err != nil {
+		log.Error("fail to notify about ready export", logger.FError(err), logger.F("export_id", exportEntity.ID))
+	}
+	log.Debug("account export is ready", logger.F("export_id", exportEntity.ID))
+}
+
+func (e *export) fail(ctx context.Context, id int64) {
+	log := e.container.GetLogger()
+	if err := e.exportRepository.Fail(ctx, id, exportFailureReason); err != nil {
+		log.Error("fail to mark export as failed", logger.FError(err), logger.F("export_id", id))
+	}
+}
+
+// buildArchive writes the account data into a temporary zip file, uploads it and returns its file name in the storage.
+func (e *export) buildArchive(ctx context.Context, exportEntity entity.AccountExport, accountEntity *entity.Account) (*string, error) {
+	tempFile, err := os.CreateTemp("", "account-export-*.zip")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tempFile.Close()
+		_ = os.Remove(tempFile.Name())
+	}()
+	archive := newExportArchive(zip.NewWriter(tempFile))
+	if err := e.writeArchive(ctx, archive, accountEntity); err != nil {
+		return nil, err
+	}
+	if err := archive.close(exportEntity, time.Now()); err != nil {
+		return nil, err
+	}
+	if _, err := tempFile.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	fileName := fmt.Sprintf("exports/%d/%s.zip", exportEntity.AccountID, uuid.NewString())
+	if _, err := e.fileStorage.UploadFile(fileName, tempFile); err != nil {
+		return nil, err
+	}
+	return &fileName, nil
+}
+
+func (e *export) writeArchive(ctx context.Context, archive *exportArchive, accountEntity *entity.Account) error {
+	log := e.container.GetLogger()
+	accountID := accountEntity.ID
+	if err := archive.writeJSON("account.json", "profile of the account with its company", 1, newExportAccount(accountEntity)); err != nil {
+		return err
+	}
+	tags, err := e.tagRepository.GetTagsByAccountID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if err := archive.writeJSON("tags.json", "tags of the account", len(tags), newExportTags(tags)); err != nil {
+		return err
+	}
+	categories, err := e.categoryRepository.GetCategoriesByAccountID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if err := archive.writeJSON("categories.json", "categories of the account", len(categories), newExportCategories(categories)); err != nil {
+		return err
+	}
+	tasks, err := e.taskRepository.GetAllByOwnerID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	exportTasks := make([]exportTask, 0, len(tasks))
+	for _, task := range tasks {
+		taskCategories, err := e.categoryRepository.GetCategoriesByTaskID(ctx, task.ID)
+		if err != nil {
+			return err
+		}
+		exportTasks = append(exportTasks, newExportTask(task, taskCategories))
+	}
+	if err := archive.writeJSON("tasks.json", "tasks created by the account including the deleted ones", len(exportTasks), exportTasks); err != nil {
+		return err
+	}
+	likesGiven, err := e.accountRepository.GetLikesByLikerID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if err := archive.writeJSON("likes_given.json", "accounts liked by the account", len(likesGiven), newExportLikesGiven(likesGiven)); err != nil {
+		return err
+	}
+	likesReceived, err := e.accountRepository.GetLikesByLikedID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if err := archive.writeJSON("likes_received.json", "accounts that liked the account", len(likesReceived), newExportLikesReceived(likesReceived)); err != nil {
+		return err
+	}
+	dislikes, err := e.accountRepository.GetDislikesByDislikerID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if err := archive.writeJSON("dislikes.json", "accounts disliked by the account", len(dislikes), newExportDislikes(dislikes)); err != nil {
+		return err
+	}
+	matches, err := e.matchRepository.GetAllByAccountID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if err := archive.writeJSON("matches.json", "matches of the account in both roles including the ended ones", len(matches), newExportMatches(matches)); err != nil {
+		return err
+	}
+	blocks, err := e.accountRepository.GetBlocksByBlockerID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if err := archive.writeJSON("blocks.json", "accounts blocked by the account", len(blocks), newExportBlocks(blocks)); err != nil {
+		return err
+	}
+	reports, err := e.reportRepository.GetAllByReporterID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if err := archive.writeJSON("reports.json", "reports filed by the account", len(reports), newExportReports(reports)); err != nil {
+		return err
+	}
+	portfolioItems, err := e.portfolioRepository.GetAllByAccountID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if err := archive.writeJSON("portfolio.json", "portfolio items of the account, their files are listed in attachments.json", len(portfolioItems), newExportPortfolioItems(portfolioItems)); err != nil {
+		return err
+	}
+	preference, err := e.matchPreferenceRepository.GetByAccountID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if err := archive.writeJSON("match_preference.json", "matching preferences of the account", 1, newExportMatchPreference(preference)); err != nil {
+		return err
+	}
+	privacy, err := e.privacyRepository.GetByAccountID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if err := archive.writeJSON("privacy.json", "privacy settings of the account", 1, newExportPrivacy(privacy)); err != nil {
+		return err
+	}
+	sessions, err := e.sessionRepository.GetAllByAccountID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if err := archive.writeJSON("sessions.json", "sessions of the account including the revoked ones", len(sessions), newExportSessions(sessions)); err != nil {
+		return err
+	}
+	numberOfSanctions, err := e.sanctionRepository.CountByAccountID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	sanctions, err := e.sanctionRepository.GetByAccountID(ctx, accountID, 0, numberOfSanctions)
+	if err != nil {
+		return err
+	}
+	if err := archive.writeJSON("sanctions.json", "suspensions, bans and lifts applied to the account", len(sanctions), newExportSanctions(sanctions)); err != nil {
+		return err
+	}
+	type exportAttachmentItem struct {
+		kind       string
+		attachment *entity.Attachment
+	}
+	attachments := []exportAttachmentItem{
+		{kind: "avatar", attachment: accountEntity.AvatarAttachment},
+		{kind: "document", attachment: accountEntity.DocumentAttachment},
+	}
+	for _, portfolioItem := range portfolioItems {
+		attachments = append(attachments, exportAttachmentItem{kind: "portfolio", attachment: portfolioItem.Attachment})
+	}
+	for _, report := range reports {
+		attachments = append(attachments, exportAttachmentItem{kind: "report", attachment: report.Attachment})
+	}
+	exportAttachments := make([]exportAttachment, 0, len(attachments))
+	for _, item := range attachments {
+		if item.attachment == nil || len(item.attachment.FileName) == 0 {
+			continue
+		}
+		exportAttachment := newExportAttachment(item.kind, item.attachment)
+		archivePath := "attachments/" + item.attachment.FileName
+		if err := e.writeAttachment(archive, archivePath, item.kind, item.attachment.FileName); err != nil {
+			log.Error("fail to add attachment to export", logger.FError(err), logger.F("file_name", item.attachment.FileName))
+		} else {
+			exportAttachment.ArchivePath = &archivePath
+		}
+		exportAttachments = append(exportAttachments, exportAttachment)
+	}
+	return archive.writeJSON("attachments.json", "metadata of the attachments, archive_path is null when the original file is unavailable", len(exportAttachments), exportAttachments)
+}
+
+func (e *export) writeAttachment(archive *exportArchive, archivePath string, kind string, fileName string) error {
+	file, err := e.fileStorage.DownloadFile(fileName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	return archive.writeFile(archivePath, "original "+kind+" file", file)
+}
+
+func (e *export) notify(telegramID int64, expiresAt time.Time) error {
+	if len(e.container.GetTelegramBotToken()) == 0 {
+		return nil
+	}
+	sendMessage := botModel.SendMessage{
+		ChatID: telegramID,
+		Text: "Your personal data export is ready. Open the app to download it, the archive is available until " +
+			expiresAt.UTC().Format("2006-01-02 15:04 MST") + ".",
+		ReplyMarkup: botModel.InlineKeyboardMarkup{
+			Buttons: [][]botModel.InlineKeyboardButton{
+				{
+					{
+						Text:       "Open app",
+						WebAppInfo: &botModel.WebAppInfo{URL: e.container.GetTelegramMiniAppURL()},
+					},
+				},
+			},
+		},
+	}
+	return e.telegramBotClient.Execute(sendMessage, bot.SendMessageMethod)
+}
@@ -0,0 +1,442 @@
+package usecase
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"go-tonify-backend/internal/domain/entity"
+	"io"
+	"time"
+)
+
+const exportArchiveVersion = 1
+
+type exportManifest struct {
+	Version     int                  `json:"version"`
+	ExportID    int64                `json:"export_id"`
+	AccountID   int64                `json:"account_id"`
+	GeneratedAt time.Time            `json:"generated_at"`
+	Files       []exportManifestFile `json:"files"`
+}
+
+type exportManifestFile struct {
+	Path        string `json:"path"`
+	Description string `json:"description"`
+	Records     *int   `json:"records,omitempty"`
+	Size        int64  `json:"size"`
+}
+
+type exportAccount struct {
+	ID           int64          `json:"id"`
+	TelegramID   int64          `json:"telegram_id"`
+	FirstName    string         `json:"first_name"`
+	MiddleName   *string        `json:"middle_name"`
+	LastName     string         `json:"last_name"`
+	Nickname     *string        `json:"nickname"`
+	Role         string         `json:"role"`
+	AboutMe      *string        `json:"about_me"`
+	Gender       string         `json:"gender"`
+	Country      *string        `json:"country"`
+	Location     *string        `json:"location"`
+	Company      *exportCompany `json:"company"`
+	Verification string         `json:"verification_status"`
+	Wallet       *string        `json:"wallet_address"`
+	CreatedAt    *time.Time     `json:"created_at"`
+	UpdatedAt    *time.Time     `json:"updated_at"`
+}
+
+type exportCompany struct {
+	ID          int64      `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+}
+
+type exportTag struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+}
+
+type exportCategory struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+}
+
+type exportTask struct {
+	ID          int64            `json:"id"`
+	Title       string           `json:"title"`
+	OwnerRole   string           `json:"owner_role"`
+	Description string           `json:"description"`
+	Categories  []exportCategory `json:"categories"`
+	CreatedAt   *time.Time       `json:"created_at"`
+	UpdatedAt   *time.Time       `json:"updated_at"`
+	DeletedAt   *time.Time       `json:"deleted_at"`
+}
+
+// exportReaction keeps the role the reaction was made in, an account can react in both roles.
+type exportReaction struct {
+	AccountID int64      `json:"account_id"`
+	Role      string     `json:"role"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+type exportAttachment struct {
+	ID          int64      `json:"id"`
+	Kind        string     `json:"kind"`
+	FileName    string     `json:"file_name"`
+	Path        *string    `json:"path"`
+	ArchivePath *string    `json:"archive_path"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+}
+
+type exportPortfolioItem struct {
+	ID           int64      `json:"id"`
+	AttachmentID int64      `json:"attachment_id"`
+	Title        string     `json:"title"`
+	Description  *string    `json:"description"`
+	ExternalLink *string    `json:"external_link"`
+	SortOrder    int64      `json:"sort_order"`
+	CreatedAt    *time.Time `json:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+}
+
+type exportMatch struct {
+	ID           int64      `json:"id"`
+	ClientID     int64      `json:"client_id"`
+	FreelancerID int64      `json:"freelancer_id"`
+	UnmatchedBy  *int64     `json:"unmatched_by"`
+	CreatedAt    *time.Time `json:"created_at"`
+	UnmatchedAt  *time.Time `json:"unmatched_at"`
+}
+
+type exportBlock struct {
+	AccountID int64      `json:"account_id"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// exportReport leaves out the reviewer, staff identities aren't part of the account data.
+type exportReport struct {
+	ID           int64      `json:"id"`
+	ReportedID   int64      `json:"reported_id"`
+	Reason       string     `json:"reason"`
+	Description  *string    `json:"description"`
+	AttachmentID *int64     `json:"attachment_id"`
+	Status       string     `json:"status"`
+	CreatedAt    *time.Time `json:"created_at"`
+	ReviewedAt   *time.Time `json:"reviewed_at"`
+}
+
+type exportMatchPreference struct {
+	Countries    []string   `json:"countries"`
+	CategoryIDs  []int64    `json:"category_ids"`
+	RequiredTags []string   `json:"required_tags"`
+	VerifiedOnly bool       `json:"verified_only"`
+	HasCompany   *bool      `json:"has_company"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+}
+
+// exportSession leaves out the session id, it identifies the session in the access tokens.
+type exportSession struct {
+	Device       string     `json:"device"`
+	Platform     *string    `json:"platform"`
+	UserAgent    *string    `json:"user_agent"`
+	IP           *string    `json:"ip"`
+	CreatedAt    *time.Time `json:"created_at"`
+	LastActiveAt *time.Time `json:"last_active_at"`
+	RevokedAt    *time.Time `json:"revoked_at"`
+}
+
+type exportPrivacy struct {
+	LastName           string     `json:"last_name"`
+	Location           string     `json:"location"`
+	Company            string     `json:"company"`
+	DocumentAttachment string     `json:"document_attachment"`
+	TelegramID         string     `json:"telegram_id"`
+	UpdatedAt          *time.Time `json:"updated_at"`
+}
+
+// exportSanction leaves out the staff account that applied the sanction.
+type exportSanction struct {
+	Action         string     `json:"action"`
+	Reason         string     `json:"reason"`
+	SuspendedUntil *time.Time `json:"suspended_until"`
+	CreatedAt      *time.Time `json:"created_at"`
+}
+
+// exportArchive writes the files of an export into a zip and keeps track of them for the manifest.
+type exportArchive struct {
+	writer *zip.Writer
+	files  []exportManifestFile
+}
+
+func newExportArchive(writer *zip.Writer) *exportArchive {
+	return &exportArchive{
+		writer: writer,
+		files:  make([]exportManifestFile, 0),
+	}
+}
+
+func (a *exportArchive) writeJSON(path string, description string, records int, value any) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	fileWriter, err := a.writer.Create(path)
+	if err != nil {
+		return err
+	}
+	size, err := fileWriter.Write(data)
+	if err != nil {
+		return err
+	}
+	a.files = append(a.files, exportManifestFile{
+		Path:        path,
+		Description: description,
+		Records:     &records,
+		Size:        int64(size),
+	})
+	return nil
+}
+
+func (a *exportArchive) writeFile(path string, description string, reader io.Reader) error {
+	fileWriter, err := a.writer.Create(path)
+	if err != nil {
+		return err
+	}
+	size, err := io.Copy(fileWriter, reader)
+	if err != nil {
+		return err
+	}
+	a.files = append(a.files, exportManifestFile{
+		Path:        path,
+		Description: description,
+		Size:        size,
+	})
+	return nil
+}
+
+// close writes manifest.json listing every file of the archive and finishes the zip.
+func (a *exportArchive) close(exportEntity entity.AccountExport, generatedAt time.Time) error {
+	manifest := exportManifest{
+		Version:     exportArchiveVersion,
+		ExportID:    exportEntity.ID,
+		AccountID:   exportEntity.AccountID,
+		GeneratedAt: generatedAt.UTC(),
+		Files:       a.files,
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	fileWriter, err := a.writer.Create("manifest.json")
+	if err != nil {
+		return err
+	}
+	if _, err := fileWriter.Write(data); err != nil {
+		return err
+	}
+	return a.writer.Close()
+}
+
+func newExportAccount(accountEntity *entity.Account) exportAccount {
+	account := exportAccount{
+		ID:           accountEntity.ID,
+		TelegramID:   accountEntity.TelegramID,
+		FirstName:    accountEntity.FirstName,
+		MiddleName:   accountEntity.MiddleName,
+		LastName:     accountEntity.LastName,
+		Nickname:     accountEntity.Nickname,
+		Role:         accountEntity.Role.String(),
+		AboutMe:      accountEntity.AboutMe,
+		Gender:       accountEntity.Gender.String(),
+		Country:      accountEntity.Country,
+		Location:     accountEntity.Location,
+		Verification: accountEntity.VerificationStatus.String(),
+		Wallet:       accountEntity.WalletAddress,
+		CreatedAt:    accountEntity.CreatedAt,
+		UpdatedAt:    accountEntity.UpdatedAt,
+	}
+	if company := accountEntity.Company; company != nil {
+		account.Company = &exportCompany{
+			ID:          company.ID,
+			Name:        company.Name,
+			Description: company.Description,
+			CreatedAt:   company.CreatedAt,
+			UpdatedAt:   company.UpdatedAt,
+		}
+	}
+	return account
+}
+
+func newExportTags(tagEntities []entity.Tag) []exportTag {
+	tags := make([]exportTag, 0, len(tagEntities))
+	for _, tagEntity := range tagEntities {
+		tags = append(tags, exportTag{ID: tagEntity.ID, Title: tagEntity.Title})
+	}
+	return tags
+}
+
+func newExportCategories(categoryEntities []entity.Category) []exportCategory {
+	categories := make([]exportCategory, 0, len(categoryEntities))
+	for _, categoryEntity := range categoryEntities {
+		categories = append(categories, exportCategory{ID: categoryEntity.ID, Title: categoryEntity.Title})
+	}
+	return categories
+}
+
+func newExportTask(taskEntity entity.Task, categoryEntities []entity.Category) exportTask {
+	return exportTask{
+		ID:          taskEntity.ID,
+		Title:       taskEntity.Title,
+		OwnerRole:   taskEntity.OwnerRole.String(),
+		Description: taskEntity.Description,
+		Categories:  newExportCategories(categoryEntities),
+		CreatedAt:   taskEntity.CreatedAt,
+		UpdatedAt:   taskEntity.UpdatedAt,
+		DeletedAt:   taskEntity.DeletedAt,
+	}
+}
+
+func newExportLikesGiven(likeEntities []entity.LikeAccount) []exportReaction {
+	reactions := make([]exportReaction, 0, len(likeEntities))
+	for _, likeEntity := range likeEntities {
+		reactions = append(reactions, exportReaction{AccountID: likeEntity.LikedID, Role: likeEntity.LikerRole.String(), CreatedAt: likeEntity.CreatedAt})
+	}
+	return reactions
+}
+
+func newExportLikesReceived(likeEntities []entity.LikeAccount) []exportReaction {
+	reactions := make([]exportReaction, 0, len(likeEntities))
+	for _, likeEntity := range likeEntities {
+		reactions = append(reactions, exportReaction{AccountID: likeEntity.LikerID, Role: likeEntity.LikerRole.String(), CreatedAt: likeEntity.CreatedAt})
+	}
+	return reactions
+}
+
+func newExportDislikes(dislikeEntities []entity.DislikeAccount) []exportReaction {
+	reactions := make([]exportReaction, 0, len(dislikeEntities))
+	for _, dislikeEntity := range dislikeEntities {
+		reactions = append(reactions, exportReaction{AccountID: dislikeEntity.DislikedID, Role: dislikeEntity.DislikerRole.String(), CreatedAt: dislikeEntity.CreatedAt})
+	}
+	return reactions
+}
+
+func newExportAttachment(kind string, attachmentEntity *entity.Attachment) exportAttachment {
+	return exportAttachment{
+		ID:        attachmentEntity.ID,
+		Kind:      kind,
+		FileName:  attachmentEntity.FileName,
+		Path:      attachmentEntity.Path,
+		CreatedAt: attachmentEntity.CreatedAt,
+		UpdatedAt: attachmentEntity.UpdatedAt,
+	}
+}
+
+func newExportPortfolioItems(portfolioItemEntities []entity.PortfolioItem) []exportPortfolioItem {
+	portfolioItems := make([]exportPortfolioItem, 0, len(portfolioItemEntities))
+	for _, portfolioItemEntity := range portfolioItemEntities {
+		portfolioItems = append(portfolioItems, exportPortfolioItem{
+			ID:           portfolioItemEntity.ID,
+			AttachmentID: portfolioItemEntity.AttachmentID,
+			Title:        portfolioItemEntity.Title,
+			Description:  portfolioItemEntity.Description,
+			ExternalLink: portfolioItemEntity.ExternalLink,
+			SortOrder:    portfolioItemEntity.SortOrder,
+			CreatedAt:    portfolioItemEntity.CreatedAt,
+			UpdatedAt:    portfolioItemEntity.UpdatedAt,
+		})
+	}
+	return portfolioItems
+}
+
+func newExportMatches(matchEntities []entity.Match) []exportMatch {
+	matches := make([]exportMatch, 0, len(matchEntities))
+	for _, matchEntity := range matchEntities {
+		matches = append(matches, exportMatch{
+			ID:           matchEntity.ID,
+			ClientID:     matchEntity.ClientID,
+			FreelancerID: matchEntity.FreelancerID,
+			UnmatchedBy:  matchEntity.UnmatchedBy,
+			CreatedAt:    matchEntity.CreatedAt,
+			UnmatchedAt:  matchEntity.UnmatchedAt,
+		})
+	}
+	return matches
+}
+
+func newExportBlocks(blockEntities []entity.BlockAccount) []exportBlock {
+	blocks := make([]exportBlock, 0, len(blockEntities))
+	for _, blockEntity := range blockEntities {
+		blocks = append(blocks, exportBlock{AccountID: blockEntity.BlockedID, CreatedAt: blockEntity.CreatedAt})
+	}
+	return blocks
+}
+
+func newExportReports(reportEntities []entity.Report) []exportReport {
+	reports := make([]exportReport, 0, len(reportEntities))
+	for _, reportEntity := range reportEntities {
+		reports = append(reports, exportReport{
+			ID:           reportEntity.ID,
+			ReportedID:   reportEntity.ReportedID,
+			Reason:       reportEntity.Reason.String(),
+			Description:  reportEntity.Description,
+			AttachmentID: reportEntity.AttachmentID,
+			Status:       reportEntity.Status.String(),
+			CreatedAt:    reportEntity.CreatedAt,
+			ReviewedAt:   reportEntity.ReviewedAt,
+		})
+	}
+	return reports
+}
+
+func newExportMatchPreference(preferenceEntity *entity.MatchPreference) exportMatchPreference {
+	return exportMatchPreference{
+		Countries:    preferenceEntity.Countries,
+		CategoryIDs:  preferenceEntity.CategoryIDs,
+		RequiredTags: preferenceEntity.RequiredTags,
+		VerifiedOnly: preferenceEntity.VerifiedOnly,
+		HasCompany:   preferenceEntity.HasCompany,
+		UpdatedAt:    preferenceEntity.UpdatedAt,
+	}
+}
+
+func newExportSessions(sessionEntities []entity.Session) []exportSession {
+	sessions := make([]exportSession, 0, len(sessionEntities))
+	for _, sessionEntity := range sessionEntities {
+		sessions = append(sessions, exportSession{
+			Device:       sessionEntity.Device,
+			Platform:     sessionEntity.Platform,
+			UserAgent:    sessionEntity.UserAgent,
+			IP:           sessionEntity.IP,
+			CreatedAt:    sessionEntity.CreatedAt,
+			LastActiveAt: sessionEntity.LastActiveAt,
+			RevokedAt:    sessionEntity.RevokedAt,
+		})
+	}
+	return sessions
+}
+
+func newExportPrivacy(privacyEntity *entity.AccountPrivacy) exportPrivacy {
+	return exportPrivacy{
+		LastName:           privacyEntity.LastName.String(),
+		Location:           privacyEntity.Location.String(),
+		Company:            privacyEntity.Company.String(),
+		DocumentAttachment: privacyEntity.DocumentAttachment.String(),
+		TelegramID:         privacyEntity.TelegramID.String(),
+		UpdatedAt:          privacyEntity.UpdatedAt,
+	}
+}
+
+func newExportSanctions(sanctionEntities []entity.AccountSanction) []exportSanction {
+	sanctions := make([]exportSanction, 0, len(sanctionEntities))
+	for _, sanctionEntity := range sanctionEntities {
+		sanctions = append(sanctions, exportSanction{
+			Action:         sanctionEntity.Action,
+			Reason:         sanctionEntity.Reason,
+			SuspendedUntil: sanctionEntity.SuspendedUntil,
+			CreatedAt:      sanctionEntity.CreatedAt,
+		})
+	}
+	return sanctions
+}
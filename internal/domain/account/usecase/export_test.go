@@ -0,0 +1,296 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	categoryRepository "go-tonify-backend/internal/domain/category/repository"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/internal/domain/filestorage"
+	taskRepository "go-tonify-backend/internal/domain/task/repository"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testExportAccountID int64 = 7
+
+type fakeExportRepository struct {
+	accountRepository.Export
+	failReason string
+}
+
+func (f *fakeExportRepository) Fail(ctx context.Context, id int64, reason string) error {
+	f.failReason = reason
+	return nil
+}
+
+type fakeExportAccountRepository struct {
+	accountRepository.Account
+	err error
+}
+
+func (f *fakeExportAccountRepository) GetFullDetailByID(ctx context.Context, id int64) (*entity.Account, error) {
+	return nil, f.err
+}
+
+func (f *fakeExportAccountRepository) GetLikesByLikerID(ctx context.Context, likerID int64) ([]entity.LikeAccount, error) {
+	return []entity.LikeAccount{{ID: 1, LikerID: likerID, LikedID: 8, LikerRole: entity.ClientRole}}, nil
+}
+
+func (f *fakeExportAccountRepository) GetLikesByLikedID(ctx context.Context, likedID int64) ([]entity.LikeAccount, error) {
+	return []entity.LikeAccount{}, nil
+}
+
+func (f *fakeExportAccountRepository) GetDislikesByDislikerID(ctx context.Context, dislikerID int64) ([]entity.DislikeAccount, error) {
+	return []entity.DislikeAccount{}, nil
+}
+
+func (f *fakeExportAccountRepository) GetBlocksByBlockerID(ctx context.Context, blockerID int64) ([]entity.BlockAccount, error) {
+	return []entity.BlockAccount{{ID: 1, BlockerID: blockerID, BlockedID: 9}}, nil
+}
+
+type fakeExportTagRepository struct {
+	accountRepository.Tag
+}
+
+func (f *fakeExportTagRepository) GetTagsByAccountID(ctx context.Context, accountID int64) ([]entity.Tag, error) {
+	return []entity.Tag{{ID: 1, Title: "golang"}}, nil
+}
+
+type fakeExportCategoryRepository struct {
+	categoryRepository.Category
+}
+
+func (f *fakeExportCategoryRepository) GetCategoriesByAccountID(ctx context.Context, accountID int64) ([]entity.Category, error) {
+	return []entity.Category{}, nil
+}
+
+type fakeExportTaskRepository struct {
+	taskRepository.Task
+}
+
+func (f *fakeExportTaskRepository) GetAllByOwnerID(ctx context.Context, ownerID int64) ([]entity.Task, error) {
+	return []entity.Task{}, nil
+}
+
+type fakeExportPortfolioRepository struct {
+	accountRepository.Portfolio
+}
+
+func (f *fakeExportPortfolioRepository) GetAllByAccountID(ctx context.Context, accountID int64) ([]entity.PortfolioItem, error) {
+	return []entity.PortfolioItem{
+		{ID: 1, AccountID: accountID, AttachmentID: 11, Attachment: &entity.Attachment{ID: 11, FileName: "portfolio.png"}, Title: "landing"},
+	}, nil
+}
+
+type fakeExportMatchRepository struct {
+	accountRepository.Match
+}
+
+func (f *fakeExportMatchRepository) GetAllByAccountID(ctx context.Context, accountID int64) ([]entity.Match, error) {
+	return []entity.Match{{ID: 1, ClientID: accountID, FreelancerID: 8}}, nil
+}
+
+type fakeExportReportRepository struct {
+	accountRepository.Report
+}
+
+func (f *fakeExportReportRepository) GetAllByReporterID(ctx context.Context, reporterID int64) ([]entity.Report, error) {
+	attachmentID := int64(12)
+	reviewerID := int64(1)
+	return []entity.Report{
+		{
+			ID:           1,
+			ReporterID:   &reporterID,
+			ReportedID:   10,
+			Reason:       entity.SpamReportReason,
+			AttachmentID: &attachmentID,
+			Attachment:   &entity.Attachment{ID: attachmentID, FileName: "report.png"},
+			Status:       entity.PendingReportStatus,
+			ReviewerID:   &reviewerID,
+		},
+	}, nil
+}
+
+type fakeExportSessionRepository struct {
+	accountRepository.Session
+}
+
+func (f *fakeExportSessionRepository) GetAllByAccountID(ctx context.Context, accountID int64) ([]entity.Session, error) {
+	revokedAt := time.Now()
+	return []entity.Session{
+		{ID: "active-session-id", AccountID: accountID, Device: "iPhone"},
+		{ID: "revoked-session-id", AccountID: accountID, Device: "Pixel", RevokedAt: &revokedAt},
+	}, nil
+}
+
+type fakeExportSanctionRepository struct {
+	accountRepository.Sanction
+}
+
+func (f *fakeExportSanctionRepository) CountByAccountID(ctx context.Context, accountID int64) (int64, error) {
+	return 1, nil
+}
+
+func (f *fakeExportSanctionRepository) GetByAccountID(ctx context.Context, accountID int64, offset int64, limit int64) ([]entity.AccountSanction, error) {
+	staffAccountID := int64(1)
+	return []entity.AccountSanction{{ID: 1, AccountID: accountID, StaffAccountID: &staffAccountID, Action: "ban", Reason: "spam"}}, nil
+}
+
+type fakeExportPrivacyRepository struct {
+	accountRepository.Privacy
+}
+
+func (f *fakeExportPrivacyRepository) GetByAccountID(ctx context.Context, accountID int64) (*entity.AccountPrivacy, error) {
+	privacy := entity.DefaultAccountPrivacy(accountID)
+	privacy.LastName = entity.NobodyVisibility
+	return &privacy, nil
+}
+
+type fakeExportMatchPreferenceRepository struct {
+	accountRepository.MatchPreference
+}
+
+func (f *fakeExportMatchPreferenceRepository) GetByAccountID(ctx context.Context, accountID int64) (*entity.MatchPreference, error) {
+	preference := entity.DefaultMatchPreference(accountID)
+	preference.Countries = []string{"UA"}
+	return &preference, nil
+}
+
+type fakeExportFileStorage struct {
+	filestorage.FileStorage
+}
+
+func (f *fakeExportFileStorage) DownloadFile(fileName string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader("content of " + fileName)), nil
+}
+
+func newTestExport(exportRepository accountRepository.Export, accountRepository accountRepository.Account) *export {
+	return NewExport(
+		&fakeContainer{},
+		&fakeExportFileStorage{},
+		nil,
+		exportRepository,
+		accountRepository,
+		&fakeExportTagRepository{},
+		&fakeExportCategoryRepository{},
+		&fakeExportTaskRepository{},
+		&fakeExportPortfolioRepository{},
+		&fakeExportMatchRepository{},
+		&fakeExportReportRepository{},
+		&fakeExportSessionRepository{},
+		&fakeExportSanctionRepository{},
+		&fakeExportPrivacyRepository{},
+		&fakeExportMatchPreferenceRepository{},
+	).(*export)
+}
+
+func TestWriteExportArchive(t *testing.T) {
+	exportUsecase := newTestExport(&fakeExportRepository{}, &fakeExportAccountRepository{})
+	var buffer bytes.Buffer
+	archive := newExportArchive(zip.NewWriter(&buffer))
+	accountEntity := entity.Account{
+		ID:               testExportAccountID,
+		FirstName:        "Taras",
+		AvatarAttachment: &entity.Attachment{ID: 10, FileName: "avatar.png"},
+	}
+	if err := exportUsecase.writeArchive(context.Background(), archive, &accountEntity); err != nil {
+		t.Fatal("fail to write archive", err)
+	}
+	if err := archive.close(entity.AccountExport{ID: 1, AccountID: testExportAccountID}, time.Now()); err != nil {
+		t.Fatal("fail to close archive", err)
+	}
+	reader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if err != nil {
+		t.Fatal("fail to read archive", err)
+	}
+	files := make(map[string][]byte)
+	for _, file := range reader.File {
+		fileReader, err := file.Open()
+		if err != nil {
+			t.Fatal("fail to open archive file", err)
+		}
+		content, err := io.ReadAll(fileReader)
+		_ = fileReader.Close()
+		if err != nil {
+			t.Fatal("fail to read archive file", err)
+		}
+		files[file.Name] = content
+	}
+	expectedRecords := map[string]int{
+		"account.json":          1,
+		"tags.json":             1,
+		"categories.json":       0,
+		"tasks.json":            0,
+		"likes_given.json":      1,
+		"likes_received.json":   0,
+		"dislikes.json":         0,
+		"matches.json":          1,
+		"blocks.json":           1,
+		"reports.json":          1,
+		"portfolio.json":        1,
+		"match_preference.json": 1,
+		"privacy.json":          1,
+		"sessions.json":         2,
+		"sanctions.json":        1,
+		"attachments.json":      3,
+	}
+	var manifest exportManifest
+	if err := json.Unmarshal(files["manifest.json"], &manifest); err != nil {
+		t.Fatal("fail to decode manifest", err)
+	}
+	records := make(map[string]int)
+	for _, manifestFile := range manifest.Files {
+		if manifestFile.Records != nil {
+			records[manifestFile.Path] = *manifestFile.Records
+		}
+	}
+	for path, expected := range expectedRecords {
+		if _, ok := files[path]; !ok {
+			t.Errorf("the archive misses %s", path)
+			continue
+		}
+		if records[path] != expected {
+			t.Errorf("%s: expected %d records, got %d", path, expected, records[path])
+		}
+	}
+	for _, fileName := range []string{"avatar.png", "portfolio.png", "report.png"} {
+		content, ok := files["attachments/"+fileName]
+		if !ok {
+			t.Errorf("the archive misses the file %s", fileName)
+			continue
+		}
+		if string(content) != "content of "+fileName {
+			t.Errorf("unexpected content of %s: %s", fileName, content)
+		}
+	}
+	var privacy exportPrivacy
+	if err := json.Unmarshal(files["privacy.json"], &privacy); err != nil {
+		t.Fatal("fail to decode privacy", err)
+	}
+	if privacy.LastName != entity.NobodyVisibility.String() {
+		t.Errorf("unexpected last name visibility %s", privacy.LastName)
+	}
+	for _, path := range []string{"sessions.json", "reports.json", "sanctions.json"} {
+		content := string(files[path])
+		if strings.Contains(content, "session-id") || strings.Contains(content, "reviewer") || strings.Contains(content, "staff") {
+			t.Errorf("%s exposes session ids or staff: %s", path, content)
+		}
+	}
+}
+
+func TestExportFailureReasonIsGeneric(t *testing.T) {
+	exportRepository := &fakeExportRepository{}
+	exportUsecase := newTestExport(exportRepository, &fakeExportAccountRepository{
+		err: errors.New("pq: connection refused to 10.0.0.5:5432"),
+	})
+	exportUsecase.process(context.Background(), entity.AccountExport{ID: 1, AccountID: testExportAccountID})
+	if exportRepository.failReason != exportFailureReason {
+		t.Errorf("expected failure reason %q, got %q", exportFailureReason, exportRepository.failReason)
+	}
+}
@@ -13,20 +13,32 @@ import (
 	commonModel "go-tonify-backend/internal/domain/model"
 	"go-tonify-backend/internal/domain/provider/transaction"
 	"go-tonify-backend/pkg/logger"
+	"strings"
+	"time"
 )
 
 type Match interface {
-	MatchableAccounts(ctx context.Context, accountID int64, limit int64) (*commonModel.Pagination[model.Account], error)
-	MatchAction(ctx context.Context, accountID int64, targetID int64, action model.MatchAction) (model.MatchResult, error)
-	GetAccountLikers(ctx context.Context, accountID int64, offset int64, limit int64) (*commonModel.Pagination[model.Account], error)
+	MatchableAccounts(ctx context.Context, accountID int64, activeRole model.Role, matchableFilter model.MatchableFilter, offset int64, limit int64) (*commonModel.Pagination[model.Account], error)
+	MatchAction(ctx context.Context, accountID int64, activeRole model.Role, targetID int64, action model.MatchAction) (model.MatchResult, error)
+	GetAccountLikers(ctx context.Context, accountID int64, activeRole model.Role, offset int64, limit int64) (*commonModel.Pagination[model.Account], error)
+	GetMatches(ctx context.Context, accountID int64, activeRole model.Role, offset int64, limit int64) (*commonModel.Pagination[model.Match], error)
+	Unmatch(ctx context.Context, accountID int64, matchID int64) error
+	Rewind(ctx context.Context, accountID int64, activeRole model.Role) (*model.Rewind, error)
+	Block(ctx context.Context, accountID int64, targetID int64) error
+	GetPreference(ctx context.Context, accountID int64) (*model.MatchPreference, error)
+	UpdatePreference(ctx context.Context, accountID int64, preference model.MatchPreference) (*model.MatchPreference, error)
 }
 
 type match struct {
-	container           container.Container
-	transactionProvider *transaction.Provider
-	accountRepository   accountRepository.Account
-	tagRepository       accountRepository.Tag
-	categoryRepository  categoryRepository.Category
+	container            container.Container
+	transactionProvider  *transaction.Provider
+	accountRepository    accountRepository.Account
+	tagRepository        accountRepository.Tag
+	privacyRepository    accountRepository.Privacy
+	portfolioRepository  accountRepository.Portfolio
+	categoryRepository   categoryRepository.Category
+	matchRepository      accountRepository.Match
+	preferenceRepository accountRepository.MatchPreference
 }
 
 func NewMatch(
@@ -34,19 +46,41 @@ func NewMatch(
 	transactionProvider *transaction.Provider,
 	accountRepository accountRepository.Account,
 	tagRepository accountRepository.Tag,
+	privacyRepository accountRepository.Privacy,
+	portfolioRepository accountRepository.Portfolio,
 	categoryRepository categoryRepository.Category,
+	matchRepository accountRepository.Match,
+	preferenceRepository accountRepository.MatchPreference,
 ) Match {
 	return &match{
-		container:           container,
-		transactionProvider: transactionProvider,
-		accountRepository:   accountRepository,
-		tagRepository:       tagRepository,
-		categoryRepository:  categoryRepository,
+		container:            container,
+		transactionProvider:  transactionProvider,
+		accountRepository:    accountRepository,
+		tagRepository:        tagRepository,
+		privacyRepository:    privacyRepository,
+		portfolioRepository:  portfolioRepository,
+		categoryRepository:   categoryRepository,
+		matchRepository:      matchRepository,
+		preferenceRepository: preferenceRepository,
 	}
 }
 
-func (m *match) MatchableAccounts(ctx context.Context, accountID int64, limit int64) (*commonModel.Pagination[model.Account], error) {
+// MatchableAccounts returns accounts holding the role opposite to activeRole and satisfying the match preference
+// of the account ranked by relevance to it, likes and dislikes the account made in its other role don't hide anyone.
+func (m *match) MatchableAccounts(
+	ctx context.Context,
+	accountID int64,
+	activeRole model.Role,
+	matchableFilter model.MatchableFilter,
+	offset int64,
+	limit int64,
+) (*commonModel.Pagination[model.Account], error) {
 	log := m.container.GetLogger()
+	role, err := accountConverter.ConvertRoleModel2Entity(activeRole)
+	if err != nil {
+		log.Error("fail to parse active role", logger.FError(err))
+		return nil, model.RoleNotHeldError
+	}
 	accountEntity, err := m.accountRepository.GetByID(ctx, accountID)
 	if err != nil {
 		log.Error("fail to get account by id", logger.FError(err))
@@ -61,12 +95,26 @@ func (m *match) MatchableAccounts(ctx context.Context, accountID int64, limit in
 		log.Error("fail to clear dislikes", logger.FError(err))
 		return nil, err
 	}
-	accountEntities, err := m.accountRepository.GetMatchableAccounts(ctx, accountEntity.ID, accountEntity.Role.Opposite(), limit)
+	preferenceEntity, err := m.preferenceRepository.GetByAccountID(ctx, accountID)
+	if err != nil {
+		log.Error("fail to get match preference by account id", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	filter := entity.MatchableAccountFilter{
+		Role:         role.Opposite(),
+		ViewerRole:   role,
+		VerifiedOnly: matchableFilter.VerifiedOnly || preferenceEntity.VerifiedOnly,
+		Countries:    preferenceEntity.Countries,
+		CategoryIDs:  preferenceEntity.CategoryIDs,
+		RequiredTags: preferenceEntity.RequiredTags,
+		HasCompany:   preferenceEntity.HasCompany,
+	}
+	accountEntities, err := m.getRankedMatchableAccounts(ctx, accountEntity.ID, filter, offset, limit)
 	if err != nil {
-		log.Error("fail to get matchable accounts", logger.FError(err))
+		log.Error("fail to get ranked matchable accounts", logger.FError(err))
 		return nil, err
 	}
-	numberOfAccounts, err := m.accountRepository.GetNumberMatchableAccounts(ctx, accountID, accountEntity.Role.Opposite())
+	numberOfAccounts, err := m.accountRepository.GetNumberMatchableAccounts(ctx, accountID, filter)
 	if err != nil {
 		log.Error("fail to get number of matchable accounts", logger.FError(err))
 		return nil, err
@@ -89,34 +137,150 @@ func (m *match) MatchableAccounts(ctx context.Context, accountID int64, limit in
 		tagModels := accountConverter.ConvertEntities2TagModels(tags)
 		account.Tags = &tagModels
 		categories, err := m.categoryRepository.GetCategoriesByAccountID(ctx, accountEntity.ID)
+		if err != nil {
+			log.Error(
+				"fail to get categories by account id",
+				logger.FError(err),
+				logger.F("account_id", accountEntity.ID),
+			)
+			return nil, err
+		}
 		categoryModels := categoryConverter.ConvertEntities2CategoriesModel(categories)
 		account.Categories = &categoryModels
+		portfolioItems, err := m.portfolioRepository.GetAllByAccountID(ctx, accountEntity.ID)
+		if err != nil {
+			log.Error(
+				"fail to get portfolio items by account id",
+				logger.FError(err),
+				logger.F("account_id", accountEntity.ID),
+			)
+			return nil, err
+		}
+		portfolioItemModels := accountConverter.ConvertEntities2PortfolioItemModels(portfolioItems)
+		account.Portfolio = &portfolioItemModels
+		if err := applyPrivacy(ctx, m.accountRepository, m.privacyRepository, accountID, account); err != nil {
+			log.Error("fail to apply privacy", logger.FError(err), logger.F("account_id", account.ID))
+			return nil, err
+		}
 		accounts = append(accounts, *account)
 	}
 	pagination := commonModel.Pagination[model.Account]{
-		Offset: 0,
+		Offset: offset,
 		Limit:  limit,
-		Total:  *numberOfAccounts,
+		Total:  matchFeedTotal(*numberOfAccounts, m.container.GetMatchConfig().CandidatePoolSize),
 		Data:   accounts,
 	}
 	return &pagination, nil
 }
 
-func (m *match) MatchAction(ctx context.Context, accountID int64, targetID int64, action model.MatchAction) (model.MatchResult, error) {
+// getRankedMatchableAccounts ranks the candidate pool and loads the accounts of the requested page in the ranked order.
+func (m *match) getRankedMatchableAccounts(
+	ctx context.Context,
+	accountID int64,
+	filter entity.MatchableAccountFilter,
+	offset int64,
+	limit int64,
+) ([]entity.Account, error) {
 	log := m.container.GetLogger()
+	matchConfig := m.container.GetMatchConfig()
+	candidates, err := m.accountRepository.GetMatchableCandidates(ctx, accountID, filter, matchConfig.CandidatePoolSize)
+	if err != nil {
+		log.Error("fail to get matchable candidates", logger.FError(err))
+		return nil, err
+	}
+	viewerTags, err := m.tagRepository.GetTagsByAccountID(ctx, accountID)
+	if err != nil {
+		log.Error("fail to get tags by account id", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	viewerCategories, err := m.categoryRepository.GetCategoriesByAccountID(ctx, accountID)
+	if err != nil {
+		log.Error("fail to get categories by account id", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	viewer := matchViewer{
+		Tags:       int64(len(viewerTags)),
+		Categories: int64(len(viewerCategories)),
+	}
+	ranked := pageMatchCandidates(rankMatchCandidates(candidates, viewer, matchConfig.Weights, time.Now()), offset, limit)
+	if len(ranked) == 0 {
+		return []entity.Account{}, nil
+	}
+	ids := make([]int64, 0, len(ranked))
+	for _, candidate := range ranked {
+		ids = append(ids, candidate.AccountID)
+	}
+	accountEntities, err := m.accountRepository.GetListByIDs(ctx, ids)
+	if err != nil {
+		log.Error("fail to get accounts by ids", logger.FError(err))
+		return nil, err
+	}
+	accountsByID := make(map[int64]entity.Account, len(accountEntities))
+	for _, accountEntity := range accountEntities {
+		accountsByID[accountEntity.ID] = accountEntity
+	}
+	orderedAccounts := make([]entity.Account, 0, len(ids))
+	for _, id := range ids {
+		if accountEntity, ok := accountsByID[id]; ok {
+			orderedAccounts = append(orderedAccounts, accountEntity)
+		}
+	}
+	return orderedAccounts, nil
+}
+
+// MatchAction likes or dislikes the target acting in activeRole, the target must hold the opposite role.
+func (m *match) MatchAction(ctx context.Context, accountID int64, activeRole model.Role, targetID int64, action model.MatchAction) (model.MatchResult, error) {
+	log := m.container.GetLogger()
+	role, err := accountConverter.ConvertRoleModel2Entity(activeRole)
+	if err != nil {
+		log.Error("fail to parse active role", logger.FError(err))
+		return model.ErrorMatchResult, model.RoleNotHeldError
+	}
+	targetHasRole, err := m.accountRepository.HasRole(ctx, targetID, role.Opposite())
+	if err != nil {
+		log.Error("fail to check role of target account", logger.FError(err), logger.F("target_id", targetID))
+		return model.ErrorMatchResult, err
+	}
+	if !targetHasRole {
+		log.Error("target account doesn't hold the opposite role", logger.F("target_id", targetID))
+		return model.ErrorMatchResult, model.EntityNotFoundError
+	}
+	blocked, err := m.accountRepository.IsBlocked(ctx, accountID, targetID)
+	if err != nil {
+		log.Error("fail to check block between accounts", logger.FError(err), logger.F("target_id", targetID))
+		return model.ErrorMatchResult, err
+	}
+	if blocked {
+		log.Error("accounts have blocked each other", logger.F("target_id", targetID))
+		return model.ErrorMatchResult, model.EntityNotFoundError
+	}
 	dislikeAccount := entity.DislikeAccount{
-		DislikerID: accountID,
-		DislikedID: targetID,
+		DislikerID:   accountID,
+		DislikedID:   targetID,
+		DislikerRole: role,
 	}
 	likeAccount := entity.LikeAccount{
-		LikerID: accountID,
-		LikedID: targetID,
+		LikerID:   accountID,
+		LikedID:   targetID,
+		LikerRole: role,
 	}
+	clientID, freelancerID := matchPair(accountID, role, targetID)
 	var matchResult model.MatchResult
-	err := m.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+	err = m.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
 		switch action {
 		case model.LikeMatchAction:
-			err := composed.Account.DeleteDislikeAccount(ctx, dislikeAccount)
+			pairMatch, err := composed.Match.GetByPair(ctx, clientID, freelancerID)
+			if err != nil && err != sql.ErrNoRows {
+				log.Error("fail to get match by pair", logger.FError(err))
+				matchResult = model.ErrorMatchResult
+				return err
+			}
+			if pairMatch != nil && pairMatch.UnmatchedAt != nil {
+				log.Error("accounts have unmatched", logger.F("match_id", pairMatch.ID))
+				matchResult = model.ErrorMatchResult
+				return model.UnmatchedPairError
+			}
+			err = composed.Account.DeleteDislikeAccount(ctx, dislikeAccount)
 			if err != nil {
 				log.Error("fail to delete dislike account", logger.FError(err))
 				matchResult = model.ErrorMatchResult
@@ -139,8 +303,9 @@ func (m *match) MatchAction(ctx context.Context, accountID int64, targetID int64
 				return err
 			}
 			likeAccount = entity.LikeAccount{
-				LikerID: targetID,
-				LikedID: accountID,
+				LikerID:   targetID,
+				LikedID:   accountID,
+				LikerRole: role.Opposite(),
 			}
 			exists, err = composed.Account.ExistsLike(ctx, likeAccount)
 			if err != nil {
@@ -149,6 +314,15 @@ func (m *match) MatchAction(ctx context.Context, accountID int64, targetID int64
 				return err
 			}
 			if exists {
+				matchEntity := entity.Match{
+					ClientID:     clientID,
+					FreelancerID: freelancerID,
+				}
+				if err := composed.Match.Create(ctx, &matchEntity); err != nil {
+					log.Error("fail to record match", logger.FError(err))
+					matchResult = model.ErrorMatchResult
+					return err
+				}
 				matchResult = model.MatchAccountMatchResult
 			} else {
 				matchResult = model.LikeMatchResult
@@ -160,6 +334,20 @@ func (m *match) MatchAction(ctx context.Context, accountID int64, targetID int64
 				matchResult = model.ErrorMatchResult
 				return err
 			}
+			// a dislike of a matched account takes the like back, so the match ends as an unmatch.
+			pairMatch, err := composed.Match.GetByPair(ctx, clientID, freelancerID)
+			if err != nil && err != sql.ErrNoRows {
+				log.Error("fail to get match by pair", logger.FError(err))
+				matchResult = model.ErrorMatchResult
+				return err
+			}
+			if pairMatch != nil && pairMatch.UnmatchedAt == nil {
+				if err := composed.Match.Unmatch(ctx, pairMatch.ID, accountID); err != nil {
+					log.Error("fail to unmatch", logger.FError(err))
+					matchResult = model.ErrorMatchResult
+					return err
+				}
+			}
 			exists, err := composed.Account.ExistsDislike(ctx, dislikeAccount)
 			if err != nil {
 				log.Error("fail to perform exists dislike", logger.FError(err))
@@ -191,9 +379,15 @@ func (m *match) MatchAction(ctx context.Context, accountID int64, targetID int64
 	return matchResult, nil
 }
 
-func (m *match) GetAccountLikers(ctx context.Context, accountID int64, offset int64, limit int64) (*commonModel.Pagination[model.Account], error) {
+// GetAccountLikers returns accounts that liked the account in its activeRole, the latest like first.
+func (m *match) GetAccountLikers(ctx context.Context, accountID int64, activeRole model.Role, offset int64, limit int64) (*commonModel.Pagination[model.Account], error) {
 	log := m.container.GetLogger()
-	numberOfAccounts, err := m.accountRepository.GetNumberAccountLikers(ctx, accountID)
+	role, err := accountConverter.ConvertRoleModel2Entity(activeRole)
+	if err != nil {
+		log.Error("fail to parse active role", logger.FError(err))
+		return nil, model.RoleNotHeldError
+	}
+	numberOfAccounts, err := m.accountRepository.GetNumberAccountLikers(ctx, accountID, role)
 	if err != nil {
 		log.Error("fail to get number of account likers", logger.FError(err))
 		return nil, err
@@ -202,7 +396,7 @@ func (m *match) GetAccountLikers(ctx context.Context, accountID int64, offset in
 		log.Error("number_of_accounts has nil value")
 		return nil, model.NilError
 	}
-	accounts, err := m.accountRepository.GetAccountLikers(ctx, accountID, offset, limit)
+	accounts, err := m.accountRepository.GetAccountLikers(ctx, accountID, role, offset, limit)
 	if err != nil {
 		log.Error("fail to get account likers", logger.FError(err))
 		return nil, err
@@ -220,16 +414,400 @@ func (m *match) GetAccountLikers(ctx context.Context, accountID int64, offset in
 		}
 		tagModels := accountConverter.ConvertEntities2TagModels(tags)
 		accountModel.Tags = &tagModels
-		categories, err := m.categoryRepository.GetCategoriesByAccountID(ctx, accountID)
+		categories, err := m.categoryRepository.GetCategoriesByAccountID(ctx, accountModel.ID)
+		if err != nil {
+			log.Error(
+				"fail to get categories by account id",
+				logger.FError(err),
+				logger.F("account_id", accountModel.ID),
+			)
+		}
 		categoryModels := categoryConverter.ConvertEntities2CategoriesModel(categories)
 		accountModel.Categories = &categoryModels
+		if err := applyPrivacy(ctx, m.accountRepository, m.privacyRepository, accountID, accountModel); err != nil {
+			log.Error("fail to apply privacy", logger.FError(err), logger.F("account_id", accountModel.ID))
+			return nil, err
+		}
 		accountModels = append(accountModels, *accountModel)
 	}
 	pagination := commonModel.Pagination[model.Account]{
-		Offset: 0,
+		Offset: offset,
 		Limit:  limit,
 		Total:  *numberOfAccounts,
 		Data:   accountModels,
 	}
 	return &pagination, nil
 }
+
+// GetMatches returns the active matches of the account acting in activeRole, the newest first.
+func (m *match) GetMatches(ctx context.Context, accountID int64, activeRole model.Role, offset int64, limit int64) (*commonModel.Pagination[model.Match], error) {
+	log := m.container.GetLogger()
+	role, err := accountConverter.ConvertRoleModel2Entity(activeRole)
+	if err != nil {
+		log.Error("fail to parse active role", logger.FError(err))
+		return nil, model.RoleNotHeldError
+	}
+	numberOfMatches, err := m.matchRepository.CountByAccountID(ctx, accountID, role)
+	if err != nil {
+		log.Error("fail to count matches", logger.FError(err))
+		return nil, err
+	}
+	matchEntities, err := m.matchRepository.GetListByAccountID(ctx, accountID, role, offset, limit)
+	if err != nil {
+		log.Error("fail to get matches", logger.FError(err))
+		return nil, err
+	}
+	partnerIDs := make([]int64, 0, len(matchEntities))
+	for _, matchEntity := range matchEntities {
+		partnerID, _ := matchEntity.PartnerOf(accountID)
+		partnerIDs = append(partnerIDs, partnerID)
+	}
+	partnerEntities, err := m.accountRepository.GetListByIDs(ctx, partnerIDs)
+	if err != nil {
+		log.Error("fail to get accounts by ids", logger.FError(err))
+		return nil, err
+	}
+	partnersByID := make(map[int64]entity.Account, len(partnerEntities))
+	for _, partnerEntity := range partnerEntities {
+		partnersByID[partnerEntity.ID] = partnerEntity
+	}
+	matches := make([]model.Match, 0, len(matchEntities))
+	for _, matchEntity := range matchEntities {
+		partnerID, _ := matchEntity.PartnerOf(accountID)
+		partnerEntity, ok := partnersByID[partnerID]
+		if !ok {
+			continue
+		}
+		partner, err := m.composeCardAccount(ctx, accountID, &partnerEntity)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, model.Match{
+			ID:        matchEntity.ID,
+			Account:   partner,
+			CreatedAt: matchEntity.CreatedAt,
+		})
+	}
+	pagination := commonModel.Pagination[model.Match]{
+		Offset: offset,
+		Limit:  limit,
+		Total:  numberOfMatches,
+		Data:   matches,
+	}
+	return &pagination, nil
+}
+
+// Unmatch ends the match for both sides: both likes are removed and the pair doesn't appear in the feed again.
+func (m *match) Unmatch(ctx context.Context, accountID int64, matchID int64) error {
+	log := m.container.GetLogger()
+	err := m.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		matchEntity, err := composed.Match.GetByID(ctx, matchID)
+		if err != nil {
+			log.Error("fail to get match by id", logger.FError(err), logger.F("match_id", matchID))
+			switch err {
+			case sql.ErrNoRows:
+				return model.EntityNotFoundError
+			default:
+				return err
+			}
+		}
+		if !matchEntity.HasAccount(accountID) || matchEntity.UnmatchedAt != nil {
+			return model.EntityNotFoundError
+		}
+		likes := []entity.LikeAccount{
+			{LikerID: matchEntity.ClientID, LikedID: matchEntity.FreelancerID, LikerRole: entity.ClientRole},
+			{LikerID: matchEntity.FreelancerID, LikedID: matchEntity.ClientID, LikerRole: entity.FreelancerRole},
+		}
+		for _, like := range likes {
+			if err := composed.Account.DeleteLikeAccount(ctx, like); err != nil {
+				log.Error("fail to delete like account", logger.FError(err))
+				return err
+			}
+		}
+		if err := composed.Match.Unmatch(ctx, matchEntity.ID, accountID); err != nil {
+			log.Error("fail to unmatch", logger.FError(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while unmatching", logger.FError(err))
+		return err
+	}
+	return nil
+}
+
+// Rewind reverts the latest like or dislike the account made acting in activeRole, a match the like formed
+// is dissolved, a dislike that ended a match isn't reverted. The number of rewinds within 24 hours is limited.
+func (m *match) Rewind(ctx context.Context, accountID int64, activeRole model.Role) (*model.Rewind, error) {
+	log := m.container.GetLogger()
+	role, err := accountConverter.ConvertRoleModel2Entity(activeRole)
+	if err != nil {
+		log.Error("fail to parse active role", logger.FError(err))
+		return nil, model.RoleNotHeldError
+	}
+	rewindsPerDay := m.container.GetMatchConfig().RewindsPerDay
+	var swipe *entity.Swipe
+	err = m.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		// concurrent rewinds of the account wait for the lock, so they can't all pass the limit check
+		if err := composed.Account.LockByID(ctx, accountID); err != nil {
+			log.Error("fail to lock account of the rewind", logger.FError(err))
+			return err
+		}
+		numberOfRewinds, err := composed.Rewind.CountSince(ctx, accountID, time.Now().Add(-24*time.Hour))
+		if err != nil {
+			log.Error("fail to count rewinds", logger.FError(err))
+			return err
+		}
+		if numberOfRewinds >= rewindsPerDay {
+			log.Error("account has exceeded the daily rewind limit", logger.F("account_id", accountID))
+			return model.RewindLimitError
+		}
+		swipe, err = composed.Account.GetLastSwipe(ctx, accountID, role)
+		if err != nil {
+			log.Error("fail to get last swipe", logger.FError(err))
+			switch err {
+			case sql.ErrNoRows:
+				return model.NothingToRewindError
+			default:
+				return err
+			}
+		}
+		clientID, freelancerID := matchPair(accountID, role, swipe.TargetID)
+		pairMatch, err := composed.Match.GetByPair(ctx, clientID, freelancerID)
+		if err != nil && err != sql.ErrNoRows {
+			log.Error("fail to get match by pair", logger.FError(err))
+			return err
+		}
+		if err := checkRewindableSwipe(swipe, pairMatch); err != nil {
+			log.Error("swipe can't be rewound", logger.FError(err), logger.F("target_id", swipe.TargetID))
+			return err
+		}
+		action := model.DislikeMatchAction
+		if swipe.Liked {
+			action = model.LikeMatchAction
+			likeAccount := entity.LikeAccount{
+				LikerID:   accountID,
+				LikedID:   swipe.TargetID,
+				LikerRole: role,
+			}
+			if err := composed.Account.DeleteLikeAccount(ctx, likeAccount); err != nil {
+				log.Error("fail to delete like account", logger.FError(err))
+				return err
+			}
+			if pairMatch != nil && pairMatch.UnmatchedAt == nil {
+				if err := composed.Match.Delete(ctx, pairMatch.ID); err != nil {
+					log.Error("fail to delete match", logger.FError(err))
+					return err
+				}
+			}
+		} else {
+			dislikeAccount := entity.DislikeAccount{
+				DislikerID:   accountID,
+				DislikedID:   swipe.TargetID,
+				DislikerRole: role,
+			}
+			if err := composed.Account.DeleteDislikeAccount(ctx, dislikeAccount); err != nil {
+				log.Error("fail to delete dislike account", logger.FError(err))
+				return err
+			}
+		}
+		rewindEntity := entity.MatchRewind{
+			AccountID: accountID,
+			TargetID:  swipe.TargetID,
+			Action:    string(action),
+		}
+		if err := composed.Rewind.Create(ctx, &rewindEntity); err != nil {
+			log.Error("fail to record rewind", logger.FError(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while rewinding", logger.FError(err))
+		return nil, err
+	}
+	rewind := model.Rewind{
+		Action: model.DislikeMatchAction,
+	}
+	if swipe.Liked {
+		rewind.Action = model.LikeMatchAction
+	}
+	targetEntities, err := m.accountRepository.GetListByIDs(ctx, []int64{swipe.TargetID})
+	if err != nil {
+		log.Error("fail to get accounts by ids", logger.FError(err))
+		return nil, err
+	}
+	blocked, err := m.accountRepository.IsBlocked(ctx, accountID, swipe.TargetID)
+	if err != nil {
+		log.Error("fail to check block between accounts", logger.FError(err), logger.F("target_id", swipe.TargetID))
+		return nil, err
+	}
+	// the swipe is reverted even if the account isn't active anymore or is blocked, there is no card to show then.
+	if len(targetEntities) > 0 && !blocked {
+		rewind.Account, err = m.composeCardAccount(ctx, accountID, &targetEntities[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &rewind, nil
+}
+
+// checkRewindableSwipe rejects a dislike of an account the pair has unmatched with: the dislike or the unmatch
+// took the likes back and the ended match keeps hiding the pair, so reverting the dislike alone wouldn't bring
+// the account back to the feed.
+func checkRewindableSwipe(swipe *entity.Swipe, pairMatch *entity.Match) error {
+	if !swipe.Liked && pairMatch != nil && pairMatch.UnmatchedAt != nil {
+		return model.RewindEndedMatchError
+	}
+	return nil
+}
+
+// Block hides the accounts from each other in the feeds, the likers and the profiles, a match between them ends.
+func (m *match) Block(ctx context.Context, accountID int64, targetID int64) error {
+	log := m.container.GetLogger()
+	if accountID == targetID {
+		return model.InvalidBlockError
+	}
+	if _, err := m.accountRepository.GetStatusByID(ctx, targetID); err != nil {
+		log.Error("fail to get account status by id", logger.FError(err), logger.F("target_id", targetID))
+		switch err {
+		case sql.ErrNoRows:
+			return model.EntityNotFoundError
+		default:
+			return err
+		}
+	}
+	err := m.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		blockAccount := entity.BlockAccount{
+			BlockerID: accountID,
+			BlockedID: targetID,
+		}
+		if err := composed.Account.BlockAccount(ctx, blockAccount); err != nil {
+			log.Error("fail to block account", logger.FError(err))
+			return err
+		}
+		// an account can hold both roles, so the accounts can be matched either way round.
+		pairs := [][2]int64{
+			{accountID, targetID},
+			{targetID, accountID},
+		}
+		for _, pair := range pairs {
+			pairMatch, err := composed.Match.GetByPair(ctx, pair[0], pair[1])
+			if err == sql.ErrNoRows {
+				continue
+			}
+			if err != nil {
+				log.Error("fail to get match by pair", logger.FError(err))
+				return err
+			}
+			if pairMatch.UnmatchedAt != nil {
+				continue
+			}
+			if err := composed.Match.Unmatch(ctx, pairMatch.ID, accountID); err != nil {
+				log.Error("fail to unmatch", logger.FError(err))
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while blocking", logger.FError(err))
+		return err
+	}
+	return nil
+}
+
+func (m *match) GetPreference(ctx context.Context, accountID int64) (*model.MatchPreference, error) {
+	log := m.container.GetLogger()
+	preferenceEntity, err := m.preferenceRepository.GetByAccountID(ctx, accountID)
+	if err != nil {
+		log.Error("fail to get match preference by account id", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	return accountConverter.ConvertEntity2MatchPreferenceModel(preferenceEntity), nil
+}
+
+// UpdatePreference replaces the match preference, countries are compared case-insensitively
+// and tags are normalized the same way as the tags of an account.
+func (m *match) UpdatePreference(ctx context.Context, accountID int64, preference model.MatchPreference) (*model.MatchPreference, error) {
+	log := m.container.GetLogger()
+	preferenceEntity := entity.MatchPreference{
+		AccountID:    accountID,
+		Countries:    make([]string, 0, len(preference.Countries)),
+		CategoryIDs:  make([]int64, 0, len(preference.CategoryIDs)),
+		RequiredTags: make([]string, 0, len(preference.RequiredTags)),
+		VerifiedOnly: preference.VerifiedOnly,
+		HasCompany:   preference.HasCompany,
+	}
+	countries := make(map[string]struct{}, len(preference.Countries))
+	for _, country := range preference.Countries {
+		country = strings.ToUpper(strings.TrimSpace(country))
+		if _, ok := countries[country]; ok || len(country) == 0 {
+			continue
+		}
+		countries[country] = struct{}{}
+		preferenceEntity.Countries = append(preferenceEntity.Countries, country)
+	}
+	categoryIDs := make(map[int64]struct{}, len(preference.CategoryIDs))
+	for _, categoryID := range preference.CategoryIDs {
+		if _, ok := categoryIDs[categoryID]; ok {
+			continue
+		}
+		categoryIDs[categoryID] = struct{}{}
+		preferenceEntity.CategoryIDs = append(preferenceEntity.CategoryIDs, categoryID)
+	}
+	tags := make(map[string]struct{}, len(preference.RequiredTags))
+	for _, tag := range convertTags(preference.RequiredTags) {
+		if _, ok := tags[tag]; ok || len(tag) == 0 {
+			continue
+		}
+		tags[tag] = struct{}{}
+		preferenceEntity.RequiredTags = append(preferenceEntity.RequiredTags, tag)
+	}
+	if err := m.preferenceRepository.Upsert(ctx, &preferenceEntity); err != nil {
+		log.Error("fail to upsert match preference", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	return accountConverter.ConvertEntity2MatchPreferenceModel(&preferenceEntity), nil
+}
+
+// composeCardAccount completes the account with what its match card shows, as the viewer is allowed to see it.
+func (m *match) composeCardAccount(ctx context.Context, viewerID int64, accountEntity *entity.Account) (*model.Account, error) {
+	log := m.container.GetLogger()
+	account := accountConverter.ConvertEntity2AccountModel(accountEntity)
+	tags, err := m.tagRepository.GetTagsByAccountID(ctx, accountEntity.ID)
+	if err != nil {
+		log.Error("fail to get tags by account id", logger.FError(err), logger.F("account_id", accountEntity.ID))
+		return nil, err
+	}
+	tagModels := accountConverter.ConvertEntities2TagModels(tags)
+	account.Tags = &tagModels
+	categories, err := m.categoryRepository.GetCategoriesByAccountID(ctx, accountEntity.ID)
+	if err != nil {
+		log.Error("fail to get categories by account id", logger.FError(err), logger.F("account_id", accountEntity.ID))
+		return nil, err
+	}
+	categoryModels := categoryConverter.ConvertEntities2CategoriesModel(categories)
+	account.Categories = &categoryModels
+	portfolioItems, err := m.portfolioRepository.GetAllByAccountID(ctx, accountEntity.ID)
+	if err != nil {
+		log.Error("fail to get portfolio items by account id", logger.FError(err), logger.F("account_id", accountEntity.ID))
+		return nil, err
+	}
+	portfolioItemModels := accountConverter.ConvertEntities2PortfolioItemModels(portfolioItems)
+	account.Portfolio = &portfolioItemModels
+	if err := applyPrivacy(ctx, m.accountRepository, m.privacyRepository, viewerID, account); err != nil {
+		log.Error("fail to apply privacy", logger.FError(err), logger.F("account_id", accountEntity.ID))
+		return nil, err
+	}
+	return account, nil
+}
+
+// matchPair orders the account acting in role and the target as the client and the freelancer of a match.
+func matchPair(accountID int64, role entity.Role, targetID int64) (int64, int64) {
+	if role == entity.ClientRole {
+		return accountID, targetID
+	}
+	return targetID, accountID
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/internal/infrastructure/config"
+	"math"
+	"sort"
+	"time"
+)
+
+// matchActivityHalfLife halves the activity score of a candidate for every week without activity.
+const matchActivityHalfLife = 7 * 24 * time.Hour
+
+// matchViewer is what the ranking needs to know about the account looking at the feed.
+type matchViewer struct {
+	Tags       int64
+	Categories int64
+}
+
+// rankMatchCandidates orders the candidates by score, the candidates with the same score by account id,
+// so the order doesn't change between pages as long as the candidates don't.
+func rankMatchCandidates(candidates []entity.MatchCandidate, viewer matchViewer, weights config.MatchWeights, now time.Time) []entity.MatchCandidate {
+	ranked := make([]entity.MatchCandidate, len(candidates))
+	copy(ranked, candidates)
+	scores := make(map[int64]float64, len(ranked))
+	for _, candidate := range ranked {
+		scores[candidate.AccountID] = scoreMatchCandidate(candidate, viewer, weights, now)
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		left, right := scores[ranked[i].AccountID], scores[ranked[j].AccountID]
+		if left != right {
+			return left > right
+		}
+		return ranked[i].AccountID < ranked[j].AccountID
+	})
+	return ranked
+}
+
+// pageMatchCandidates cuts the page out of the ranked candidates, a page past the pool is empty.
+func pageMatchCandidates(ranked []entity.MatchCandidate, offset int64, limit int64) []entity.MatchCandidate {
+	if offset >= int64(len(ranked)) {
+		return []entity.MatchCandidate{}
+	}
+	ranked = ranked[offset:]
+	if limit < int64(len(ranked)) {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+// matchFeedTotal caps the number of matchable accounts by the candidate pool, the accounts past the pool
+// are never ranked, so the total mustn't promise pages the feed can't serve.
+func matchFeedTotal(numberOfAccounts int64, candidatePoolSize int64) int64 {
+	if numberOfAccounts > candidatePoolSize {
+		return candidatePoolSize
+	}
+	return numberOfAccounts
+}
+
+// scoreMatchCandidate sums the weighted components of the candidate, every component is scored from 0 to 1.
+func scoreMatchCandidate(candidate entity.MatchCandidate, viewer matchViewer, weights config.MatchWeights, now time.Time) float64 {
+	score := weights.Tags*overlapScore(candidate.SharedTags, viewer.Tags) +
+		weights.Categories*overlapScore(candidate.SharedCategories, viewer.Categories) +
+		weights.Completeness*overlapScore(candidate.FilledFields, entity.MatchCandidateProfileFields) +
+		weights.Activity*activityScore(candidate.LastActiveAt, now)
+	if candidate.SameCountry {
+		score += weights.Country
+	}
+	if candidate.LikedViewer {
+		score += weights.LikedViewer
+	}
+	return score
+}
+
+func overlapScore(shared int64, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return math.Min(float64(shared)/float64(total), 1)
+}
+
+// activityScore counts the inactivity in whole days, the score stays the same during a day and the feed
+// doesn't reorder while the viewer pages through it.
+func activityScore(lastActiveAt *time.Time, now time.Time) float64 {
+	if lastActiveAt == nil {
+		return 0
+	}
+	inactiveDays := math.Floor(now.Sub(*lastActiveAt).Hours() / 24)
+	if inactiveDays < 0 {
+		inactiveDays = 0
+	}
+	return math.Pow(0.5, inactiveDays*24/matchActivityHalfLife.Hours())
+}
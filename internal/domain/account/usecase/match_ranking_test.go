@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/internal/infrastructure/config"
+	"testing"
+	"time"
+)
+
+var testMatchWeights = config.MatchWeights{
+	Tags:         3,
+	Categories:   2,
+	Country:      1,
+	Completeness: 1,
+	Activity:     1.5,
+	LikedViewer:  2.5,
+}
+
+func TestRankMatchCandidates(t *testing.T) {
+	now := time.Date(2024, 12, 7, 12, 0, 0, 0, time.UTC)
+	activeToday := now.Add(-time.Hour)
+	activeMonthAgo := now.Add(-30 * 24 * time.Hour)
+	viewer := matchViewer{Tags: 4, Categories: 2}
+	tests := []struct {
+		name       string
+		candidates []entity.MatchCandidate
+		weights    config.MatchWeights
+		expect     []int64
+	}{
+		{
+			name: "shared interests go first",
+			candidates: []entity.MatchCandidate{
+				{AccountID: 1, LastActiveAt: &activeToday},
+				{AccountID: 2, SharedTags: 4, SharedCategories: 2, LastActiveAt: &activeToday},
+				{AccountID: 3, SharedTags: 1, LastActiveAt: &activeToday},
+			},
+			weights: testMatchWeights,
+			expect:  []int64{2, 3, 1},
+		},
+		{
+			name: "recent activity beats a complete but abandoned profile",
+			candidates: []entity.MatchCandidate{
+				{AccountID: 1, FilledFields: entity.MatchCandidateProfileFields, LastActiveAt: &activeMonthAgo},
+				{AccountID: 2, FilledFields: 4, LastActiveAt: &activeToday},
+				{AccountID: 3},
+			},
+			weights: testMatchWeights,
+			expect:  []int64{2, 1, 3},
+		},
+		{
+			name: "an account that liked the viewer and lives in the same country",
+			candidates: []entity.MatchCandidate{
+				{AccountID: 1, SharedTags: 2, LastActiveAt: &activeToday},
+				{AccountID: 2, LikedViewer: true, LastActiveAt: &activeToday},
+				{AccountID: 3, SameCountry: true, LastActiveAt: &activeToday},
+			},
+			weights: testMatchWeights,
+			expect:  []int64{2, 1, 3},
+		},
+		{
+			name: "ties are ordered by account id",
+			candidates: []entity.MatchCandidate{
+				{AccountID: 9, SharedTags: 1},
+				{AccountID: 4, SharedTags: 1},
+				{AccountID: 7, SharedTags: 1},
+			},
+			weights: testMatchWeights,
+			expect:  []int64{4, 7, 9},
+		},
+		{
+			name: "weights change the order",
+			candidates: []entity.MatchCandidate{
+				{AccountID: 1, SharedTags: 4},
+				{AccountID: 2, SameCountry: true},
+			},
+			weights: config.MatchWeights{Tags: 1, Country: 5},
+			expect:  []int64{2, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranked := rankMatchCandidates(tt.candidates, viewer, tt.weights, now)
+			if len(ranked) != len(tt.expect) {
+				t.Fatalf("expected %d candidates, got %d", len(tt.expect), len(ranked))
+			}
+			for i, accountID := range tt.expect {
+				if ranked[i].AccountID != accountID {
+					t.Errorf("position %d: expected account %d, got %d", i, accountID, ranked[i].AccountID)
+				}
+			}
+		})
+	}
+}
+
+func TestRankMatchCandidatesIsStableDuringDay(t *testing.T) {
+	morning := time.Date(2024, 12, 7, 8, 0, 0, 0, time.UTC)
+	evening := morning.Add(12 * time.Hour)
+	lastActiveAt := morning.Add(-36 * time.Hour)
+	otherLastActiveAt := morning.Add(-40 * time.Hour)
+	candidates := []entity.MatchCandidate{
+		{AccountID: 1, SharedTags: 1, LastActiveAt: &lastActiveAt},
+		{AccountID: 2, SharedTags: 1, LastActiveAt: &otherLastActiveAt},
+		{AccountID: 3, SameCountry: true, LastActiveAt: &lastActiveAt},
+	}
+	viewer := matchViewer{Tags: 2}
+	morningRanking := rankMatchCandidates(candidates, viewer, testMatchWeights, morning)
+	eveningRanking := rankMatchCandidates(candidates, viewer, testMatchWeights, evening)
+	for i := range morningRanking {
+		if morningRanking[i].AccountID != eveningRanking[i].AccountID {
+			t.Fatalf("position %d: morning has account %d, evening has %d", i, morningRanking[i].AccountID, eveningRanking[i].AccountID)
+		}
+	}
+}
+
+func TestScoreMatchCandidateWithoutViewerInterests(t *testing.T) {
+	candidate := entity.MatchCandidate{AccountID: 1, SharedTags: 3, SharedCategories: 1}
+	if score := scoreMatchCandidate(candidate, matchViewer{}, testMatchWeights, time.Now()); score != 0 {
+		t.Errorf("expected zero score, got %v", score)
+	}
+}
+
+func TestMatchFeedPagePastCandidatePool(t *testing.T) {
+	const candidatePoolSize = 3
+	ranked := []entity.MatchCandidate{{AccountID: 1}, {AccountID: 2}, {AccountID: 3}}
+	total := matchFeedTotal(10, candidatePoolSize)
+	if total != candidatePoolSize {
+		t.Fatalf("expected total capped at %d, got %d", candidatePoolSize, total)
+	}
+	tests := []struct {
+		name   string
+		offset int64
+		limit  int64
+		expect []int64
+	}{
+		{name: "last page of the pool", offset: 2, limit: 2, expect: []int64{3}},
+		{name: "page at the pool boundary", offset: total, limit: 2, expect: []int64{}},
+		{name: "page past the pool boundary", offset: 6, limit: 2, expect: []int64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := pageMatchCandidates(ranked, tt.offset, tt.limit)
+			if len(page) != len(tt.expect) {
+				t.Fatalf("expected %d candidates, got %d", len(tt.expect), len(page))
+			}
+			for i, accountID := range tt.expect {
+				if page[i].AccountID != accountID {
+					t.Errorf("position %d: expected account %d, got %d", i, accountID, page[i].AccountID)
+				}
+			}
+		})
+	}
+	if total := matchFeedTotal(2, candidatePoolSize); total != 2 {
+		t.Errorf("expected total below the pool to stay 2, got %d", total)
+	}
+}
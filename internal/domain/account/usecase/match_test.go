@@ -0,0 +1,304 @@
+package usecase
+
+import (
+	"context"
+	"go-tonify-backend/internal/domain/account/model"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	categoryRepository "go-tonify-backend/internal/domain/category/repository"
+	"go-tonify-backend/internal/domain/entity"
+	"testing"
+	"time"
+)
+
+const testViewerID int64 = 1
+
+type fakeMatchAccountRepository struct {
+	accountRepository.Account
+	likers      []entity.Account
+	likedRole   entity.Role
+	offset      int64
+	limit       int64
+	matchedWith map[int64]bool
+	accounts    []entity.Account
+}
+
+func (f *fakeMatchAccountRepository) GetNumberAccountLikers(ctx context.Context, accountID int64, likedRole entity.Role) (*int64, error) {
+	numberOfLikers := int64(len(f.likers))
+	return &numberOfLikers, nil
+}
+
+func (f *fakeMatchAccountRepository) GetAccountLikers(ctx context.Context, accountID int64, likedRole entity.Role, offset int64, limit int64) ([]entity.Account, error) {
+	f.likedRole, f.offset, f.limit = likedRole, offset, limit
+	if offset >= int64(len(f.likers)) {
+		return []entity.Account{}, nil
+	}
+	end := offset + limit
+	if end > int64(len(f.likers)) {
+		end = int64(len(f.likers))
+	}
+	return f.likers[offset:end], nil
+}
+
+func (f *fakeMatchAccountRepository) GetListByIDs(ctx context.Context, ids []int64) ([]entity.Account, error) {
+	accounts := make([]entity.Account, 0, len(ids))
+	for _, account := range f.accounts {
+		for _, id := range ids {
+			if account.ID == id {
+				accounts = append(accounts, account)
+			}
+		}
+	}
+	return accounts, nil
+}
+
+func (f *fakeMatchAccountRepository) IsMatched(ctx context.Context, accountID int64, otherAccountID int64) (bool, error) {
+	return f.matchedWith[otherAccountID], nil
+}
+
+type fakeMatchTagRepository struct {
+	accountRepository.Tag
+}
+
+func (f *fakeMatchTagRepository) GetTagsByAccountID(ctx context.Context, accountID int64) ([]entity.Tag, error) {
+	return []entity.Tag{}, nil
+}
+
+// fakeMatchCategoryRepository gives every account a category with the id of the account.
+type fakeMatchCategoryRepository struct {
+	categoryRepository.Category
+}
+
+func (f *fakeMatchCategoryRepository) GetCategoriesByAccountID(ctx context.Context, accountID int64) ([]entity.Category, error) {
+	return []entity.Category{{ID: accountID}}, nil
+}
+
+type fakeMatchPrivacyRepository struct {
+	accountRepository.Privacy
+}
+
+func (f *fakeMatchPrivacyRepository) GetByAccountID(ctx context.Context, accountID int64) (*entity.AccountPrivacy, error) {
+	privacy := entity.DefaultAccountPrivacy(accountID)
+	return &privacy, nil
+}
+
+type fakeMatchPortfolioRepository struct {
+	accountRepository.Portfolio
+}
+
+func (f *fakeMatchPortfolioRepository) GetAllByAccountID(ctx context.Context, accountID int64) ([]entity.PortfolioItem, error) {
+	return []entity.PortfolioItem{}, nil
+}
+
+type fakeMatchRepository struct {
+	accountRepository.Match
+	matches []entity.Match
+	role    entity.Role
+}
+
+func (f *fakeMatchRepository) CountByAccountID(ctx context.Context, accountID int64, role entity.Role) (int64, error) {
+	return int64(len(f.matches)), nil
+}
+
+func (f *fakeMatchRepository) GetListByAccountID(ctx context.Context, accountID int64, role entity.Role, offset int64, limit int64) ([]entity.Match, error) {
+	f.role = role
+	return f.matches, nil
+}
+
+func newTestMatch(accountRepository accountRepository.Account, matchRepository accountRepository.Match) *match {
+	return NewMatch(
+		&fakeContainer{},
+		nil,
+		accountRepository,
+		&fakeMatchTagRepository{},
+		&fakeMatchPrivacyRepository{},
+		&fakeMatchPortfolioRepository{},
+		&fakeMatchCategoryRepository{},
+		matchRepository,
+		nil,
+	).(*match)
+}
+
+func TestCheckRewindableSwipe(t *testing.T) {
+	unmatchedAt := time.Date(2024, 12, 7, 12, 0, 0, 0, time.UTC)
+	activeMatch := entity.Match{ID: 1, ClientID: 2, FreelancerID: 3}
+	endedMatch := entity.Match{ID: 1, ClientID: 2, FreelancerID: 3, UnmatchedAt: &unmatchedAt}
+	tests := []struct {
+		name        string
+		swipe       entity.Swipe
+		pairMatch   *entity.Match
+		expectedErr error
+	}{
+		{name: "dislike without a match", swipe: entity.Swipe{TargetID: 3}},
+		{name: "like that formed a match", swipe: entity.Swipe{TargetID: 3, Liked: true}, pairMatch: &activeMatch},
+		{name: "dislike that ended a match", swipe: entity.Swipe{TargetID: 3}, pairMatch: &endedMatch, expectedErr: model.RewindEndedMatchError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkRewindableSwipe(&tt.swipe, tt.pairMatch); err != tt.expectedErr {
+				t.Errorf("expected %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestGetAccountLikers(t *testing.T) {
+	accountRepository := &fakeMatchAccountRepository{
+		likers: []entity.Account{{ID: 4}, {ID: 3}, {ID: 2}},
+		matchedWith: map[int64]bool{
+			3: true,
+		},
+	}
+	matchUsecase := newTestMatch(accountRepository, nil)
+	pagination, err := matchUsecase.GetAccountLikers(context.Background(), testViewerID, model.FreelancerRole, 1, 2)
+	if err != nil {
+		t.Fatal("fail to get account likers", err)
+	}
+	if accountRepository.likedRole != entity.FreelancerRole {
+		t.Errorf("expected likers of the freelancer role, got %s", accountRepository.likedRole)
+	}
+	if accountRepository.offset != 1 || accountRepository.limit != 2 {
+		t.Errorf("expected offset 1 and limit 2, got %d and %d", accountRepository.offset, accountRepository.limit)
+	}
+	if pagination.Total != 3 {
+		t.Errorf("expected total 3, got %d", pagination.Total)
+	}
+	expectedIDs := []int64{3, 2}
+	if len(pagination.Data) != len(expectedIDs) {
+		t.Fatalf("expected %d likers, got %d", len(expectedIDs), len(pagination.Data))
+	}
+	expectedAudiences := []model.Audience{model.MatchAudience, model.StrangerAudience}
+	for i, account := range pagination.Data {
+		if account.ID != expectedIDs[i] {
+			t.Errorf("liker %d: expected account %d, got %d", i, expectedIDs[i], account.ID)
+		}
+		if account.Categories == nil || len(*account.Categories) != 1 || (*account.Categories)[0].ID != account.ID {
+			t.Errorf("liker %d: expected the categories of account %d, got %v", i, account.ID, account.Categories)
+		}
+		if account.Audience != expectedAudiences[i] {
+			t.Errorf("liker %d: expected audience %s, got %s", i, expectedAudiences[i], account.Audience)
+		}
+	}
+}
+
+func TestGetAccountLikersUnknownRole(t *testing.T) {
+	matchUsecase := newTestMatch(&fakeMatchAccountRepository{}, nil)
+	_, err := matchUsecase.GetAccountLikers(context.Background(), testViewerID, model.UnknownRole, 0, 10)
+	if err != model.RoleNotHeldError {
+		t.Errorf("expected %v, got %v", model.RoleNotHeldError, err)
+	}
+}
+
+func TestGetMatches(t *testing.T) {
+	accountRepository := &fakeMatchAccountRepository{
+		accounts:    []entity.Account{{ID: 2}, {ID: 3}},
+		matchedWith: map[int64]bool{2: true, 3: true},
+	}
+	matchRepository := &fakeMatchRepository{
+		matches: []entity.Match{
+			{ID: 10, ClientID: 2, FreelancerID: testViewerID},
+			{ID: 11, ClientID: 4, FreelancerID: testViewerID},
+			{ID: 12, ClientID: 3, FreelancerID: testViewerID},
+		},
+	}
+	matchUsecase := newTestMatch(accountRepository, matchRepository)
+	pagination, err := matchUsecase.GetMatches(context.Background(), testViewerID, model.FreelancerRole, 0, 10)
+	if err != nil {
+		t.Fatal("fail to get matches", err)
+	}
+	if matchRepository.role != entity.FreelancerRole {
+		t.Errorf("expected matches of the freelancer role, got %s", matchRepository.role)
+	}
+	// the partner of match 11 is no longer available, so the match is skipped
+	expected := map[int64]int64{10: 2, 12: 3}
+	if len(pagination.Data) != len(expected) {
+		t.Fatalf("expected %d matches, got %d", len(expected), len(pagination.Data))
+	}
+	for _, matchModel := range pagination.Data {
+		if matchModel.Account == nil || matchModel.Account.ID != expected[matchModel.ID] {
+			t.Errorf("match %d: expected partner %d, got %v", matchModel.ID, expected[matchModel.ID], matchModel.Account)
+			continue
+		}
+		if matchModel.Account.Audience != model.MatchAudience {
+			t.Errorf("match %d: expected audience %s, got %s", matchModel.ID, model.MatchAudience, matchModel.Account.Audience)
+		}
+	}
+}
+
+func TestMatchPair(t *testing.T) {
+	tests := []struct {
+		name               string
+		role               entity.Role
+		expectedClient     int64
+		expectedFreelancer int64
+	}{
+		{name: "client likes freelancer", role: entity.ClientRole, expectedClient: 1, expectedFreelancer: 2},
+		{name: "freelancer likes client", role: entity.FreelancerRole, expectedClient: 2, expectedFreelancer: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientID, freelancerID := matchPair(1, tt.role, 2)
+			if clientID != tt.expectedClient || freelancerID != tt.expectedFreelancer {
+				t.Errorf("expected client %d and freelancer %d, got %d and %d", tt.expectedClient, tt.expectedFreelancer, clientID, freelancerID)
+			}
+		})
+	}
+}
+
+type fakeMatchPreferenceRepository struct {
+	accountRepository.MatchPreference
+	upserted *entity.MatchPreference
+}
+
+func (f *fakeMatchPreferenceRepository) Upsert(ctx context.Context, preference *entity.MatchPreference) error {
+	f.upserted = preference
+	return nil
+}
+
+func TestUpdatePreferenceNormalizes(t *testing.T) {
+	preferenceRepository := &fakeMatchPreferenceRepository{}
+	matchUsecase := &match{
+		container:            &fakeContainer{},
+		preferenceRepository: preferenceRepository,
+	}
+	hasCompany := true
+	preference, err := matchUsecase.UpdatePreference(context.Background(), testViewerID, model.MatchPreference{
+		Countries:    []string{" ua", "UA", "pl", ""},
+		CategoryIDs:  []int64{3, 1, 3},
+		RequiredTags: []string{"Go Lang", "golang", "#golang", "  ", "Rust"},
+		VerifiedOnly: true,
+		HasCompany:   &hasCompany,
+	})
+	if err != nil {
+		t.Fatal("fail to update preference", err)
+	}
+	if preferenceRepository.upserted == nil || preferenceRepository.upserted.AccountID != testViewerID {
+		t.Fatal("expected the preference of the account to be stored", preferenceRepository.upserted)
+	}
+	expectedCountries := []string{"UA", "PL"}
+	expectedCategoryIDs := []int64{3, 1}
+	expectedTags := []string{"golang", "rust"}
+	if !equalSlices(preference.Countries, expectedCountries) {
+		t.Errorf("expected countries %v, got %v", expectedCountries, preference.Countries)
+	}
+	if !equalSlices(preference.CategoryIDs, expectedCategoryIDs) {
+		t.Errorf("expected category ids %v, got %v", expectedCategoryIDs, preference.CategoryIDs)
+	}
+	if !equalSlices(preference.RequiredTags, expectedTags) {
+		t.Errorf("expected required tags %v, got %v", expectedTags, preference.RequiredTags)
+	}
+	if !preference.VerifiedOnly || preference.HasCompany == nil || !*preference.HasCompany {
+		t.Errorf("expected verified only and has company to be kept, got %v and %v", preference.VerifiedOnly, preference.HasCompany)
+	}
+}
+
+func equalSlices[T comparable](actual []T, expected []T) bool {
+	if len(actual) != len(expected) {
+		return false
+	}
+	for i := range actual {
+		if actual[i] != expected[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"go-tonify-backend/internal/domain/account/model"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	"go-tonify-backend/pkg/psql"
+	"strings"
+)
+
+var reservedNicknames = []string{
+	"@admin",
+	"@administrator",
+	"@moderator",
+	"@staff",
+	"@support",
+	"@help",
+	"@system",
+	"@root",
+	"@official",
+	"@tonify",
+	"@tonifyapp",
+}
+
+func isReservedNickname(nickname string) bool {
+	for _, reservedNickname := range reservedNicknames {
+		if strings.EqualFold(nickname, reservedNickname) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSameNickname(nickname *string, otherNickname *string) bool {
+	return nickname != nil && otherNickname != nil && strings.EqualFold(*nickname, *otherNickname)
+}
+
+// checkNicknameAvailable fails with NicknameUnavailableError when the nickname is reserved
+// or another account that isn't deleted uses it, the check ignores the account of accountID.
+func checkNicknameAvailable(ctx context.Context, accountRepository accountRepository.Account, accountID int64, nickname string) error {
+	if isReservedNickname(nickname) {
+		return model.NicknameUnavailableError
+	}
+	exists, err := accountRepository.ExistsWithNickname(ctx, nickname, accountID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return model.NicknameUnavailableError
+	}
+	return nil
+}
+
+// nicknameConflictError turns the unique nickname violation, when two accounts take a nickname at once, into NicknameUnavailableError.
+func nicknameConflictError(err error) error {
+	if psql.IsUniqueViolation(err, accountRepository.NicknameUniqueIndex) {
+		return model.NicknameUnavailableError
+	}
+	return err
+}
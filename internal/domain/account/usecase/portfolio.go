@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/container"
+	accountConverter "go-tonify-backend/internal/domain/account/converter"
+	"go-tonify-backend/internal/domain/account/model"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/internal/domain/filestorage"
+	"go-tonify-backend/internal/domain/provider/transaction"
+	"go-tonify-backend/pkg/logger"
+)
+
+const (
+	MaxPortfolioItemsByAccount int64 = 12
+)
+
+type Portfolio interface {
+	GetPortfolio(ctx context.Context, accountID int64) ([]model.PortfolioItem, error)
+	AddItem(ctx context.Context, addPortfolioItem model.AddPortfolioItem) (*model.PortfolioItem, error)
+	EditItem(ctx context.Context, editPortfolioItem model.EditPortfolioItem) (*model.PortfolioItem, error)
+	ReorderItems(ctx context.Context, accountID int64, itemIDs []int64) ([]model.PortfolioItem, error)
+	RemoveItem(ctx context.Context, accountID int64, id int64) error
+}
+
+type portfolio struct {
+	container           container.Container
+	fileStorage         filestorage.FileStorage
+	transactionProvider *transaction.Provider
+	portfolioRepository accountRepository.Portfolio
+}
+
+func NewPortfolio(
+	container container.Container,
+	fileStorage filestorage.FileStorage,
+	transactionProvider *transaction.Provider,
+	portfolioRepository accountRepository.Portfolio,
+) Portfolio {
+	return &portfolio{
+		container:           container,
+		fileStorage:         fileStorage,
+		transactionProvider: transactionProvider,
+		portfolioRepository: portfolioRepository,
+	}
+}
+
+func (p *portfolio) GetPortfolio(ctx context.Context, accountID int64) ([]model.PortfolioItem, error) {
+	log := p.container.GetLogger()
+	portfolioItemEntities, err := p.portfolioRepository.GetAllByAccountID(ctx, accountID)
+	if err != nil {
+		log.Error("fail to get portfolio items by account id", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	return accountConverter.ConvertEntities2PortfolioItemModels(portfolioItemEntities), nil
+}
+
+// AddItem uploads the file of the item and puts the item at the end of the portfolio.
+func (p *portfolio) AddItem(ctx context.Context, addPortfolioItem model.AddPortfolioItem) (*model.PortfolioItem, error) {
+	log := p.container.GetLogger()
+	// the limit is checked before the upload so a full portfolio doesn't cost an upload,
+	// it's checked again under the account lock since concurrent additions may pass this check
+	numberOfItems, err := p.portfolioRepository.CountByAccountID(ctx, addPortfolioItem.AccountID)
+	if err != nil {
+		log.Error("fail to count portfolio items", logger.FError(err))
+		return nil, err
+	}
+	if err := checkPortfolioLimit(log, addPortfolioItem.AccountID, numberOfItems); err != nil {
+		return nil, err
+	}
+	attachmentEntity, err := uploadAndPrepareAttachmentEntity(log, p.fileStorage, addPortfolioItem.FileHeader)
+	if err != nil {
+		log.Error("fail to upload and prepare a portfolio attachment entity", logger.FError(err))
+		return nil, err
+	}
+	var portfolioItemID *int64
+	err = p.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		if err := composed.Account.LockByID(ctx, addPortfolioItem.AccountID); err != nil {
+			log.Error("fail to lock account of the portfolio", logger.FError(err))
+			return err
+		}
+		numberOfItems, err := composed.Portfolio.CountByAccountID(ctx, addPortfolioItem.AccountID)
+		if err != nil {
+			log.Error("fail to count portfolio items", logger.FError(err))
+			return err
+		}
+		if err := checkPortfolioLimit(log, addPortfolioItem.AccountID, numberOfItems); err != nil {
+			return err
+		}
+		attachmentID, err := composed.Attachment.Create(ctx, attachmentEntity)
+		if err != nil {
+			log.Error("fail to record portfolio attachment to db", logger.FError(err))
+			return err
+		}
+		if attachmentID == nil {
+			log.Error("attachmentID contains nil value")
+			return model.NilError
+		}
+		portfolioItemEntity := entity.PortfolioItem{
+			AccountID:    addPortfolioItem.AccountID,
+			AttachmentID: *attachmentID,
+			Title:        addPortfolioItem.Title,
+			Description:  addPortfolioItem.Description,
+			ExternalLink: addPortfolioItem.ExternalLink,
+			SortOrder:    *numberOfItems,
+		}
+		portfolioItemID, err = composed.Portfolio.Create(ctx, &portfolioItemEntity)
+		if err != nil {
+			log.Error("fail to record portfolio item to db", logger.FError(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while adding portfolio item", logger.FError(err))
+		if err := p.fileStorage.DeleteFile(attachmentEntity.FileName); err != nil {
+			log.Error("fail to delete attachment from file storage", logger.FError(err))
+		}
+		return nil, err
+	}
+	if portfolioItemID == nil {
+		log.Error("portfolioItemID contains nil value")
+		return nil, model.NilError
+	}
+	return p.getItem(ctx, addPortfolioItem.AccountID, *portfolioItemID)
+}
+
+func (p *portfolio) EditItem(ctx context.Context, editPortfolioItem model.EditPortfolioItem) (*model.PortfolioItem, error) {
+	log := p.container.GetLogger()
+	if _, err := p.getItem(ctx, editPortfolioItem.AccountID, editPortfolioItem.ID); err != nil {
+		return nil, err
+	}
+	portfolioItemEntity := entity.PortfolioItem{
+		ID:           editPortfolioItem.ID,
+		AccountID:    editPortfolioItem.AccountID,
+		Title:        editPortfolioItem.Title,
+		Description:  editPortfolioItem.Description,
+		ExternalLink: editPortfolioItem.ExternalLink,
+	}
+	if err := p.portfolioRepository.Update(ctx, &portfolioItemEntity); err != nil {
+		log.Error("fail to update portfolio item", logger.FError(err), logger.F("portfolio_item_id", editPortfolioItem.ID))
+		return nil, err
+	}
+	return p.getItem(ctx, editPortfolioItem.AccountID, editPortfolioItem.ID)
+}
+
+// ReorderItems sorts the portfolio as itemIDs lists it, itemIDs must contain every item of the account exactly once.
+func (p *portfolio) ReorderItems(ctx context.Context, accountID int64, itemIDs []int64) ([]model.PortfolioItem, error) {
+	log := p.container.GetLogger()
+	portfolioItemEntities, err := p.portfolioRepository.GetAllByAccountID(ctx, accountID)
+	if err != nil {
+		log.Error("fail to get portfolio items by account id", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	if len(itemIDs) != len(portfolioItemEntities) {
+		return nil, model.InvalidPortfolioOrderError
+	}
+	unordered := make(map[int64]bool, len(portfolioItemEntities))
+	for _, portfolioItemEntity := range portfolioItemEntities {
+		unordered[portfolioItemEntity.ID] = true
+	}
+	for _, itemID := range itemIDs {
+		if !unordered[itemID] {
+			return nil, model.InvalidPortfolioOrderError
+		}
+		delete(unordered, itemID)
+	}
+	err = p.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		for sortOrder, itemID := range itemIDs {
+			if err := composed.Portfolio.UpdateSortOrder(ctx, accountID, itemID, int64(sortOrder)); err != nil {
+				log.Error("fail to update portfolio item sort order", logger.FError(err), logger.F("portfolio_item_id", itemID))
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while reordering portfolio", logger.FError(err))
+		return nil, err
+	}
+	return p.GetPortfolio(ctx, accountID)
+}
+
+// RemoveItem deletes the item with its attachment and closes the gap it leaves in the order.
+func (p *portfolio) RemoveItem(ctx context.Context, accountID int64, id int64) error {
+	log := p.container.GetLogger()
+	portfolioItemEntity, err := p.portfolioRepository.GetByID(ctx, accountID, id)
+	if err != nil {
+		log.Error("fail to get portfolio item by id", logger.FError(err), logger.F("portfolio_item_id", id))
+		switch err {
+		case sql.ErrNoRows:
+			return model.EntityNotFoundError
+		default:
+			return err
+		}
+	}
+	err = p.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		if err := composed.Portfolio.Delete(ctx, accountID, id); err != nil {
+			log.Error("fail to delete portfolio item", logger.FError(err))
+			return err
+		}
+		if err := composed.Attachment.HardDelete(ctx, portfolioItemEntity.AttachmentID); err != nil {
+			log.Error("fail to hard delete portfolio attachment", logger.FError(err))
+			return err
+		}
+		remainingItemEntities, err := composed.Portfolio.GetAllByAccountID(ctx, accountID)
+		if err != nil {
+			log.Error("fail to get portfolio items by account id", logger.FError(err))
+			return err
+		}
+		for sortOrder, remainingItemEntity := range remainingItemEntities {
+			if remainingItemEntity.SortOrder == int64(sortOrder) {
+				continue
+			}
+			if err := composed.Portfolio.UpdateSortOrder(ctx, accountID, remainingItemEntity.ID, int64(sortOrder)); err != nil {
+				log.Error("fail to update portfolio item sort order", logger.FError(err))
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while removing portfolio item", logger.FError(err))
+		return err
+	}
+	if attachmentEntity := portfolioItemEntity.Attachment; attachmentEntity != nil && len(attachmentEntity.FileName) > 0 {
+		if err := p.fileStorage.DeleteFile(attachmentEntity.FileName); err != nil {
+			log.Error("fail to delete attachment from file storage", logger.FError(err), logger.F("file_name", attachmentEntity.FileName))
+		}
+	}
+	return nil
+}
+
+func (p *portfolio) getItem(ctx context.Context, accountID int64, id int64) (*model.PortfolioItem, error) {
+	log := p.container.GetLogger()
+	portfolioItemEntity, err := p.portfolioRepository.GetByID(ctx, accountID, id)
+	if err != nil {
+		log.Error("fail to get portfolio item by id", logger.FError(err), logger.F("portfolio_item_id", id))
+		switch err {
+		case sql.ErrNoRows:
+			return nil, model.EntityNotFoundError
+		default:
+			return nil, err
+		}
+	}
+	return accountConverter.ConvertEntity2PortfolioItemModel(portfolioItemEntity), nil
+}
+
+func checkPortfolioLimit(log logger.Logger, accountID int64, numberOfItems *int64) error {
+	if numberOfItems == nil {
+		log.Error("numberOfItems contains nil value")
+		return model.NilError
+	}
+	if *numberOfItems >= MaxPortfolioItemsByAccount {
+		log.Error("account has exceeded the limit of portfolio items", logger.F("account_id", accountID))
+		return model.PortfolioLimitError
+	}
+	return nil
+}
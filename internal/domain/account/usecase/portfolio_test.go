@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"go-tonify-backend/internal/domain/account/model"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	"go-tonify-backend/internal/domain/filestorage"
+	"mime/multipart"
+	"testing"
+)
+
+type fakePortfolioRepository struct {
+	accountRepository.Portfolio
+	numberOfItems int64
+}
+
+func (f *fakePortfolioRepository) CountByAccountID(ctx context.Context, accountID int64) (*int64, error) {
+	return &f.numberOfItems, nil
+}
+
+type fakeUploadFileStorage struct {
+	filestorage.FileStorage
+	uploads int
+}
+
+func (f *fakeUploadFileStorage) UploadFile(fileName string, file multipart.File) (*string, error) {
+	f.uploads++
+	return &fileName, nil
+}
+
+func TestAddPortfolioItemChecksLimitBeforeUpload(t *testing.T) {
+	fileStorage := &fakeUploadFileStorage{}
+	portfolioUsecase := NewPortfolio(&fakeContainer{}, fileStorage, nil, &fakePortfolioRepository{
+		numberOfItems: MaxPortfolioItemsByAccount,
+	})
+	_, err := portfolioUsecase.AddItem(context.Background(), model.AddPortfolioItem{AccountID: 1, Title: "landing"})
+	if err != model.PortfolioLimitError {
+		t.Errorf("expected %v, got %v", model.PortfolioLimitError, err)
+	}
+	if fileStorage.uploads != 0 {
+		t.Errorf("expected no upload for a full portfolio, got %d", fileStorage.uploads)
+	}
+}
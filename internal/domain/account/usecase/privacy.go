@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"go-tonify-backend/internal/container"
+	accountConverter "go-tonify-backend/internal/domain/account/converter"
+	"go-tonify-backend/internal/domain/account/model"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/logger"
+)
+
+type Privacy interface {
+	GetPrivacy(ctx context.Context, accountID int64) (*model.Privacy, error)
+	UpdatePrivacy(ctx context.Context, accountID int64, privacy model.Privacy) error
+}
+
+type privacy struct {
+	container         container.Container
+	privacyRepository accountRepository.Privacy
+}
+
+func NewPrivacy(
+	container container.Container,
+	privacyRepository accountRepository.Privacy,
+) Privacy {
+	return &privacy{
+		container:         container,
+		privacyRepository: privacyRepository,
+	}
+}
+
+func (p *privacy) GetPrivacy(ctx context.Context, accountID int64) (*model.Privacy, error) {
+	log := p.container.GetLogger()
+	privacyEntity, err := p.privacyRepository.GetByAccountID(ctx, accountID)
+	if err != nil {
+		log.Error("fail to get privacy by account id", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	return accountConverter.ConvertEntity2PrivacyModel(privacyEntity), nil
+}
+
+func (p *privacy) UpdatePrivacy(ctx context.Context, accountID int64, privacy model.Privacy) error {
+	log := p.container.GetLogger()
+	var (
+		privacyEntity = entity.AccountPrivacy{
+			AccountID: accountID,
+		}
+		err error
+	)
+	fields := []struct {
+		visibility model.Visibility
+		target     *entity.Visibility
+	}{
+		{visibility: privacy.LastName, target: &privacyEntity.LastName},
+		{visibility: privacy.Location, target: &privacyEntity.Location},
+		{visibility: privacy.Company, target: &privacyEntity.Company},
+		{visibility: privacy.DocumentAttachment, target: &privacyEntity.DocumentAttachment},
+		{visibility: privacy.TelegramID, target: &privacyEntity.TelegramID},
+	}
+	for _, field := range fields {
+		*field.target, err = entity.VisibilityFromString(string(field.visibility))
+		if err != nil {
+			log.Error("unknown visibility from string", logger.FError(err))
+			return model.UnknownVisibilityError
+		}
+	}
+	if err := p.privacyRepository.Upsert(ctx, &privacyEntity); err != nil {
+		log.Error("fail to upsert privacy", logger.FError(err), logger.F("account_id", accountID))
+		return err
+	}
+	return nil
+}
+
+// applyPrivacy attaches the privacy settings of the account and the relation of the viewer to it,
+// so converters hide the fields the viewer is not allowed to see.
+func applyPrivacy(
+	ctx context.Context,
+	accountRepository accountRepository.Account,
+	privacyRepository accountRepository.Privacy,
+	viewerID int64,
+	account *model.Account,
+) error {
+	if viewerID == account.ID {
+		account.Audience = model.OwnerAudience
+		return nil
+	}
+	privacyEntity, err := privacyRepository.GetByAccountID(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+	matched, err := accountRepository.IsMatched(ctx, viewerID, account.ID)
+	if err != nil {
+		return err
+	}
+	account.Privacy = accountConverter.ConvertEntity2PrivacyModel(privacyEntity)
+	account.Audience = model.StrangerAudience
+	if matched {
+		account.Audience = model.MatchAudience
+	}
+	return nil
+}
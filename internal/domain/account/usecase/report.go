@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/container"
+	accountConverter "go-tonify-backend/internal/domain/account/converter"
+	"go-tonify-backend/internal/domain/account/model"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/internal/domain/filestorage"
+	commonModel "go-tonify-backend/internal/domain/model"
+	"go-tonify-backend/internal/domain/provider/transaction"
+	"go-tonify-backend/pkg/logger"
+	"strings"
+)
+
+type Report interface {
+	Create(ctx context.Context, createReport model.CreateReport) (*model.Report, error)
+	GetQueue(ctx context.Context, offset int64, limit int64) (*commonModel.Pagination[model.Report], error)
+	Resolve(ctx context.Context, staffAccountID int64, id int64) error
+	Dismiss(ctx context.Context, staffAccountID int64, id int64) error
+}
+
+type report struct {
+	container           container.Container
+	fileStorage         filestorage.FileStorage
+	transactionProvider *transaction.Provider
+	reportRepository    accountRepository.Report
+	accountRepository   accountRepository.Account
+}
+
+func NewReport(
+	container container.Container,
+	fileStorage filestorage.FileStorage,
+	transactionProvider *transaction.Provider,
+	reportRepository accountRepository.Report,
+	accountRepository accountRepository.Account,
+) Report {
+	return &report{
+		container:           container,
+		fileStorage:         fileStorage,
+		transactionProvider: transactionProvider,
+		reportRepository:    reportRepository,
+		accountRepository:   accountRepository,
+	}
+}
+
+// Create puts the report in the moderation queue, the other reason needs a description.
+func (r *report) Create(ctx context.Context, createReport model.CreateReport) (*model.Report, error) {
+	log := r.container.GetLogger()
+	if createReport.ReporterID == createReport.ReportedID {
+		return nil, model.InvalidReportError
+	}
+	reason, err := accountConverter.ConvertReportReasonModel2Entity(createReport.Reason)
+	if err != nil {
+		log.Error("fail to parse report reason", logger.FError(err))
+		return nil, model.InvalidReportError
+	}
+	var description *string
+	if createReport.Description != nil {
+		if trimmed := strings.TrimSpace(*createReport.Description); len(trimmed) > 0 {
+			description = &trimmed
+		}
+	}
+	if reason == entity.OtherReportReason && description == nil {
+		return nil, model.InvalidReportError
+	}
+	if _, err := r.accountRepository.GetStatusByID(ctx, createReport.ReportedID); err != nil {
+		log.Error("fail to get account status by id", logger.FError(err), logger.F("account_id", createReport.ReportedID))
+		switch err {
+		case sql.ErrNoRows:
+			return nil, model.EntityNotFoundError
+		default:
+			return nil, err
+		}
+	}
+	var attachmentEntity *entity.Attachment
+	if createReport.FileHeader != nil {
+		attachmentEntity, err = uploadAndPrepareAttachmentEntity(log, r.fileStorage, createReport.FileHeader)
+		if err != nil {
+			log.Error("fail to upload and prepare a report attachment entity", logger.FError(err))
+			return nil, err
+		}
+	}
+	var reportID *int64
+	err = r.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		reportEntity := entity.Report{
+			ReporterID:  &createReport.ReporterID,
+			ReportedID:  createReport.ReportedID,
+			Reason:      reason,
+			Description: description,
+		}
+		if attachmentEntity != nil {
+			attachmentID, err := composed.Attachment.Create(ctx, attachmentEntity)
+			if err != nil {
+				log.Error("fail to record report attachment to db", logger.FError(err))
+				return err
+			}
+			reportEntity.AttachmentID = attachmentID
+		}
+		reportID, err = composed.Report.Create(ctx, &reportEntity)
+		if err != nil {
+			log.Error("fail to record report to db", logger.FError(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while creating report", logger.FError(err))
+		if attachmentEntity != nil {
+			if err := r.fileStorage.DeleteFile(attachmentEntity.FileName); err != nil {
+				log.Error("fail to delete attachment from file storage", logger.FError(err))
+			}
+		}
+		return nil, err
+	}
+	if reportID == nil {
+		log.Error("reportID contains nil value")
+		return nil, model.NilError
+	}
+	reportEntity, err := r.reportRepository.GetByID(ctx, *reportID)
+	if err != nil {
+		log.Error("fail to get report by id", logger.FError(err), logger.F("report_id", *reportID))
+		return nil, err
+	}
+	log.Info(
+		"account reported",
+		logger.F("report_id", reportEntity.ID),
+		logger.F("account_id", reportEntity.ReportedID),
+		logger.F("reason", reportEntity.Reason.String()),
+	)
+	return accountConverter.ConvertEntity2ReportModel(reportEntity), nil
+}
+
+// GetQueue returns the pending reports with their attachments, the oldest report first.
+func (r *report) GetQueue(ctx context.Context, offset int64, limit int64) (*commonModel.Pagination[model.Report], error) {
+	log := r.container.GetLogger()
+	numberOfReports, err := r.reportRepository.CountByStatus(ctx, entity.PendingReportStatus)
+	if err != nil {
+		log.Error("fail to count pending reports", logger.FError(err))
+		return nil, err
+	}
+	reportEntities, err := r.reportRepository.GetListByStatus(ctx, entity.PendingReportStatus, offset, limit)
+	if err != nil {
+		log.Error("fail to get pending reports", logger.FError(err))
+		return nil, err
+	}
+	pagination := commonModel.Pagination[model.Report]{
+		Offset: offset,
+		Limit:  limit,
+		Total:  numberOfReports,
+		Data:   accountConverter.ConvertEntities2ReportModels(reportEntities),
+	}
+	return &pagination, nil
+}
+
+func (r *report) Resolve(ctx context.Context, staffAccountID int64, id int64) error {
+	return r.review(ctx, staffAccountID, id, entity.ResolvedReportStatus)
+}
+
+func (r *report) Dismiss(ctx context.Context, staffAccountID int64, id int64) error {
+	return r.review(ctx, staffAccountID, id, entity.DismissedReportStatus)
+}
+
+func (r *report) review(ctx context.Context, staffAccountID int64, id int64, status entity.ReportStatus) error {
+	log := r.container.GetLogger()
+	reviewed, err := r.reportRepository.Review(ctx, id, staffAccountID, status)
+	if err != nil {
+		log.Error("fail to review report", logger.FError(err), logger.F("report_id", id))
+		return err
+	}
+	if !reviewed {
+		if _, err := r.reportRepository.GetByID(ctx, id); err != nil {
+			log.Error("fail to get report by id", logger.FError(err), logger.F("report_id", id))
+			switch err {
+			case sql.ErrNoRows:
+				return model.EntityNotFoundError
+			default:
+				return err
+			}
+		}
+		return model.ReportNotPendingError
+	}
+	log.Info(
+		"report reviewed",
+		logger.F("report_id", id),
+		logger.F("staff_account_id", staffAccountID),
+		logger.F("status", status.String()),
+	)
+	return nil
+}
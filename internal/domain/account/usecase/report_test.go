@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/domain/account/model"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	"go-tonify-backend/internal/domain/entity"
+	"testing"
+)
+
+const testMissingAccountID int64 = 404
+
+type fakeReportAccountRepository struct {
+	accountRepository.Account
+}
+
+func (f *fakeReportAccountRepository) GetStatusByID(ctx context.Context, id int64) (*entity.Account, error) {
+	if id == testMissingAccountID {
+		return nil, sql.ErrNoRows
+	}
+	return &entity.Account{ID: id, Status: entity.ActiveAccountStatus}, nil
+}
+
+func TestCreateReportInvalid(t *testing.T) {
+	blank := "   "
+	tests := []struct {
+		name         string
+		createReport model.CreateReport
+		expectedErr  error
+	}{
+		{
+			name:         "own account",
+			createReport: model.CreateReport{ReporterID: 1, ReportedID: 1, Reason: model.SpamReportReason},
+			expectedErr:  model.InvalidReportError,
+		},
+		{
+			name:         "unknown reason",
+			createReport: model.CreateReport{ReporterID: 1, ReportedID: 2, Reason: "rude"},
+			expectedErr:  model.InvalidReportError,
+		},
+		{
+			name:         "other reason without description",
+			createReport: model.CreateReport{ReporterID: 1, ReportedID: 2, Reason: model.OtherReportReason},
+			expectedErr:  model.InvalidReportError,
+		},
+		{
+			name:         "other reason with blank description",
+			createReport: model.CreateReport{ReporterID: 1, ReportedID: 2, Reason: model.OtherReportReason, Description: &blank},
+			expectedErr:  model.InvalidReportError,
+		},
+		{
+			name:         "missing account",
+			createReport: model.CreateReport{ReporterID: 1, ReportedID: testMissingAccountID, Reason: model.ScamReportReason},
+			expectedErr:  model.EntityNotFoundError,
+		},
+	}
+	reportUsecase := NewReport(&fakeContainer{}, nil, nil, nil, &fakeReportAccountRepository{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := reportUsecase.Create(context.Background(), tt.createReport); err != tt.expectedErr {
+				t.Errorf("expected %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestBlockInvalid(t *testing.T) {
+	matchUsecase := newTestMatch(&fakeReportAccountRepository{}, nil)
+	if err := matchUsecase.Block(context.Background(), testViewerID, testViewerID); err != model.InvalidBlockError {
+		t.Errorf("own account: expected %v, got %v", model.InvalidBlockError, err)
+	}
+	if err := matchUsecase.Block(context.Background(), testViewerID, testMissingAccountID); err != model.EntityNotFoundError {
+		t.Errorf("missing account: expected %v, got %v", model.EntityNotFoundError, err)
+	}
+}
@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/container"
+	accountConverter "go-tonify-backend/internal/domain/account/converter"
+	"go-tonify-backend/internal/domain/account/model"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	"go-tonify-backend/internal/domain/entity"
+	commonModel "go-tonify-backend/internal/domain/model"
+	"go-tonify-backend/internal/domain/provider/transaction"
+	staffRepository "go-tonify-backend/internal/domain/staff/repository"
+	"go-tonify-backend/pkg/logger"
+	"strings"
+	"time"
+)
+
+type Sanction interface {
+	Suspend(ctx context.Context, staffAccountID int64, accountID int64, until time.Time, reason string) error
+	Ban(ctx context.Context, staffAccountID int64, accountID int64, reason string) error
+	Lift(ctx context.Context, staffAccountID int64, accountID int64, reason string) error
+	GetSanctions(ctx context.Context, accountID int64, offset int64, limit int64) (*commonModel.Pagination[model.Sanction], error)
+}
+
+type sanction struct {
+	container           container.Container
+	transactionProvider *transaction.Provider
+	sanctionRepository  accountRepository.Sanction
+	staffRepository     staffRepository.Staff
+}
+
+func NewSanction(
+	container container.Container,
+	transactionProvider *transaction.Provider,
+	sanctionRepository accountRepository.Sanction,
+	staffRepository staffRepository.Staff,
+) Sanction {
+	return &sanction{
+		container:           container,
+		transactionProvider: transactionProvider,
+		sanctionRepository:  sanctionRepository,
+		staffRepository:     staffRepository,
+	}
+}
+
+func (s *sanction) Suspend(ctx context.Context, staffAccountID int64, accountID int64, until time.Time, reason string) error {
+	if !until.After(time.Now()) {
+		return model.InvalidSanctionError
+	}
+	return s.apply(ctx, staffAccountID, accountID, model.SuspendSanctionAction, &until, reason)
+}
+
+func (s *sanction) Ban(ctx context.Context, staffAccountID int64, accountID int64, reason string) error {
+	return s.apply(ctx, staffAccountID, accountID, model.BanSanctionAction, nil, reason)
+}
+
+func (s *sanction) Lift(ctx context.Context, staffAccountID int64, accountID int64, reason string) error {
+	return s.apply(ctx, staffAccountID, accountID, model.LiftSanctionAction, nil, reason)
+}
+
+func (s *sanction) GetSanctions(ctx context.Context, accountID int64, offset int64, limit int64) (*commonModel.Pagination[model.Sanction], error) {
+	log := s.container.GetLogger()
+	total, err := s.sanctionRepository.CountByAccountID(ctx, accountID)
+	if err != nil {
+		log.Error("fail to count account sanctions", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	sanctionEntities, err := s.sanctionRepository.GetByAccountID(ctx, accountID, offset, limit)
+	if err != nil {
+		log.Error("fail to get account sanctions", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	pagination := commonModel.Pagination[model.Sanction]{
+		Offset: offset,
+		Limit:  limit,
+		Total:  total,
+		Data:   accountConverter.ConvertEntities2SanctionModels(sanctionEntities),
+	}
+	return &pagination, nil
+}
+
+// apply changes the account status and records the sanction in one transaction,
+// so the audit trail always matches the current status.
+func (s *sanction) apply(
+	ctx context.Context,
+	staffAccountID int64,
+	accountID int64,
+	action model.SanctionAction,
+	until *time.Time,
+	reason string,
+) error {
+	log := s.container.GetLogger()
+	reason = strings.TrimSpace(reason)
+	if len(reason) == 0 || staffAccountID == accountID {
+		return model.InvalidSanctionError
+	}
+	var status entity.AccountStatus
+	switch action {
+	case model.SuspendSanctionAction:
+		status = entity.SuspendedAccountStatus
+	case model.BanSanctionAction:
+		status = entity.BannedAccountStatus
+	case model.LiftSanctionAction:
+		status = entity.ActiveAccountStatus
+	default:
+		return model.InvalidSanctionError
+	}
+	if err := s.checkStaffRank(ctx, staffAccountID, accountID); err != nil {
+		return err
+	}
+	err := s.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		accountEntity, err := composed.Account.GetStatusByID(ctx, accountID)
+		if err != nil {
+			log.Error("fail to get account status by id", logger.FError(err))
+			switch err {
+			case sql.ErrNoRows:
+				return model.EntityNotFoundError
+			default:
+				return err
+			}
+		}
+		if action == model.LiftSanctionAction && !accountEntity.IsRestricted(time.Now()) {
+			return model.InvalidSanctionError
+		}
+		accountEntity.Status = status
+		accountEntity.SuspendedUntil = until
+		accountEntity.StatusReason = nil
+		if action != model.LiftSanctionAction {
+			accountEntity.StatusReason = &reason
+		}
+		if err := composed.Account.UpdateStatus(ctx, accountEntity); err != nil {
+			log.Error("fail to update account status", logger.FError(err))
+			return err
+		}
+		sanctionEntity := entity.AccountSanction{
+			AccountID:      accountID,
+			StaffAccountID: &staffAccountID,
+			Action:         string(action),
+			Reason:         reason,
+			SuspendedUntil: until,
+		}
+		if _, err := composed.Sanction.Create(ctx, &sanctionEntity); err != nil {
+			log.Error("fail to record account sanction", logger.FError(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error(
+			"fail to apply account sanction",
+			logger.FError(err),
+			logger.F("account_id", accountID),
+			logger.F("action", action),
+		)
+		return err
+	}
+	log.Info(
+		"account sanction applied",
+		logger.F("account_id", accountID),
+		logger.F("staff_account_id", staffAccountID),
+		logger.F("action", action),
+	)
+	return nil
+}
+
+// checkStaffRank keeps staff from sanctioning staff of equal or higher rank,
+// so a moderator can't ban another moderator or an admin.
+func (s *sanction) checkStaffRank(ctx context.Context, staffAccountID int64, accountID int64) error {
+	log := s.container.GetLogger()
+	accountRoles, err := s.staffRepository.GetRolesByAccountID(ctx, accountID)
+	if err != nil {
+		log.Error("fail to get account staff roles", logger.FError(err), logger.F("account_id", accountID))
+		return err
+	}
+	accountRank := entity.StaffRank(accountRoles)
+	if accountRank == 0 {
+		return nil
+	}
+	staffRoles, err := s.staffRepository.GetRolesByAccountID(ctx, staffAccountID)
+	if err != nil {
+		log.Error("fail to get staff roles", logger.FError(err), logger.F("staff_account_id", staffAccountID))
+		return err
+	}
+	if accountRank >= entity.StaffRank(staffRoles) {
+		return model.SanctionStaffRankError
+	}
+	return nil
+}
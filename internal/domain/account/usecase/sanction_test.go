@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"go-tonify-backend/internal/container"
+	"go-tonify-backend/internal/domain/account/model"
+	"go-tonify-backend/internal/domain/entity"
+	staffRepository "go-tonify-backend/internal/domain/staff/repository"
+	"go-tonify-backend/pkg/logger"
+	"testing"
+	"time"
+)
+
+const (
+	testStaffAccountID int64 = 1
+	testAccountID      int64 = 2
+)
+
+type fakeContainer struct {
+	container.Container
+}
+
+func (f *fakeContainer) GetLogger() logger.Logger {
+	return logger.NewLogger(logger.DEV, logger.LevelFatal)
+}
+
+type fakeStaffRepository struct {
+	staffRepository.Staff
+	roles map[int64][]entity.StaffRole
+}
+
+func (f *fakeStaffRepository) GetRolesByAccountID(ctx context.Context, accountID int64) ([]entity.StaffRole, error) {
+	return f.roles[accountID], nil
+}
+
+func TestSanctionStaffRank(t *testing.T) {
+	admin := entity.StaffRole{ID: 1, Name: "admin"}
+	moderator := entity.StaffRole{ID: 2, Name: "moderator"}
+	testCases := []struct {
+		name         string
+		staffRoles   []entity.StaffRole
+		accountRoles []entity.StaffRole
+	}{
+		{
+			name:         "moderator sanctions moderator",
+			staffRoles:   []entity.StaffRole{moderator},
+			accountRoles: []entity.StaffRole{moderator},
+		},
+		{
+			name:         "moderator sanctions admin",
+			staffRoles:   []entity.StaffRole{moderator},
+			accountRoles: []entity.StaffRole{admin},
+		},
+		{
+			name:         "moderator sanctions admin holding moderator role",
+			staffRoles:   []entity.StaffRole{moderator},
+			accountRoles: []entity.StaffRole{moderator, admin},
+		},
+		{
+			name:         "admin sanctions admin",
+			staffRoles:   []entity.StaffRole{admin},
+			accountRoles: []entity.StaffRole{admin},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			sanctionUsecase := NewSanction(&fakeContainer{}, nil, nil, &fakeStaffRepository{
+				roles: map[int64][]entity.StaffRole{
+					testStaffAccountID: testCase.staffRoles,
+					testAccountID:      testCase.accountRoles,
+				},
+			})
+			err := sanctionUsecase.Suspend(context.Background(), testStaffAccountID, testAccountID, time.Now().Add(time.Hour), "spam")
+			if err != model.SanctionStaffRankError {
+				t.Errorf("suspend: expected %v, got %v", model.SanctionStaffRankError, err)
+			}
+			err = sanctionUsecase.Ban(context.Background(), testStaffAccountID, testAccountID, "spam")
+			if err != model.SanctionStaffRankError {
+				t.Errorf("ban: expected %v, got %v", model.SanctionStaffRankError, err)
+			}
+			err = sanctionUsecase.Lift(context.Background(), testStaffAccountID, testAccountID, "appeal")
+			if err != model.SanctionStaffRankError {
+				t.Errorf("lift: expected %v, got %v", model.SanctionStaffRankError, err)
+			}
+		})
+	}
+}
+
+func TestSanctionStaffRankAllowsLowerRank(t *testing.T) {
+	admin := entity.StaffRole{ID: 1, Name: "admin"}
+	moderator := entity.StaffRole{ID: 2, Name: "moderator"}
+	testCases := []struct {
+		name         string
+		staffRoles   []entity.StaffRole
+		accountRoles []entity.StaffRole
+	}{
+		{
+			name:       "moderator sanctions regular account",
+			staffRoles: []entity.StaffRole{moderator},
+		},
+		{
+			name:         "admin sanctions moderator",
+			staffRoles:   []entity.StaffRole{admin},
+			accountRoles: []entity.StaffRole{moderator},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			sanctionUsecase := &sanction{
+				container: &fakeContainer{},
+				staffRepository: &fakeStaffRepository{
+					roles: map[int64][]entity.StaffRole{
+						testStaffAccountID: testCase.staffRoles,
+						testAccountID:      testCase.accountRoles,
+					},
+				},
+			}
+			if err := sanctionUsecase.checkStaffRank(context.Background(), testStaffAccountID, testAccountID); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSanctionInvalid(t *testing.T) {
+	sanctionUsecase := NewSanction(&fakeContainer{}, nil, nil, &fakeStaffRepository{})
+	if err := sanctionUsecase.Ban(context.Background(), testStaffAccountID, testAccountID, "  "); err != model.InvalidSanctionError {
+		t.Errorf("blank reason: expected %v, got %v", model.InvalidSanctionError, err)
+	}
+	if err := sanctionUsecase.Ban(context.Background(), testStaffAccountID, testStaffAccountID, "spam"); err != model.InvalidSanctionError {
+		t.Errorf("own account: expected %v, got %v", model.InvalidSanctionError, err)
+	}
+	err := sanctionUsecase.Suspend(context.Background(), testStaffAccountID, testAccountID, time.Now().Add(-time.Hour), "spam")
+	if err != model.InvalidSanctionError {
+		t.Errorf("past suspension: expected %v, got %v", model.InvalidSanctionError, err)
+	}
+}
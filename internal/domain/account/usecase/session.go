@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/container"
+	accountConverter "go-tonify-backend/internal/domain/account/converter"
+	"go-tonify-backend/internal/domain/account/model"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	"go-tonify-backend/internal/domain/provider/transaction"
+	"go-tonify-backend/pkg/logger"
+)
+
+type Session interface {
+	GetSessions(ctx context.Context, authorization model.Authorization) ([]model.Session, error)
+	RevokeSession(ctx context.Context, accountID int64, sessionID string) error
+}
+
+type session struct {
+	container           container.Container
+	transactionProvider *transaction.Provider
+	sessionRepository   accountRepository.Session
+}
+
+func NewSession(
+	container container.Container,
+	transactionProvider *transaction.Provider,
+	sessionRepository accountRepository.Session,
+) Session {
+	return &session{
+		container:           container,
+		transactionProvider: transactionProvider,
+		sessionRepository:   sessionRepository,
+	}
+}
+
+func (s *session) GetSessions(ctx context.Context, authorization model.Authorization) ([]model.Session, error) {
+	log := s.container.GetLogger()
+	sessionEntities, err := s.sessionRepository.GetActiveByAccountID(ctx, authorization.AccountID)
+	if err != nil {
+		log.Error("fail to get active sessions", logger.FError(err), logger.F("account_id", authorization.AccountID))
+		return nil, err
+	}
+	sessions := make([]model.Session, 0, len(sessionEntities))
+	for _, sessionEntity := range sessionEntities {
+		sessionModel := accountConverter.ConvertEntity2SessionModel(&sessionEntity)
+		sessionModel.Current = sessionModel.ID == authorization.SessionID
+		sessions = append(sessions, *sessionModel)
+	}
+	return sessions, nil
+}
+
+// RevokeSession ends the session together with every refresh token issued for it,
+// access tokens of the session are rejected by Authorize from now on.
+func (s *session) RevokeSession(ctx context.Context, accountID int64, sessionID string) error {
+	log := s.container.GetLogger()
+	err := s.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		sessionEntity, err := composed.Session.GetByID(ctx, sessionID)
+		if err != nil {
+			log.Error("fail to get session by id", logger.FError(err))
+			switch err {
+			case sql.ErrNoRows:
+				return model.EntityNotFoundError
+			default:
+				return err
+			}
+		}
+		if sessionEntity.AccountID != accountID {
+			log.Error(
+				"session belongs to another account",
+				logger.F("account_id", accountID),
+				logger.F("session_id", sessionID),
+			)
+			return model.EntityNotFoundError
+		}
+		if err := composed.Session.Revoke(ctx, sessionID); err != nil {
+			log.Error("fail to revoke session", logger.FError(err))
+			return err
+		}
+		if err := composed.RefreshToken.RevokeFamily(ctx, sessionID); err != nil {
+			log.Error("fail to revoke refresh token family", logger.FError(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while revoking session", logger.FError(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/container"
+	accountConverter "go-tonify-backend/internal/domain/account/converter"
+	"go-tonify-backend/internal/domain/account/model"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	"go-tonify-backend/internal/domain/entity"
+	commonModel "go-tonify-backend/internal/domain/model"
+	"go-tonify-backend/pkg/logger"
+	"strings"
+)
+
+type Verification interface {
+	GetQueue(ctx context.Context, offset int64, limit int64) (*commonModel.Pagination[model.Account], error)
+	Approve(ctx context.Context, staffAccountID int64, accountID int64) error
+	Reject(ctx context.Context, staffAccountID int64, accountID int64, reason string) error
+}
+
+type verification struct {
+	container         container.Container
+	accountRepository accountRepository.Account
+}
+
+func NewVerification(
+	container container.Container,
+	accountRepository accountRepository.Account,
+) Verification {
+	return &verification{
+		container:         container,
+		accountRepository: accountRepository,
+	}
+}
+
+// GetQueue returns the accounts waiting for a review with their identity documents, the oldest submission first.
+func (v *verification) GetQueue(ctx context.Context, offset int64, limit int64) (*commonModel.Pagination[model.Account], error) {
+	log := v.container.GetLogger()
+	numberOfAccounts, err := v.accountRepository.GetNumberPendingVerifications(ctx)
+	if err != nil {
+		log.Error("fail to get number of pending verifications", logger.FError(err))
+		return nil, err
+	}
+	if numberOfAccounts == nil {
+		log.Error("number_of_accounts has nil value")
+		return nil, model.NilError
+	}
+	accountIDs, err := v.accountRepository.GetPendingVerificationIDs(ctx, offset, limit)
+	if err != nil {
+		log.Error("fail to get pending verifications", logger.FError(err))
+		return nil, err
+	}
+	accounts := make([]model.Account, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		accountEntity, err := v.accountRepository.GetFullDetailByID(ctx, accountID)
+		if err != nil {
+			log.Error("fail to get account by id", logger.FError(err), logger.F("account_id", accountID))
+			return nil, err
+		}
+		accountModel := accountConverter.ConvertEntity2AccountModel(accountEntity)
+		accountModel.Audience = model.StaffAudience
+		accounts = append(accounts, *accountModel)
+	}
+	pagination := commonModel.Pagination[model.Account]{
+		Offset: offset,
+		Limit:  limit,
+		Total:  *numberOfAccounts,
+		Data:   accounts,
+	}
+	return &pagination, nil
+}
+
+func (v *verification) Approve(ctx context.Context, staffAccountID int64, accountID int64) error {
+	return v.review(ctx, staffAccountID, accountID, entity.VerifiedVerificationStatus, nil)
+}
+
+func (v *verification) Reject(ctx context.Context, staffAccountID int64, accountID int64, reason string) error {
+	reason = strings.TrimSpace(reason)
+	if len(reason) == 0 {
+		return model.InvalidVerificationReviewError
+	}
+	return v.review(ctx, staffAccountID, accountID, entity.RejectedVerificationStatus, &reason)
+}
+
+func (v *verification) review(
+	ctx context.Context,
+	staffAccountID int64,
+	accountID int64,
+	status entity.VerificationStatus,
+	reason *string,
+) error {
+	log := v.container.GetLogger()
+	if staffAccountID == accountID {
+		return model.InvalidVerificationReviewError
+	}
+	reviewed, err := v.accountRepository.ReviewVerification(ctx, accountID, staffAccountID, status, reason)
+	if err != nil {
+		log.Error("fail to review account verification", logger.FError(err), logger.F("account_id", accountID))
+		return err
+	}
+	if !reviewed {
+		if _, err := v.accountRepository.GetStatusByID(ctx, accountID); err != nil {
+			log.Error("fail to get account status by id", logger.FError(err), logger.F("account_id", accountID))
+			switch err {
+			case sql.ErrNoRows:
+				return model.EntityNotFoundError
+			default:
+				return err
+			}
+		}
+		return model.VerificationNotPendingError
+	}
+	log.Info(
+		"account verification reviewed",
+		logger.F("account_id", accountID),
+		logger.F("staff_account_id", staffAccountID),
+		logger.F("status", status.String()),
+	)
+	return nil
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"go-tonify-backend/internal/container"
+	"go-tonify-backend/internal/domain/account/model"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/internal/domain/provider/transaction"
+	"go-tonify-backend/pkg/logger"
+	"go-tonify-backend/pkg/psql"
+	tonModel "go-tonify-backend/pkg/ton/model"
+	"time"
+)
+
+const walletNoncePayloadSize = 32
+
+type Wallet interface {
+	IssueNonce(ctx context.Context, accountID int64) (*model.WalletNonce, error)
+	LinkWallet(ctx context.Context, linkWallet model.LinkWallet) (*string, error)
+	UnlinkWallet(ctx context.Context, accountID int64) error
+}
+
+type wallet struct {
+	container           container.Container
+	transactionProvider *transaction.Provider
+	walletRepository    accountRepository.Wallet
+	accountRepository   accountRepository.Account
+}
+
+func NewWallet(
+	container container.Container,
+	transactionProvider *transaction.Provider,
+	walletRepository accountRepository.Wallet,
+	accountRepository accountRepository.Account,
+) Wallet {
+	return &wallet{
+		container:           container,
+		transactionProvider: transactionProvider,
+		walletRepository:    walletRepository,
+		accountRepository:   accountRepository,
+	}
+}
+
+// IssueNonce generates the payload the wallet has to sign in its ton_proof, the payload can be used once.
+func (w *wallet) IssueNonce(ctx context.Context, accountID int64) (*model.WalletNonce, error) {
+	log := w.container.GetLogger()
+	payload := make([]byte, walletNoncePayloadSize)
+	if _, err := rand.Read(payload); err != nil {
+		log.Error("fail to generate wallet nonce payload", logger.FError(err))
+		return nil, err
+	}
+	nonceEntity := entity.WalletNonce{
+		AccountID: accountID,
+		Payload:   hex.EncodeToString(payload),
+		ExpiresAt: time.Now().Add(w.container.GetTonConnectConfig().NonceTTL),
+	}
+	if _, err := w.walletRepository.CreateNonce(ctx, &nonceEntity); err != nil {
+		log.Error("fail to record wallet nonce to db", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	return &model.WalletNonce{
+		Payload:   nonceEntity.Payload,
+		ExpiresAt: nonceEntity.ExpiresAt,
+	}, nil
+}
+
+// LinkWallet verifies the ton_proof, consumes its nonce and stores the address of the wallet on the account.
+func (w *wallet) LinkWallet(ctx context.Context, linkWallet model.LinkWallet) (*string, error) {
+	log := w.container.GetLogger()
+	proofVerifier := w.container.GetTonProofVerifier()
+	address, err := proofVerifier.Verify(tonModel.Proof{
+		Address: linkWallet.Address,
+		Domain: tonModel.ProofDomain{
+			LengthBytes: linkWallet.Proof.DomainLengthBytes,
+			Value:       linkWallet.Proof.DomainValue,
+		},
+		Timestamp: linkWallet.Proof.Timestamp,
+		Payload:   linkWallet.Proof.Payload,
+		Signature: linkWallet.Proof.Signature,
+		StateInit: linkWallet.Proof.StateInit,
+	})
+	if err != nil {
+		log.Error("fail to verify ton proof", logger.FError(err), logger.F("account_id", linkWallet.AccountID))
+		return nil, model.InvalidWalletProofError
+	}
+	walletAddress := address.String()
+	err = w.transactionProvider.Transact(func(composed transaction.ComposedRepository) error {
+		consumed, err := composed.Wallet.ConsumeNonce(ctx, linkWallet.AccountID, linkWallet.Proof.Payload, time.Now())
+		if err != nil {
+			log.Error("fail to consume wallet nonce", logger.FError(err))
+			return err
+		}
+		if !consumed {
+			log.Error("wallet nonce is unknown, used or expired", logger.F("account_id", linkWallet.AccountID))
+			return model.InvalidWalletProofError
+		}
+		if err := composed.Account.UpdateWalletAddress(ctx, linkWallet.AccountID, &walletAddress); err != nil {
+			log.Error("fail to update wallet address", logger.FError(err))
+			if psql.IsUniqueViolation(err, accountRepository.WalletAddressUniqueIndex) {
+				return model.WalletAlreadyLinkedError
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("fail to perform transaction while linking wallet", logger.FError(err))
+		return nil, err
+	}
+	return &walletAddress, nil
+}
+
+func (w *wallet) UnlinkWallet(ctx context.Context, accountID int64) error {
+	log := w.container.GetLogger()
+	if err := w.accountRepository.UpdateWalletAddress(ctx, accountID, nil); err != nil {
+		log.Error("fail to unlink wallet", logger.FError(err), logger.F("account_id", accountID))
+		return err
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ type Category interface {
 	GetCategoriesByAccountID(ctx context.Context, accountID int64) ([]entity.Category, error)
 	GetCategoriesByTaskID(ctx context.Context, taskID int64) ([]entity.Category, error)
 	AddCategoryToAccount(ctx context.Context, categoryID int64, accountID int64) error
+	RemoveCategoryFromAccount(ctx context.Context, categoryID int64, accountID int64) error
 	DeleteCategoriesFromAccount(ctx context.Context, accountID int64) error
 	DeleteCategoriesFromTask(ctx context.Context, taskID int64) error
 	AddCategoryToTask(ctx context.Context, categoryID int64, taskID int64) error
@@ -69,6 +70,12 @@ func (c *category) GetCategoriesByTaskID(ctx context.Context, taskID int64) ([]e
 	return categories, nil
 }
 
+func (c *category) RemoveCategoryFromAccount(ctx context.Context, categoryID int64, accountID int64) error {
+	query := "DELETE FROM account_category WHERE category_id = $1 AND account_id = $2;"
+	_, err := c.conn.ExecContext(ctx, query, categoryID, accountID)
+	return err
+}
+
 func (c *category) DeleteCategoriesFromAccount(ctx context.Context, accountID int64) error {
 	query := "DELETE FROM account_category WHERE account_id = $1"
 	_, err := c.conn.ExecContext(ctx, query, accountID)
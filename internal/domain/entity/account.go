@@ -22,6 +22,12 @@ type Account struct {
 	AvatarAttachment     *Attachment
 	DocumentAttachmentID *int64
 	DocumentAttachment   *Attachment
+	Status               AccountStatus
+	SuspendedUntil       *time.Time
+	StatusReason         *string
+	VerificationStatus   VerificationStatus
+	VerificationReason   *string
+	WalletAddress        *string
 	CreatedAt            *time.Time
 	UpdatedAt            *time.Time
 	DeletedAt            *time.Time
@@ -30,3 +36,15 @@ type Account struct {
 func (a *Account) HasCompany() bool {
 	return a.CompanyID != nil && a.Company != nil && a.Company.Name != ""
 }
+
+// IsRestricted reports whether the account is banned or its suspension is still in effect.
+func (a *Account) IsRestricted(now time.Time) bool {
+	switch a.Status {
+	case BannedAccountStatus:
+		return true
+	case SuspendedAccountStatus:
+		return a.SuspendedUntil == nil || a.SuspendedUntil.After(now)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type AccountAudit struct {
+	ID             int64
+	AccountID      int64
+	ActorAccountID *int64
+	Action         string
+	Diff           []byte
+	SourceIP       *string
+	CreatedAt      *time.Time
+}
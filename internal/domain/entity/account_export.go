@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+type ExportStatus struct {
+	value string
+}
+
+var (
+	UnknownExportStatus    = ExportStatus{value: "unknown"}
+	PendingExportStatus    = ExportStatus{value: "pending"}
+	ProcessingExportStatus = ExportStatus{value: "processing"}
+	ReadyExportStatus      = ExportStatus{value: "ready"}
+	FailedExportStatus     = ExportStatus{value: "failed"}
+	ExpiredExportStatus    = ExportStatus{value: "expired"}
+)
+
+func ExportStatusFromString(text string) (ExportStatus, error) {
+	switch text {
+	case PendingExportStatus.value:
+		return PendingExportStatus, nil
+	case ProcessingExportStatus.value:
+		return ProcessingExportStatus, nil
+	case ReadyExportStatus.value:
+		return ReadyExportStatus, nil
+	case FailedExportStatus.value:
+		return FailedExportStatus, nil
+	case ExpiredExportStatus.value:
+		return ExpiredExportStatus, nil
+	default:
+		return UnknownExportStatus, UnknownValueError
+	}
+}
+
+func (s ExportStatus) String() string {
+	return s.value
+}
+
+type AccountExport struct {
+	ID            int64
+	AccountID     int64
+	Status        ExportStatus
+	FileName      *string
+	FailureReason *string
+	CreatedAt     *time.Time
+	UpdatedAt     *time.Time
+	CompletedAt   *time.Time
+	ExpiresAt     *time.Time
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+type AccountPrivacy struct {
+	AccountID          int64
+	LastName           Visibility
+	Location           Visibility
+	Company            Visibility
+	DocumentAttachment Visibility
+	TelegramID         Visibility
+	UpdatedAt          *time.Time
+}
+
+// DefaultAccountPrivacy matches the column defaults of account_privacy,
+// it applies to accounts that never changed their settings.
+func DefaultAccountPrivacy(accountID int64) AccountPrivacy {
+	return AccountPrivacy{
+		AccountID:          accountID,
+		LastName:           EveryoneVisibility,
+		Location:           EveryoneVisibility,
+		Company:            EveryoneVisibility,
+		DocumentAttachment: MatchesVisibility,
+		TelegramID:         MatchesVisibility,
+	}
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type AccountSanction struct {
+	ID             int64
+	AccountID      int64
+	StaffAccountID *int64
+	Action         string
+	Reason         string
+	SuspendedUntil *time.Time
+	CreatedAt      *time.Time
+}
@@ -0,0 +1,43 @@
+package entity
+
+type AccountSort struct {
+	value string
+}
+
+var (
+	UnknownAccountSort   = AccountSort{value: "unknown"}
+	RelevanceAccountSort = AccountSort{value: "relevance"}
+	NewestAccountSort    = AccountSort{value: "newest"}
+	OldestAccountSort    = AccountSort{value: "oldest"}
+)
+
+func AccountSortFromString(text string) (AccountSort, error) {
+	switch text {
+	case RelevanceAccountSort.value:
+		return RelevanceAccountSort, nil
+	case NewestAccountSort.value:
+		return NewestAccountSort, nil
+	case OldestAccountSort.value:
+		return OldestAccountSort, nil
+	default:
+		return UnknownAccountSort, UnknownValueError
+	}
+}
+
+func (s AccountSort) String() string {
+	return s.value
+}
+
+// AccountSearchFilter narrows the search, nil and empty fields don't filter.
+type AccountSearchFilter struct {
+	// ViewerID hides the accounts the viewer has blocked or has been blocked by.
+	ViewerID    *int64
+	Query       *string
+	Role        *Role
+	Tags        []string
+	CategoryIDs []int64
+	Country     *string
+	Gender      *Gender
+	HasCompany  *bool
+	Sort        AccountSort
+}
@@ -0,0 +1,29 @@
+package entity
+
+type AccountStatus struct {
+	value string
+}
+
+var (
+	UnknownAccountStatus   = AccountStatus{value: "unknown"}
+	ActiveAccountStatus    = AccountStatus{value: "active"}
+	SuspendedAccountStatus = AccountStatus{value: "suspended"}
+	BannedAccountStatus    = AccountStatus{value: "banned"}
+)
+
+func AccountStatusFromString(text string) (AccountStatus, error) {
+	switch text {
+	case ActiveAccountStatus.value:
+		return ActiveAccountStatus, nil
+	case SuspendedAccountStatus.value:
+		return SuspendedAccountStatus, nil
+	case BannedAccountStatus.value:
+		return BannedAccountStatus, nil
+	default:
+		return UnknownAccountStatus, UnknownValueError
+	}
+}
+
+func (s AccountStatus) String() string {
+	return s.value
+}
@@ -0,0 +1,10 @@
+package entity
+
+import "time"
+
+type BlockAccount struct {
+	ID        int64
+	BlockerID int64
+	BlockedID int64
+	CreatedAt *time.Time
+}
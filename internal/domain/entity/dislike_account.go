@@ -3,8 +3,9 @@ package entity
 import "time"
 
 type DislikeAccount struct {
-	ID         int64
-	DislikerID int64
-	DislikedID int64
-	CreatedAt  *time.Time
+	ID           int64
+	DislikerID   int64
+	DislikedID   int64
+	DislikerRole Role
+	CreatedAt    *time.Time
 }
@@ -7,4 +7,5 @@ var (
 	ConvertStringToIntError = errors.New("convert string to int error")
 	EmptyValueError         = errors.New("empty error")
 	UnknownValueError       = errors.New("unknown value error")
+	MalformedValueError     = errors.New("malformed value error")
 )
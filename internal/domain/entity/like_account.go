@@ -6,5 +6,6 @@ type LikeAccount struct {
 	ID        int64
 	LikerID   int64
 	LikedID   int64
+	LikerRole Role
 	CreatedAt *time.Time
 }
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// Match is a mutual like of an account acting as a client and an account acting as a freelancer.
+type Match struct {
+	ID           int64
+	ClientID     int64
+	FreelancerID int64
+	UnmatchedBy  *int64
+	CreatedAt    *time.Time
+	UnmatchedAt  *time.Time
+}
+
+// PartnerOf returns the other account of the match and the role it acts in.
+func (m *Match) PartnerOf(accountID int64) (int64, Role) {
+	if m.ClientID == accountID {
+		return m.FreelancerID, FreelancerRole
+	}
+	return m.ClientID, ClientRole
+}
+
+// HasAccount reports whether the account is one of the sides of the match.
+func (m *Match) HasAccount(accountID int64) bool {
+	return m.ClientID == accountID || m.FreelancerID == accountID
+}
@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// MatchCandidateProfileFields is the number of optional profile fields FilledFields counts:
+// nickname, about me, avatar, location, company, tags, categories and portfolio.
+const MatchCandidateProfileFields int64 = 8
+
+// MatchCandidate holds what the matchable accounts ranking knows about an account compared to the viewer.
+type MatchCandidate struct {
+	AccountID        int64
+	SharedTags       int64
+	SharedCategories int64
+	SameCountry      bool
+	FilledFields     int64
+	LastActiveAt     *time.Time
+	LikedViewer      bool
+}
@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+// MatchPreference narrows the feed of the account, empty lists and a nil HasCompany don't filter.
+type MatchPreference struct {
+	AccountID    int64
+	Countries    []string
+	CategoryIDs  []int64
+	RequiredTags []string
+	VerifiedOnly bool
+	HasCompany   *bool
+	UpdatedAt    *time.Time
+}
+
+// DefaultMatchPreference matches the column defaults of match_preference,
+// it applies to accounts that never changed their preferences.
+func DefaultMatchPreference(accountID int64) MatchPreference {
+	return MatchPreference{
+		AccountID:    accountID,
+		Countries:    []string{},
+		CategoryIDs:  []int64{},
+		RequiredTags: []string{},
+	}
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// MatchRewind records a reverted swipe, the number of rewinds per day is limited.
+type MatchRewind struct {
+	ID        int64
+	AccountID int64
+	TargetID  int64
+	Action    string
+	CreatedAt *time.Time
+}
@@ -0,0 +1,14 @@
+package entity
+
+// MatchableAccountFilter keeps accounts that hold Role and that the viewer hasn't reacted to
+// while acting in ViewerRole. The other fields come from the match preference of the viewer,
+// empty lists and a nil HasCompany don't filter.
+type MatchableAccountFilter struct {
+	Role         Role
+	ViewerRole   Role
+	VerifiedOnly bool
+	Countries    []string
+	CategoryIDs  []int64
+	RequiredTags []string
+	HasCompany   *bool
+}
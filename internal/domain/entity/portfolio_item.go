@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+type PortfolioItem struct {
+	ID           int64
+	AccountID    int64
+	AttachmentID int64
+	Attachment   *Attachment
+	Title        string
+	Description  *string
+	ExternalLink *string
+	SortOrder    int64
+	CreatedAt    *time.Time
+	UpdatedAt    *time.Time
+}
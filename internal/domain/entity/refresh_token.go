@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+type RefreshToken struct {
+	ID        int64
+	AccountID int64
+	TokenID   string
+	FamilyID  string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	CreatedAt *time.Time
+}
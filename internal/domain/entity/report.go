@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type Report struct {
+	ID           int64
+	ReporterID   *int64
+	ReportedID   int64
+	Reason       ReportReason
+	Description  *string
+	AttachmentID *int64
+	Attachment   *Attachment
+	Status       ReportStatus
+	ReviewerID   *int64
+	CreatedAt    *time.Time
+	ReviewedAt   *time.Time
+}
@@ -0,0 +1,38 @@
+package entity
+
+type ReportReason struct {
+	value string
+}
+
+var (
+	UnknownReportReason              = ReportReason{value: "unknown"}
+	SpamReportReason                 = ReportReason{value: "spam"}
+	HarassmentReportReason           = ReportReason{value: "harassment"}
+	FakeProfileReportReason          = ReportReason{value: "fake_profile"}
+	ScamReportReason                 = ReportReason{value: "scam"}
+	InappropriateContentReportReason = ReportReason{value: "inappropriate_content"}
+	OtherReportReason                = ReportReason{value: "other"}
+)
+
+func ReportReasonFromString(text string) (ReportReason, error) {
+	switch text {
+	case SpamReportReason.value:
+		return SpamReportReason, nil
+	case HarassmentReportReason.value:
+		return HarassmentReportReason, nil
+	case FakeProfileReportReason.value:
+		return FakeProfileReportReason, nil
+	case ScamReportReason.value:
+		return ScamReportReason, nil
+	case InappropriateContentReportReason.value:
+		return InappropriateContentReportReason, nil
+	case OtherReportReason.value:
+		return OtherReportReason, nil
+	default:
+		return UnknownReportReason, UnknownValueError
+	}
+}
+
+func (r ReportReason) String() string {
+	return r.value
+}
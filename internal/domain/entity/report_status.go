@@ -0,0 +1,29 @@
+package entity
+
+type ReportStatus struct {
+	value string
+}
+
+var (
+	UnknownReportStatus   = ReportStatus{value: "unknown"}
+	PendingReportStatus   = ReportStatus{value: "pending"}
+	ResolvedReportStatus  = ReportStatus{value: "resolved"}
+	DismissedReportStatus = ReportStatus{value: "dismissed"}
+)
+
+func ReportStatusFromString(text string) (ReportStatus, error) {
+	switch text {
+	case PendingReportStatus.value:
+		return PendingReportStatus, nil
+	case ResolvedReportStatus.value:
+		return ResolvedReportStatus, nil
+	case DismissedReportStatus.value:
+		return DismissedReportStatus, nil
+	default:
+		return UnknownReportStatus, UnknownValueError
+	}
+}
+
+func (s ReportStatus) String() string {
+	return s.value
+}
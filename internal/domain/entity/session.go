@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+// SessionActivityResolution is how often the last activity of a session is refreshed.
+const SessionActivityResolution = time.Minute
+
+type Session struct {
+	ID           string
+	AccountID    int64
+	Device       string
+	Platform     *string
+	UserAgent    *string
+	IP           *string
+	CreatedAt    *time.Time
+	LastActiveAt *time.Time
+	RevokedAt    *time.Time
+}
+
+// ActivityOutdated reports whether the last activity is older than SessionActivityResolution.
+func (s *Session) ActivityOutdated(now time.Time) bool {
+	return s.LastActiveAt == nil || now.Sub(*s.LastActiveAt) >= SessionActivityResolution
+}
@@ -0,0 +1,23 @@
+package entity
+
+type StaffRole struct {
+	ID          int64
+	Name        string
+	Permissions []string
+}
+
+// StaffRank orders accounts by their highest staff role: an admin outranks
+// a moderator and any staff outranks an account without roles.
+func StaffRank(roles []StaffRole) int {
+	rank := 0
+	for _, role := range roles {
+		roleRank := 1
+		if role.Name == "admin" {
+			roleRank = 2
+		}
+		if roleRank > rank {
+			rank = roleRank
+		}
+	}
+	return rank
+}
@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// Swipe is a like or a dislike an account made while acting in Role.
+type Swipe struct {
+	TargetID  int64
+	Role      Role
+	Liked     bool
+	CreatedAt *time.Time
+}
@@ -0,0 +1,32 @@
+package entity
+
+type VerificationStatus struct {
+	value string
+}
+
+var (
+	UnknownVerificationStatus    = VerificationStatus{value: "unknown"}
+	UnverifiedVerificationStatus = VerificationStatus{value: "unverified"}
+	PendingVerificationStatus    = VerificationStatus{value: "pending"}
+	VerifiedVerificationStatus   = VerificationStatus{value: "verified"}
+	RejectedVerificationStatus   = VerificationStatus{value: "rejected"}
+)
+
+func VerificationStatusFromString(text string) (VerificationStatus, error) {
+	switch text {
+	case UnverifiedVerificationStatus.value:
+		return UnverifiedVerificationStatus, nil
+	case PendingVerificationStatus.value:
+		return PendingVerificationStatus, nil
+	case VerifiedVerificationStatus.value:
+		return VerifiedVerificationStatus, nil
+	case RejectedVerificationStatus.value:
+		return RejectedVerificationStatus, nil
+	default:
+		return UnknownVerificationStatus, UnknownValueError
+	}
+}
+
+func (s VerificationStatus) String() string {
+	return s.value
+}
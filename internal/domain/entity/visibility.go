@@ -0,0 +1,29 @@
+package entity
+
+type Visibility struct {
+	value string
+}
+
+var (
+	UnknownVisibility  = Visibility{value: "unknown"}
+	EveryoneVisibility = Visibility{value: "everyone"}
+	MatchesVisibility  = Visibility{value: "matches"}
+	NobodyVisibility   = Visibility{value: "nobody"}
+)
+
+func VisibilityFromString(text string) (Visibility, error) {
+	switch text {
+	case EveryoneVisibility.value:
+		return EveryoneVisibility, nil
+	case MatchesVisibility.value:
+		return MatchesVisibility, nil
+	case NobodyVisibility.value:
+		return NobodyVisibility, nil
+	default:
+		return UnknownVisibility, UnknownValueError
+	}
+}
+
+func (v Visibility) String() string {
+	return v.value
+}
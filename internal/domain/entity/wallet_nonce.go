@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+type WalletNonce struct {
+	ID        int64
+	AccountID int64
+	Payload   string
+	CreatedAt *time.Time
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
@@ -1,9 +1,13 @@
 package filestorage
 
-import "mime/multipart"
+import (
+	"io"
+	"mime/multipart"
+)
 
 type FileStorage interface {
 	UploadFile(string, multipart.File) (*string, error)
+	DownloadFile(string) (io.ReadCloser, error)
 	GetFileURL(string) (*string, error)
 	DeleteFile(string) error
 }
@@ -0,0 +1,8 @@
+package model
+
+// Optional is a field of a partial update, Set is false when the field is absent
+// and Value is nil when the field is explicitly cleared.
+type Optional[T any] struct {
+	Set   bool
+	Value *T
+}
@@ -12,11 +12,20 @@ type Provider struct {
 }
 
 type ComposedRepository struct {
-	Attachment accountRepository.Attachment
-	Account    accountRepository.Account
-	Company    accountRepository.Company
-	Tag        accountRepository.Tag
-	Category   categoryRepository.Category
+	Attachment   accountRepository.Attachment
+	Account      accountRepository.Account
+	Company      accountRepository.Company
+	Tag          accountRepository.Tag
+	RefreshToken accountRepository.RefreshToken
+	Session      accountRepository.Session
+	Sanction     accountRepository.Sanction
+	Portfolio    accountRepository.Portfolio
+	Wallet       accountRepository.Wallet
+	Audit        accountRepository.Audit
+	Match        accountRepository.Match
+	Rewind       accountRepository.Rewind
+	Report       accountRepository.Report
+	Category     categoryRepository.Category
 }
 
 func NewProvider(db *sql.DB) *Provider {
@@ -28,11 +37,20 @@ func NewProvider(db *sql.DB) *Provider {
 func (p *Provider) Transact(txFunc func(composed ComposedRepository) error) error {
 	return psql.RunInTx(p.db, func(tx *sql.Tx) error {
 		composed := ComposedRepository{
-			Attachment: accountRepository.NewAttachment(tx),
-			Account:    accountRepository.NewAccount(tx),
-			Company:    accountRepository.NewCompany(tx),
-			Tag:        accountRepository.NewTag(tx),
-			Category:   categoryRepository.NewCategory(tx),
+			Attachment:   accountRepository.NewAttachment(tx),
+			Account:      accountRepository.NewAccount(tx),
+			Company:      accountRepository.NewCompany(tx),
+			Tag:          accountRepository.NewTag(tx),
+			RefreshToken: accountRepository.NewRefreshToken(tx),
+			Session:      accountRepository.NewSession(tx),
+			Sanction:     accountRepository.NewSanction(tx),
+			Portfolio:    accountRepository.NewPortfolio(tx),
+			Wallet:       accountRepository.NewWallet(tx),
+			Audit:        accountRepository.NewAudit(tx),
+			Match:        accountRepository.NewMatch(tx),
+			Rewind:       accountRepository.NewRewind(tx),
+			Report:       accountRepository.NewReport(tx),
+			Category:     categoryRepository.NewCategory(tx),
 		}
 		return txFunc(composed)
 	})
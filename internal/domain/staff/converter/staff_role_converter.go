@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/internal/domain/staff/model"
+)
+
+func ConvertEntity2StaffRoleModel(staffRoleEntity *entity.StaffRole) *model.StaffRole {
+	permissions := make([]model.Permission, 0, len(staffRoleEntity.Permissions))
+	for _, permission := range staffRoleEntity.Permissions {
+		permissions = append(permissions, model.Permission(permission))
+	}
+	return &model.StaffRole{
+		ID:          staffRoleEntity.ID,
+		Name:        staffRoleEntity.Name,
+		Permissions: permissions,
+	}
+}
+
+func ConvertEntities2StaffRolesModel(staffRoleEntities []entity.StaffRole) []model.StaffRole {
+	var staffRoles = make([]model.StaffRole, 0, len(staffRoleEntities))
+	for _, staffRoleEntity := range staffRoleEntities {
+		staffRoles = append(staffRoles, *ConvertEntity2StaffRoleModel(&staffRoleEntity))
+	}
+	return staffRoles
+}
@@ -0,0 +1,9 @@
+package model
+
+import "errors"
+
+var (
+	NilError              = errors.New("nil error")
+	EntityNotFoundError   = errors.New("entity not found")
+	UnknownStaffRoleError = errors.New("unknown staff role")
+)
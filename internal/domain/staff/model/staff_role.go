@@ -0,0 +1,10 @@
+package model
+
+// AdminStaffRoleName is the staff role that manages the other staff.
+const AdminStaffRoleName = "admin"
+
+type StaffRole struct {
+	ID          int64
+	Name        string
+	Permissions []Permission
+}
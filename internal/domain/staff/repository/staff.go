@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/psql"
+)
+
+type Staff interface {
+	GetRoles(ctx context.Context) ([]entity.StaffRole, error)
+	GetRolesByAccountID(ctx context.Context, accountID int64) ([]entity.StaffRole, error)
+	GetRoleIDByName(ctx context.Context, name string) (*int64, error)
+	HasPermission(ctx context.Context, accountID int64, permission string) (bool, error)
+	AssignRole(ctx context.Context, accountID int64, roleID int64) error
+	RevokeRole(ctx context.Context, accountID int64, roleID int64) error
+	CountAccountsByRoleID(ctx context.Context, roleID int64) (int64, error)
+}
+
+type staff struct {
+	conn psql.Operation
+}
+
+func NewStaff(conn psql.Operation) Staff {
+	return &staff{
+		conn: conn,
+	}
+}
+
+func (s *staff) GetRoles(ctx context.Context) ([]entity.StaffRole, error) {
+	query := "SELECT staff_role.id, staff_role.name, staff_role_permission.permission " +
+		"FROM staff_role " +
+		"LEFT JOIN staff_role_permission ON staff_role_permission.role_id = staff_role.id " +
+		"ORDER BY staff_role.id, staff_role_permission.permission;"
+	return s.queryRoles(ctx, query)
+}
+
+func (s *staff) GetRolesByAccountID(ctx context.Context, accountID int64) ([]entity.StaffRole, error) {
+	query := "SELECT staff_role.id, staff_role.name, staff_role_permission.permission " +
+		"FROM account_staff_role " +
+		"JOIN staff_role ON staff_role.id = account_staff_role.role_id " +
+		"LEFT JOIN staff_role_permission ON staff_role_permission.role_id = staff_role.id " +
+		"WHERE account_staff_role.account_id = $1 " +
+		"ORDER BY staff_role.id, staff_role_permission.permission;"
+	return s.queryRoles(ctx, query, accountID)
+}
+
+func (s *staff) GetRoleIDByName(ctx context.Context, name string) (*int64, error) {
+	query := "SELECT id FROM staff_role WHERE name = $1;"
+	var id int64
+	if err := s.conn.QueryRowContext(ctx, query, name).Scan(&id); err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func (s *staff) HasPermission(ctx context.Context, accountID int64, permission string) (bool, error) {
+	query := "SELECT EXISTS(" +
+		"	SELECT 1 FROM account_staff_role " +
+		"	JOIN staff_role_permission ON staff_role_permission.role_id = account_staff_role.role_id " +
+		"	WHERE account_staff_role.account_id = $1 AND staff_role_permission.permission = $2" +
+		");"
+	var exists bool
+	err := s.conn.QueryRowContext(ctx, query, accountID, permission).Scan(&exists)
+	return exists, err
+}
+
+func (s *staff) AssignRole(ctx context.Context, accountID int64, roleID int64) error {
+	query := "INSERT INTO account_staff_role (account_id, role_id) VALUES ($1, $2) " +
+		"ON CONFLICT (account_id, role_id) DO NOTHING;"
+	_, err := s.conn.ExecContext(ctx, query, accountID, roleID)
+	return err
+}
+
+func (s *staff) RevokeRole(ctx context.Context, accountID int64, roleID int64) error {
+	query := "DELETE FROM account_staff_role WHERE account_id = $1 AND role_id = $2;"
+	_, err := s.conn.ExecContext(ctx, query, accountID, roleID)
+	return err
+}
+
+func (s *staff) CountAccountsByRoleID(ctx context.Context, roleID int64) (int64, error) {
+	query := "SELECT COUNT(*) FROM account_staff_role WHERE role_id = $1;"
+	var count int64
+	err := s.conn.QueryRowContext(ctx, query, roleID).Scan(&count)
+	return count, err
+}
+
+func (s *staff) queryRoles(ctx context.Context, query string, args ...any) ([]entity.StaffRole, error) {
+	rows, err := s.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	roles := make([]entity.StaffRole, 0)
+	for rows.Next() {
+		var (
+			role       entity.StaffRole
+			permission sql.NullString
+		)
+		if err := rows.Scan(&role.ID, &role.Name, &permission); err != nil {
+			return nil, err
+		}
+		if len(roles) == 0 || roles[len(roles)-1].ID != role.ID {
+			roles = append(roles, role)
+		}
+		if permission.Valid {
+			last := &roles[len(roles)-1]
+			last.Permissions = append(last.Permissions, permission.String)
+		}
+	}
+	return roles, rows.Err()
+}
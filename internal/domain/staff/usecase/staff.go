@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/container"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	"go-tonify-backend/internal/domain/staff/converter"
+	"go-tonify-backend/internal/domain/staff/model"
+	"go-tonify-backend/internal/domain/staff/repository"
+	"go-tonify-backend/pkg/logger"
+)
+
+type Staff interface {
+	IsStaff(ctx context.Context, accountID int64) (bool, error)
+	HasPermissions(ctx context.Context, accountID int64, permissions ...model.Permission) (bool, error)
+	GetRoles(ctx context.Context) ([]model.StaffRole, error)
+	GetAccountRoles(ctx context.Context, accountID int64) ([]model.StaffRole, error)
+	AssignRole(ctx context.Context, accountID int64, roleName string) error
+	RevokeRole(ctx context.Context, accountID int64, roleName string) error
+	BootstrapAdmin(ctx context.Context, telegramID int64) error
+}
+
+type staff struct {
+	container         container.Container
+	staffRepository   repository.Staff
+	accountRepository accountRepository.Account
+}
+
+func NewStaff(
+	container container.Container,
+	staffRepository repository.Staff,
+	accountRepository accountRepository.Account,
+) Staff {
+	return &staff{
+		container:         container,
+		staffRepository:   staffRepository,
+		accountRepository: accountRepository,
+	}
+}
+
+func (s *staff) IsStaff(ctx context.Context, accountID int64) (bool, error) {
+	staffRoles, err := s.GetAccountRoles(ctx, accountID)
+	if err != nil {
+		return false, err
+	}
+	return len(staffRoles) > 0, nil
+}
+
+// HasPermissions reports whether the account has every permission through any of its staff roles.
+func (s *staff) HasPermissions(ctx context.Context, accountID int64, permissions ...model.Permission) (bool, error) {
+	log := s.container.GetLogger()
+	for _, permission := range permissions {
+		hasPermission, err := s.staffRepository.HasPermission(ctx, accountID, string(permission))
+		if err != nil {
+			log.Error("fail to check permission", logger.FError(err), logger.F("permission", permission))
+			return false, err
+		}
+		if !hasPermission {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (s *staff) GetRoles(ctx context.Context) ([]model.StaffRole, error) {
+	log := s.container.GetLogger()
+	staffRoleEntities, err := s.staffRepository.GetRoles(ctx)
+	if err != nil {
+		log.Error("fail to get staff roles", logger.FError(err))
+		return nil, err
+	}
+	return converter.ConvertEntities2StaffRolesModel(staffRoleEntities), nil
+}
+
+func (s *staff) GetAccountRoles(ctx context.Context, accountID int64) ([]model.StaffRole, error) {
+	log := s.container.GetLogger()
+	staffRoleEntities, err := s.staffRepository.GetRolesByAccountID(ctx, accountID)
+	if err != nil {
+		log.Error("fail to get staff roles of account", logger.FError(err), logger.F("account_id", accountID))
+		return nil, err
+	}
+	return converter.ConvertEntities2StaffRolesModel(staffRoleEntities), nil
+}
+
+func (s *staff) AssignRole(ctx context.Context, accountID int64, roleName string) error {
+	log := s.container.GetLogger()
+	roleID, err := s.getRoleID(ctx, roleName)
+	if err != nil {
+		return err
+	}
+	if _, err := s.accountRepository.GetByID(ctx, accountID); err != nil {
+		log.Error("fail to get account by id", logger.FError(err), logger.F("account_id", accountID))
+		switch err {
+		case sql.ErrNoRows:
+			return model.EntityNotFoundError
+		default:
+			return err
+		}
+	}
+	if err := s.staffRepository.AssignRole(ctx, accountID, *roleID); err != nil {
+		log.Error("fail to assign staff role", logger.FError(err), logger.F("account_id", accountID))
+		return err
+	}
+	return nil
+}
+
+func (s *staff) RevokeRole(ctx context.Context, accountID int64, roleName string) error {
+	log := s.container.GetLogger()
+	roleID, err := s.getRoleID(ctx, roleName)
+	if err != nil {
+		return err
+	}
+	if err := s.staffRepository.RevokeRole(ctx, accountID, *roleID); err != nil {
+		log.Error("fail to revoke staff role", logger.FError(err), logger.F("account_id", accountID))
+		return err
+	}
+	return nil
+}
+
+// BootstrapAdmin makes the account with the telegram id an admin while nobody holds the admin role,
+// so the staff can be managed on a fresh deployment. Once there is an admin it does nothing.
+func (s *staff) BootstrapAdmin(ctx context.Context, telegramID int64) error {
+	log := s.container.GetLogger()
+	roleID, err := s.getRoleID(ctx, model.AdminStaffRoleName)
+	if err != nil {
+		return err
+	}
+	numberOfAdmins, err := s.staffRepository.CountAccountsByRoleID(ctx, *roleID)
+	if err != nil {
+		log.Error("fail to count admins", logger.FError(err))
+		return err
+	}
+	if numberOfAdmins > 0 {
+		return nil
+	}
+	accountEntity, err := s.accountRepository.GetByTelegramID(ctx, telegramID)
+	if err != nil {
+		log.Error("fail to get account by telegram id", logger.FError(err), logger.F("telegram_id", telegramID))
+		switch err {
+		case sql.ErrNoRows:
+			return model.EntityNotFoundError
+		default:
+			return err
+		}
+	}
+	if err := s.staffRepository.AssignRole(ctx, accountEntity.ID, *roleID); err != nil {
+		log.Error("fail to assign initial admin", logger.FError(err), logger.F("account_id", accountEntity.ID))
+		return err
+	}
+	log.Info("initial admin has been assigned", logger.F("account_id", accountEntity.ID))
+	return nil
+}
+
+func (s *staff) getRoleID(ctx context.Context, roleName string) (*int64, error) {
+	log := s.container.GetLogger()
+	roleID, err := s.staffRepository.GetRoleIDByName(ctx, roleName)
+	if err != nil {
+		log.Error("fail to get staff role by name", logger.FError(err), logger.F("role", roleName))
+		switch err {
+		case sql.ErrNoRows:
+			return nil, model.UnknownStaffRoleError
+		default:
+			return nil, err
+		}
+	}
+	if roleID == nil {
+		return nil, model.NilError
+	}
+	return roleID, nil
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"go-tonify-backend/internal/container"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/internal/domain/staff/model"
+	"go-tonify-backend/internal/domain/staff/repository"
+	"go-tonify-backend/pkg/logger"
+	"testing"
+)
+
+const testAdminRoleID int64 = 1
+
+type fakeContainer struct {
+	container.Container
+}
+
+func (f *fakeContainer) GetLogger() logger.Logger {
+	return logger.NewLogger(logger.DEV, logger.LevelFatal)
+}
+
+type fakeStaffRepository struct {
+	repository.Staff
+	roles map[int64][]int64
+}
+
+func (f *fakeStaffRepository) GetRoleIDByName(ctx context.Context, name string) (*int64, error) {
+	if name != model.AdminStaffRoleName {
+		return nil, sql.ErrNoRows
+	}
+	roleID := testAdminRoleID
+	return &roleID, nil
+}
+
+func (f *fakeStaffRepository) CountAccountsByRoleID(ctx context.Context, roleID int64) (int64, error) {
+	var count int64
+	for _, roleIDs := range f.roles {
+		for _, id := range roleIDs {
+			if id == roleID {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+func (f *fakeStaffRepository) AssignRole(ctx context.Context, accountID int64, roleID int64) error {
+	f.roles[accountID] = append(f.roles[accountID], roleID)
+	return nil
+}
+
+type fakeAccountRepository struct {
+	accountRepository.Account
+	accountIDByTelegramID map[int64]int64
+}
+
+func (f *fakeAccountRepository) GetByTelegramID(ctx context.Context, telegramID int64) (*entity.Account, error) {
+	accountID, ok := f.accountIDByTelegramID[telegramID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &entity.Account{ID: accountID, TelegramID: telegramID}, nil
+}
+
+func TestBootstrapAdmin(t *testing.T) {
+	tests := []struct {
+		name          string
+		roles         map[int64][]int64
+		telegramID    int64
+		expectedErr   error
+		expectedAdmin map[int64]bool
+	}{
+		{name: "first admin is assigned", roles: map[int64][]int64{}, telegramID: 100, expectedAdmin: map[int64]bool{7: true}},
+		{name: "existing admin is kept alone", roles: map[int64][]int64{8: {testAdminRoleID}}, telegramID: 100, expectedAdmin: map[int64]bool{7: false, 8: true}},
+		{name: "account isn't signed up", roles: map[int64][]int64{}, telegramID: 200, expectedErr: model.EntityNotFoundError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			staffRepository := &fakeStaffRepository{roles: tt.roles}
+			accountRepository := &fakeAccountRepository{accountIDByTelegramID: map[int64]int64{100: 7}}
+			staffUsecase := NewStaff(&fakeContainer{}, staffRepository, accountRepository)
+			if err := staffUsecase.BootstrapAdmin(context.Background(), tt.telegramID); err != tt.expectedErr {
+				t.Fatalf("expected %v, got %v", tt.expectedErr, err)
+			}
+			for accountID, expected := range tt.expectedAdmin {
+				if actual := len(staffRepository.roles[accountID]) > 0; actual != expected {
+					t.Errorf("account %d: expected admin %v, got %v", accountID, expected, actual)
+				}
+			}
+		})
+	}
+}
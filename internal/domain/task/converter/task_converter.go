@@ -10,6 +10,7 @@ func ConvertEntity2TaskModel(taskEntity *entity.Task) *model.Task {
 		ID:          taskEntity.ID,
 		Title:       taskEntity.Title,
 		OwnerID:     taskEntity.OwnerID,
+		OwnerRole:   taskEntity.OwnerRole.String(),
 		Description: taskEntity.Description,
 		CreatedAt:   taskEntity.CreatedAt,
 		UpdatedAt:   taskEntity.UpdatedAt,
@@ -5,6 +5,7 @@ import "time"
 type Task struct {
 	ID          int64
 	OwnerID     int64
+	OwnerRole   string
 	Title       string
 	Description string
 	CreatedAt   *time.Time
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	accountRepository "go-tonify-backend/internal/domain/account/repository"
 	"go-tonify-backend/internal/domain/entity"
 	"go-tonify-backend/pkg/psql"
 )
@@ -10,8 +11,9 @@ import (
 type Task interface {
 	Create(ctx context.Context, task *entity.Task) (*int64, error)
 	GetByID(ctx context.Context, id int64) (*entity.Task, error)
-	CountByID(ctx context.Context, id int64) (*int64, error)
+	CountByID(ctx context.Context, id int64, ownerRole entity.Role) (*int64, error)
 	GetList(ctx context.Context, ownerID int64, offset int64, limit int64) ([]entity.Task, error)
+	GetAllByOwnerID(ctx context.Context, ownerID int64) ([]entity.Task, error)
 }
 
 type task struct {
@@ -28,11 +30,12 @@ func (t *task) Create(ctx context.Context, task *entity.Task) (*int64, error) {
 	var id int64
 	query := "INSERT INTO task (" +
 		"	owner_id, " +
+		"	owner_role, " +
 		"	title, " +
 		"	description " +
-		") VALUES ($1, $2, $3) " +
+		") VALUES ($1, $2, $3, $4) " +
 		"RETURNING id;"
-	err := t.conn.QueryRowContext(ctx, query, task.OwnerID, task.Title, task.Description).Scan(&id)
+	err := t.conn.QueryRowContext(ctx, query, task.OwnerID, task.OwnerRole.String(), task.Title, task.Description).Scan(&id)
 	if err != nil {
 		return nil, err
 	}
@@ -42,6 +45,7 @@ func (t *task) Create(ctx context.Context, task *entity.Task) (*int64, error) {
 func (t *task) GetByID(ctx context.Context, id int64) (*entity.Task, error) {
 	query := "SELECT " +
 		"	owner_id, " +
+		"	owner_role, " +
 		"	title, " +
 		"	description, " +
 		"	created_at, " +
@@ -50,12 +54,14 @@ func (t *task) GetByID(ctx context.Context, id int64) (*entity.Task, error) {
 		"	WHERE id = $1 AND deleted_at IS NULL;"
 	var (
 		task      entity.Task
+		ownerRole string
 		createdAt sql.NullTime
 		updatedAt sql.NullTime
 	)
 	task.ID = id
 	err := t.conn.QueryRowContext(ctx, query, id).Scan(
 		&task.OwnerID,
+		&ownerRole,
 		&task.Title,
 		&task.Description,
 		&createdAt,
@@ -64,6 +70,7 @@ func (t *task) GetByID(ctx context.Context, id int64) (*entity.Task, error) {
 	if err != nil {
 		return nil, err
 	}
+	task.OwnerRole, _ = entity.RoleFromString(ownerRole)
 	if createdAt.Valid {
 		task.CreatedAt = &createdAt.Time
 	}
@@ -73,12 +80,13 @@ func (t *task) GetByID(ctx context.Context, id int64) (*entity.Task, error) {
 	return &task, nil
 }
 
-func (t *task) CountByID(ctx context.Context, id int64) (*int64, error) {
+// CountByID counts the tasks the account created while acting in ownerRole.
+func (t *task) CountByID(ctx context.Context, id int64, ownerRole entity.Role) (*int64, error) {
 	query := "SELECT COUNT(*) " +
 		"FROM task " +
-		"	WHERE owner_id = $1;"
+		"	WHERE owner_id = $1 AND owner_role = $2;"
 	var count int64
-	err := t.conn.QueryRowContext(ctx, query, id).Scan(
+	err := t.conn.QueryRowContext(ctx, query, id, ownerRole.String()).Scan(
 		&count,
 	)
 	if err != nil {
@@ -87,15 +95,20 @@ func (t *task) CountByID(ctx context.Context, id int64) (*int64, error) {
 	return &count, nil
 }
 
+// GetList skips the tasks created in a role the owner doesn't hold anymore.
 func (t *task) GetList(ctx context.Context, ownerID int64, offset int64, limit int64) ([]entity.Task, error) {
 	query := "SELECT " +
-		"	id, " +
-		"	title, " +
-		"	description, " +
-		"	created_at, " +
-		"	updated_at " +
+		"	task.id, " +
+		"	task.owner_role, " +
+		"	task.title, " +
+		"	task.description, " +
+		"	task.created_at, " +
+		"	task.updated_at " +
 		"FROM task " +
-		"	WHERE owner_id = $1 AND deleted_at IS NULL " +
+		"	JOIN account ON account.id = task.owner_id " +
+		"	JOIN account_role ON account_role.account_id = task.owner_id AND account_role.role = task.owner_role " +
+		"	WHERE task.owner_id = $1 AND task.deleted_at IS NULL " +
+		"	AND " + accountRepository.ActiveAccountCondition +
 		"LIMIT $2 " +
 		"OFFSET $3;"
 	rows, err := t.conn.QueryContext(ctx, query, ownerID, limit, offset)
@@ -105,6 +118,7 @@ func (t *task) GetList(ctx context.Context, ownerID int64, offset int64, limit i
 	tasks := make([]entity.Task, 0, limit)
 	for rows.Next() {
 		var (
+			ownerRole string
 			createdAt sql.NullTime
 			updatedAt sql.NullTime
 		)
@@ -112,6 +126,7 @@ func (t *task) GetList(ctx context.Context, ownerID int64, offset int64, limit i
 		task.OwnerID = ownerID
 		err = rows.Scan(
 			&task.ID,
+			&ownerRole,
 			&task.Title,
 			&task.Description,
 			&createdAt,
@@ -120,6 +135,7 @@ func (t *task) GetList(ctx context.Context, ownerID int64, offset int64, limit i
 		if err != nil {
 			return nil, err
 		}
+		task.OwnerRole, _ = entity.RoleFromString(ownerRole)
 		if createdAt.Valid {
 			task.CreatedAt = &createdAt.Time
 		}
@@ -130,3 +146,58 @@ func (t *task) GetList(ctx context.Context, ownerID int64, offset int64, limit i
 	}
 	return tasks, nil
 }
+
+// GetAllByOwnerID returns every task of the owner including the deleted ones.
+func (t *task) GetAllByOwnerID(ctx context.Context, ownerID int64) ([]entity.Task, error) {
+	query := "SELECT " +
+		"	id, " +
+		"	title, " +
+		"	description, " +
+		"	created_at, " +
+		"	updated_at, " +
+		"	deleted_at, " +
+		"	owner_role " +
+		"FROM task " +
+		"WHERE owner_id = $1 " +
+		"ORDER BY created_at, id;"
+	rows, err := t.conn.QueryContext(ctx, query, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	tasks := make([]entity.Task, 0)
+	for rows.Next() {
+		var (
+			createdAt sql.NullTime
+			updatedAt sql.NullTime
+			deletedAt sql.NullTime
+			ownerRole string
+		)
+		var task entity.Task
+		task.OwnerID = ownerID
+		err = rows.Scan(
+			&task.ID,
+			&task.Title,
+			&task.Description,
+			&createdAt,
+			&updatedAt,
+			&deletedAt,
+			&ownerRole,
+		)
+		if err != nil {
+			return nil, err
+		}
+		task.OwnerRole, _ = entity.RoleFromString(ownerRole)
+		if createdAt.Valid {
+			task.CreatedAt = &createdAt.Time
+		}
+		if updatedAt.Valid {
+			task.UpdatedAt = &updatedAt.Time
+		}
+		if deletedAt.Valid {
+			task.DeletedAt = &deletedAt.Time
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
@@ -32,9 +32,15 @@ func NewTask(container container.Container, taskRepository repository.Task) Task
 	}
 }
 
+// CreateTask records the task in the role the owner acts in, the task limit applies per role.
 func (t *task) CreateTask(ctx context.Context, task *model.Task) (*model.Task, error) {
 	log := t.container.GetLogger()
-	createdTasks, err := t.taskRepository.CountByID(ctx, task.OwnerID)
+	ownerRole, err := entity.RoleFromString(task.OwnerRole)
+	if err != nil {
+		log.Error("unknown owner role from string", logger.FError(err))
+		return nil, err
+	}
+	createdTasks, err := t.taskRepository.CountByID(ctx, task.OwnerID, ownerRole)
 	if err != nil {
 		log.Error("fail to get count tasks", logger.FError(err))
 		return nil, err
@@ -49,6 +55,7 @@ func (t *task) CreateTask(ctx context.Context, task *model.Task) (*model.Task, e
 	}
 	taskEntity := entity.Task{
 		OwnerID:     task.OwnerID,
+		OwnerRole:   ownerRole,
 		Title:       task.Title,
 		Description: task.Description,
 	}
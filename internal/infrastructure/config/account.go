@@ -0,0 +1,74 @@
+package config
+
+import (
+	"go-tonify-backend/internal/domain/entity"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultAccountDeletionGracePeriod = 30 * 24 * time.Hour
+	defaultAccountPurgeInterval       = time.Hour
+	defaultAccountExportInterval      = time.Minute
+	defaultAccountExportTTL           = 7 * 24 * time.Hour
+)
+
+type Account struct {
+	DeletionGracePeriod time.Duration // in sec
+	PurgeInterval       time.Duration // in sec
+	ExportInterval      time.Duration // in sec
+	ExportTTL           time.Duration // in sec
+}
+
+var (
+	accountOnce     sync.Once
+	accountError    error
+	accountInstance *Account
+)
+
+func GetAccount() (*Account, error) {
+	accountOnce.Do(func() {
+		var instance = Account{
+			DeletionGracePeriod: defaultAccountDeletionGracePeriod,
+			PurgeInterval:       defaultAccountPurgeInterval,
+			ExportInterval:      defaultAccountExportInterval,
+			ExportTTL:           defaultAccountExportTTL,
+		}
+		if deletionGracePeriodText, ok := os.LookupEnv("ACCOUNT_DELETION_GRACE_PERIOD"); ok {
+			deletionGracePeriod, err := strconv.Atoi(deletionGracePeriodText)
+			if err != nil {
+				accountError = entity.ConvertStringToIntError
+				return
+			}
+			instance.DeletionGracePeriod = time.Duration(deletionGracePeriod) * time.Second
+		}
+		if purgeIntervalText, ok := os.LookupEnv("ACCOUNT_PURGE_INTERVAL"); ok {
+			purgeInterval, err := strconv.Atoi(purgeIntervalText)
+			if err != nil {
+				accountError = entity.ConvertStringToIntError
+				return
+			}
+			instance.PurgeInterval = time.Duration(purgeInterval) * time.Second
+		}
+		if exportIntervalText, ok := os.LookupEnv("ACCOUNT_EXPORT_INTERVAL"); ok {
+			exportInterval, err := strconv.Atoi(exportIntervalText)
+			if err != nil {
+				accountError = entity.ConvertStringToIntError
+				return
+			}
+			instance.ExportInterval = time.Duration(exportInterval) * time.Second
+		}
+		if exportTTLText, ok := os.LookupEnv("ACCOUNT_EXPORT_TTL"); ok {
+			exportTTL, err := strconv.Atoi(exportTTLText)
+			if err != nil {
+				accountError = entity.ConvertStringToIntError
+				return
+			}
+			instance.ExportTTL = time.Duration(exportTTL) * time.Second
+		}
+		accountInstance = &instance
+	})
+	return accountInstance, accountError
+}
@@ -7,6 +7,11 @@ type Config struct {
 	AWS        *AWS
 	PostgreSQL *PostgreSQL
 	Telegram   *Telegram
+	Account    *Account
+	RateLimit  *RateLimit
+	TonConnect *TonConnect
+	Match      *Match
+	Staff      *Staff
 }
 
 var (
@@ -41,6 +46,31 @@ func GetConfig() (*Config, error) {
 			configError = err
 			return
 		}
+		instance.Account, err = GetAccount()
+		if err != nil {
+			configError = err
+			return
+		}
+		instance.RateLimit, err = GetRateLimit()
+		if err != nil {
+			configError = err
+			return
+		}
+		instance.TonConnect, err = GetTonConnect()
+		if err != nil {
+			configError = err
+			return
+		}
+		instance.Match, err = GetMatch()
+		if err != nil {
+			configError = err
+			return
+		}
+		instance.Staff, err = GetStaff()
+		if err != nil {
+			configError = err
+			return
+		}
 		configInstance = &instance
 	})
 	return configInstance, configError
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"go-tonify-backend/internal/domain/entity"
+	"os"
+	"strconv"
+	"sync"
+)
+
+const (
+	defaultMatchCandidatePoolSize int64 = 1000
+	defaultMatchRewindsPerDay     int64 = 3
+)
+
+// MatchWeights weigh the components of the matchable accounts ranking, every component is scored from 0 to 1.
+type MatchWeights struct {
+	Tags         float64
+	Categories   float64
+	Country      float64
+	Completeness float64
+	Activity     float64
+	LikedViewer  float64
+}
+
+type Match struct {
+	Weights MatchWeights
+	// CandidatePoolSize limits the most recently active candidates the feed ranks.
+	CandidatePoolSize int64
+	// RewindsPerDay limits the swipes an account can revert within 24 hours.
+	RewindsPerDay int64
+}
+
+var (
+	matchOnce     sync.Once
+	matchError    error
+	matchInstance *Match
+)
+
+func GetMatch() (*Match, error) {
+	matchOnce.Do(func() {
+		var instance = Match{
+			Weights: MatchWeights{
+				Tags:         3,
+				Categories:   2,
+				Country:      1,
+				Completeness: 1,
+				Activity:     1.5,
+				LikedViewer:  2.5,
+			},
+			CandidatePoolSize: defaultMatchCandidatePoolSize,
+			RewindsPerDay:     defaultMatchRewindsPerDay,
+		}
+		weights := map[string]*float64{
+			"MATCH_WEIGHT_TAGS":         &instance.Weights.Tags,
+			"MATCH_WEIGHT_CATEGORIES":   &instance.Weights.Categories,
+			"MATCH_WEIGHT_COUNTRY":      &instance.Weights.Country,
+			"MATCH_WEIGHT_COMPLETENESS": &instance.Weights.Completeness,
+			"MATCH_WEIGHT_ACTIVITY":     &instance.Weights.Activity,
+			"MATCH_WEIGHT_LIKED_VIEWER": &instance.Weights.LikedViewer,
+		}
+		for key, weight := range weights {
+			weightText, ok := os.LookupEnv(key)
+			if !ok {
+				continue
+			}
+			parsedWeight, err := strconv.ParseFloat(weightText, 64)
+			if err != nil || parsedWeight < 0 {
+				matchError = entity.MalformedValueError
+				return
+			}
+			*weight = parsedWeight
+		}
+		if candidatePoolSizeText, ok := os.LookupEnv("MATCH_CANDIDATE_POOL_SIZE"); ok {
+			candidatePoolSize, err := strconv.Atoi(candidatePoolSizeText)
+			if err != nil {
+				matchError = entity.ConvertStringToIntError
+				return
+			}
+			instance.CandidatePoolSize = int64(candidatePoolSize)
+		}
+		if rewindsPerDayText, ok := os.LookupEnv("MATCH_REWINDS_PER_DAY"); ok {
+			rewindsPerDay, err := strconv.Atoi(rewindsPerDayText)
+			if err != nil {
+				matchError = entity.ConvertStringToIntError
+				return
+			}
+			instance.RewindsPerDay = int64(rewindsPerDay)
+		}
+		matchInstance = &instance
+	})
+	return matchInstance, matchError
+}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/ratelimit"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit holds a limit per route group, a limit with zero requests disables limiting of the group.
+type RateLimit struct {
+	Auth        ratelimit.Limit
+	SignUp      ratelimit.Limit
+	Account     ratelimit.Limit
+	Match       ratelimit.Limit
+	MatchAction ratelimit.Limit
+	Task        ratelimit.Limit
+	Common      ratelimit.Limit
+	Admin       ratelimit.Limit
+	Report      ratelimit.Limit
+}
+
+var (
+	rateLimitOnce     sync.Once
+	rateLimitError    error
+	rateLimitInstance *RateLimit
+)
+
+func GetRateLimit() (*RateLimit, error) {
+	rateLimitOnce.Do(func() {
+		var instance = RateLimit{
+			Auth:        ratelimit.Limit{Requests: 20, Period: time.Minute},
+			SignUp:      ratelimit.Limit{Requests: 5, Period: time.Hour},
+			Account:     ratelimit.Limit{Requests: 120, Period: time.Minute},
+			Match:       ratelimit.Limit{Requests: 120, Period: time.Minute},
+			MatchAction: ratelimit.Limit{Requests: 60, Period: time.Minute},
+			Task:        ratelimit.Limit{Requests: 60, Period: time.Minute},
+			Common:      ratelimit.Limit{Requests: 120, Period: time.Minute},
+			Admin:       ratelimit.Limit{Requests: 300, Period: time.Minute},
+			Report:      ratelimit.Limit{Requests: 10, Period: time.Hour},
+		}
+		limits := map[string]*ratelimit.Limit{
+			"RATE_LIMIT_AUTH":         &instance.Auth,
+			"RATE_LIMIT_SIGN_UP":      &instance.SignUp,
+			"RATE_LIMIT_ACCOUNT":      &instance.Account,
+			"RATE_LIMIT_MATCH":        &instance.Match,
+			"RATE_LIMIT_MATCH_ACTION": &instance.MatchAction,
+			"RATE_LIMIT_TASK":         &instance.Task,
+			"RATE_LIMIT_COMMON":       &instance.Common,
+			"RATE_LIMIT_ADMIN":        &instance.Admin,
+			"RATE_LIMIT_REPORT":       &instance.Report,
+		}
+		for key, limit := range limits {
+			limitText, ok := os.LookupEnv(key)
+			if !ok {
+				continue
+			}
+			parsedLimit, err := parseRateLimit(limitText)
+			if err != nil {
+				rateLimitError = err
+				return
+			}
+			*limit = *parsedLimit
+		}
+		rateLimitInstance = &instance
+	})
+	return rateLimitInstance, rateLimitError
+}
+
+// parseRateLimit parses "<requests>/<period in sec>", e.g. "20/60".
+func parseRateLimit(text string) (*ratelimit.Limit, error) {
+	requestsText, periodText, ok := strings.Cut(text, "/")
+	if !ok {
+		return nil, entity.MalformedValueError
+	}
+	requests, err := strconv.Atoi(requestsText)
+	if err != nil {
+		return nil, entity.ConvertStringToIntError
+	}
+	period, err := strconv.Atoi(periodText)
+	if err != nil {
+		return nil, entity.ConvertStringToIntError
+	}
+	return &ratelimit.Limit{
+		Requests: requests,
+		Period:   time.Duration(period) * time.Second,
+	}, nil
+}
@@ -5,19 +5,33 @@ import (
 	"net"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
 
+const defaultJWTSecretKeyID = "primary"
+
 type Server struct {
-	Addr                string
-	Port                string
-	SecureAddr          string
-	SecurePort          string
-	JWTSecretKey        string
-	LoggerLevel         int
-	AccessJWTExpiresIn  time.Duration // in sec
-	RefreshJWTExpiresIn time.Duration // in sec
+	Addr                  string
+	Port                  string
+	SecureAddr            string
+	SecurePort            string
+	TrustedProxies        []string
+	JWTSecretKey          string
+	JWTSecretKeyID        string
+	JWTRetiredSecretKeys  []JWTKey
+	JWTLegacyKeyRetiredAt *time.Time
+	JWTKeyGracePeriod     time.Duration // in sec
+	LoggerLevel           int
+	AccessJWTExpiresIn    time.Duration // in sec
+	RefreshJWTExpiresIn   time.Duration // in sec
+}
+
+type JWTKey struct {
+	ID        string
+	Secret    string
+	RetiredAt time.Time
 }
 
 var (
@@ -53,6 +67,14 @@ func GetServer() (*Server, error) {
 			serverErr = entity.NilError
 			return
 		}
+		// no proxy is trusted by default, so the client ip can't be spoofed by a forwarded header
+		if trustedProxiesText, ok := os.LookupEnv("SERVER_TRUSTED_PROXIES"); ok {
+			for _, trustedProxy := range strings.Split(trustedProxiesText, ",") {
+				if trustedProxy = strings.TrimSpace(trustedProxy); len(trustedProxy) > 0 {
+					instance.TrustedProxies = append(instance.TrustedProxies, trustedProxy)
+				}
+			}
+		}
 		loggerLevelText, ok := os.LookupEnv("SERVER_LOGGER_LEVEL")
 		if !ok {
 			serverErr = entity.NilError
@@ -85,6 +107,42 @@ func GetServer() (*Server, error) {
 			return
 		}
 		instance.RefreshJWTExpiresIn = time.Duration(refreshJWTExpiresIn) * time.Second
+		instance.JWTSecretKey, ok = os.LookupEnv("JWT_SECRET_KEY")
+		if !ok {
+			serverErr = entity.NilError
+			return
+		}
+		instance.JWTSecretKeyID, ok = os.LookupEnv("JWT_SECRET_KEY_ID")
+		if !ok {
+			instance.JWTSecretKeyID = defaultJWTSecretKeyID
+		}
+		if retiredSecretKeysText, ok := os.LookupEnv("JWT_RETIRED_SECRET_KEYS"); ok {
+			instance.JWTRetiredSecretKeys, err = parseJWTKeys(retiredSecretKeysText)
+			if err != nil {
+				serverErr = err
+				return
+			}
+		}
+		// tokens without a kid are accepted for a grace period since the keyring rollout,
+		// only when the moment of the rollout is configured
+		if legacyKeyRetiredAtText, ok := os.LookupEnv("JWT_LEGACY_KEY_RETIRED_AT"); ok {
+			legacyKeyRetiredAt, err := strconv.ParseInt(legacyKeyRetiredAtText, 10, 64)
+			if err != nil {
+				serverErr = entity.ConvertStringToIntError
+				return
+			}
+			retiredAt := time.Unix(legacyKeyRetiredAt, 0)
+			instance.JWTLegacyKeyRetiredAt = &retiredAt
+		}
+		instance.JWTKeyGracePeriod = instance.RefreshJWTExpiresIn
+		if keyGracePeriodText, ok := os.LookupEnv("JWT_KEY_GRACE_PERIOD"); ok {
+			keyGracePeriod, err := strconv.Atoi(keyGracePeriodText)
+			if err != nil {
+				serverErr = entity.ConvertStringToIntError
+				return
+			}
+			instance.JWTKeyGracePeriod = time.Duration(keyGracePeriod) * time.Second
+		}
 		serverInstance = &instance
 	})
 	return serverInstance, serverErr
@@ -97,3 +155,28 @@ func (s *Server) Address() string {
 func (s *Server) SecureAddress() string {
 	return net.JoinHostPort(s.SecureAddr, s.SecurePort)
 }
+
+// parseJWTKeys reads a comma separated list of "<kid>:<retired at, unix sec>:<secret>" items.
+func parseJWTKeys(text string) ([]JWTKey, error) {
+	keys := make([]JWTKey, 0)
+	for _, item := range strings.Split(text, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) == 0 {
+			continue
+		}
+		parts := strings.SplitN(item, ":", 3)
+		if len(parts) != 3 || len(parts[0]) == 0 || len(parts[2]) == 0 {
+			return nil, entity.MalformedValueError
+		}
+		retiredAt, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return nil, entity.ConvertStringToIntError
+		}
+		keys = append(keys, JWTKey{
+			ID:        parts[0],
+			Secret:    parts[2],
+			RetiredAt: time.Unix(retiredAt, 0),
+		})
+	}
+	return keys, nil
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"go-tonify-backend/internal/domain/entity"
+	"os"
+	"strconv"
+	"sync"
+)
+
+type Staff struct {
+	// InitialAdminTelegramID is the telegram id of the account made admin while there is no admin yet.
+	InitialAdminTelegramID *int64
+}
+
+var (
+	staffOnce     sync.Once
+	staffError    error
+	staffInstance *Staff
+)
+
+func GetStaff() (*Staff, error) {
+	staffOnce.Do(func() {
+		var instance Staff
+		if initialAdminTelegramIDText, ok := os.LookupEnv("STAFF_INITIAL_ADMIN_TELEGRAM_ID"); ok {
+			initialAdminTelegramID, err := strconv.ParseInt(initialAdminTelegramIDText, 10, 64)
+			if err != nil {
+				staffError = entity.ConvertStringToIntError
+				return
+			}
+			instance.InitialAdminTelegramID = &initialAdminTelegramID
+		}
+		staffInstance = &instance
+	})
+	return staffInstance, staffError
+}
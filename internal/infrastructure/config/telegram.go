@@ -1,14 +1,24 @@
 package config
 
 import (
+	"encoding/hex"
 	"go-tonify-backend/internal/domain/entity"
+	"go-tonify-backend/pkg/telegram"
 	"os"
+	"strconv"
 	"sync"
+	"time"
 )
 
+const defaultTelegramInitDataMaxAge = 24 * time.Hour
+
 type Telegram struct {
-	BotToken   string
-	MiniAppURL string
+	BotToken               string
+	MiniAppURL             string
+	InitDataMaxAge         time.Duration
+	InitDataValidationMode telegram.ValidationMode
+	BotID                  int64
+	PublicKey              []byte
 }
 
 var (
@@ -22,9 +32,14 @@ func GetTelegram() (*Telegram, error) {
 		var (
 			instance Telegram
 			ok       bool
+			err      error
 		)
+		instance.InitDataValidationMode = telegram.HMACValidationMode
+		if validationModeText, ok := os.LookupEnv("TELEGRAM_INIT_DATA_VALIDATION_MODE"); ok {
+			instance.InitDataValidationMode = telegram.ValidationMode(validationModeText)
+		}
 		instance.BotToken, ok = os.LookupEnv("TELEGRAM_BOT_TOKEN")
-		if !ok {
+		if !ok && instance.InitDataValidationMode == telegram.HMACValidationMode {
 			telegramError = entity.NilError
 			return
 		}
@@ -33,6 +48,41 @@ func GetTelegram() (*Telegram, error) {
 			telegramError = entity.NilError
 			return
 		}
+		instance.InitDataMaxAge = defaultTelegramInitDataMaxAge
+		if initDataMaxAgeText, ok := os.LookupEnv("TELEGRAM_INIT_DATA_MAX_AGE"); ok {
+			initDataMaxAge, err := strconv.Atoi(initDataMaxAgeText)
+			if err != nil {
+				telegramError = entity.ConvertStringToIntError
+				return
+			}
+			instance.InitDataMaxAge = time.Duration(initDataMaxAge) * time.Second
+		}
+		switch instance.InitDataValidationMode {
+		case telegram.HMACValidationMode:
+		case telegram.Ed25519ValidationMode:
+			botIDText, ok := os.LookupEnv("TELEGRAM_BOT_ID")
+			if !ok {
+				telegramError = entity.NilError
+				return
+			}
+			instance.BotID, err = strconv.ParseInt(botIDText, 10, 64)
+			if err != nil {
+				telegramError = entity.ConvertStringToIntError
+				return
+			}
+			publicKeyText, ok := os.LookupEnv("TELEGRAM_PUBLIC_KEY")
+			if !ok {
+				publicKeyText = telegram.ProductionPublicKey
+			}
+			instance.PublicKey, err = hex.DecodeString(publicKeyText)
+			if err != nil {
+				telegramError = entity.MalformedValueError
+				return
+			}
+		default:
+			telegramError = entity.UnknownValueError
+			return
+		}
 		telegramInstance = &instance
 	})
 	return telegramInstance, telegramError
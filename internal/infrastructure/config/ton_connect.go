@@ -0,0 +1,63 @@
+package config
+
+import (
+	"go-tonify-backend/internal/domain/entity"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTonConnectProofMaxAge = 15 * time.Minute
+	defaultTonConnectNonceTTL    = 15 * time.Minute
+)
+
+type TonConnect struct {
+	// Domains a ton_proof may be issued for, the host of the mini app url when empty.
+	Domains     []string
+	ProofMaxAge time.Duration // in sec
+	NonceTTL    time.Duration // in sec
+}
+
+var (
+	tonConnectOnce     sync.Once
+	tonConnectError    error
+	tonConnectInstance *TonConnect
+)
+
+func GetTonConnect() (*TonConnect, error) {
+	tonConnectOnce.Do(func() {
+		var instance = TonConnect{
+			Domains:     make([]string, 0),
+			ProofMaxAge: defaultTonConnectProofMaxAge,
+			NonceTTL:    defaultTonConnectNonceTTL,
+		}
+		if domainsText, ok := os.LookupEnv("TON_CONNECT_DOMAINS"); ok {
+			for _, domain := range strings.Split(domainsText, ",") {
+				if domain = strings.TrimSpace(domain); len(domain) > 0 {
+					instance.Domains = append(instance.Domains, domain)
+				}
+			}
+		}
+		if proofMaxAgeText, ok := os.LookupEnv("TON_CONNECT_PROOF_MAX_AGE"); ok {
+			proofMaxAge, err := strconv.Atoi(proofMaxAgeText)
+			if err != nil {
+				tonConnectError = entity.ConvertStringToIntError
+				return
+			}
+			instance.ProofMaxAge = time.Duration(proofMaxAge) * time.Second
+		}
+		if nonceTTLText, ok := os.LookupEnv("TON_CONNECT_NONCE_TTL"); ok {
+			nonceTTL, err := strconv.Atoi(nonceTTLText)
+			if err != nil {
+				tonConnectError = entity.ConvertStringToIntError
+				return
+			}
+			instance.NonceTTL = time.Duration(nonceTTL) * time.Second
+		}
+		tonConnectInstance = &instance
+	})
+	return tonConnectInstance, tonConnectError
+}
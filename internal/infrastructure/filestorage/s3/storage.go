@@ -9,6 +9,7 @@ import (
 	"go-tonify-backend/internal/container"
 	"go-tonify-backend/internal/utils"
 	"go-tonify-backend/pkg/logger"
+	"io"
 	"mime/multipart"
 )
 
@@ -47,6 +48,19 @@ func (f *FileStorage) UploadFile(fileName string, file multipart.File) (*string,
 	return &output.Location, nil
 }
 
+func (f *FileStorage) DownloadFile(fileName string) (io.ReadCloser, error) {
+	log := f.container.GetLogger()
+	output, err := f.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: utils.NewString(f.bucketName),
+		Key:    utils.NewString(fileName),
+	})
+	if err != nil {
+		log.Error("fail to get object", logger.F("key", fileName), logger.FError(err))
+		return nil, err
+	}
+	return output.Body, nil
+}
+
 func (f *FileStorage) GetFileURL(fileName string) (*string, error) {
 	return utils.NewString(
 		fmt.Sprintf("https://%s.s3.amazonaws.com/%s", f.bucketName, fileName),
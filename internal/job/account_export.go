@@ -0,0 +1,67 @@
+package job
+
+import (
+	"context"
+	"go-tonify-backend/internal/container"
+	"go-tonify-backend/internal/domain/account/usecase"
+	"go-tonify-backend/pkg/logger"
+	"time"
+)
+
+const accountExportBatchSize = 10
+
+// AccountExport periodically builds the requested personal data exports and removes the expired ones.
+type AccountExport struct {
+	container     container.Container
+	exportUsecase usecase.Export
+}
+
+func NewAccountExport(container container.Container, exportUsecase usecase.Export) *AccountExport {
+	return &AccountExport{
+		container:     container,
+		exportUsecase: exportUsecase,
+	}
+}
+
+func (a *AccountExport) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.container.GetAccountConfig().ExportInterval)
+	defer ticker.Stop()
+	for {
+		a.process(ctx)
+		a.expire(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *AccountExport) process(ctx context.Context) {
+	log := a.container.GetLogger()
+	for {
+		processed, err := a.exportUsecase.ProcessPendingExports(ctx, accountExportBatchSize)
+		if err != nil {
+			log.Error("fail to process pending exports", logger.FError(err))
+			return
+		}
+		if processed > 0 {
+			log.Info("pending exports have been processed", logger.F("count", processed))
+		}
+		if processed < accountExportBatchSize {
+			return
+		}
+	}
+}
+
+func (a *AccountExport) expire(ctx context.Context) {
+	log := a.container.GetLogger()
+	expired, err := a.exportUsecase.ExpireExports(ctx, accountExportBatchSize)
+	if err != nil {
+		log.Error("fail to expire exports", logger.FError(err))
+		return
+	}
+	if expired > 0 {
+		log.Info("exports have been expired", logger.F("count", expired))
+	}
+}
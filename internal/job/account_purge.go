@@ -0,0 +1,54 @@
+package job
+
+import (
+	"context"
+	"go-tonify-backend/internal/container"
+	"go-tonify-backend/internal/domain/account/usecase"
+	"go-tonify-backend/pkg/logger"
+	"time"
+)
+
+const accountPurgeBatchSize = 100
+
+// AccountPurge periodically hard-deletes accounts whose deletion grace period is over.
+type AccountPurge struct {
+	container      container.Container
+	accountUsecase usecase.Account
+}
+
+func NewAccountPurge(container container.Container, accountUsecase usecase.Account) *AccountPurge {
+	return &AccountPurge{
+		container:      container,
+		accountUsecase: accountUsecase,
+	}
+}
+
+func (a *AccountPurge) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.container.GetAccountConfig().PurgeInterval)
+	defer ticker.Stop()
+	for {
+		a.purge(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *AccountPurge) purge(ctx context.Context) {
+	log := a.container.GetLogger()
+	for {
+		purged, err := a.accountUsecase.PurgeDeletedAccounts(ctx, accountPurgeBatchSize)
+		if err != nil {
+			log.Error("fail to purge deleted accounts", logger.FError(err))
+			return
+		}
+		if purged > 0 {
+			log.Info("deleted accounts have been purged", logger.F("count", purged))
+		}
+		if purged < accountPurgeBatchSize {
+			return
+		}
+	}
+}
@@ -3,3 +3,10 @@ package utils
 func NewString(text string) *string {
 	return &text
 }
+
+func NewNonEmptyString(text string) *string {
+	if len(text) == 0 {
+		return nil
+	}
+	return &text
+}
@@ -0,0 +1,47 @@
+package utils
+
+import "strings"
+
+var userAgentDevices = []struct {
+	marker string
+	device string
+}{
+	{marker: "iphone", device: "iPhone"},
+	{marker: "ipad", device: "iPad"},
+	{marker: "android", device: "Android"},
+	{marker: "windows", device: "Windows"},
+	{marker: "macintosh", device: "Mac"},
+	{marker: "mac os", device: "Mac"},
+	{marker: "linux", device: "Linux"},
+}
+
+var telegramPlatforms = map[string]string{
+	"android":  "Telegram Android",
+	"ios":      "Telegram iOS",
+	"tdesktop": "Telegram Desktop",
+	"macos":    "Telegram macOS",
+	"weba":     "Telegram Web A",
+	"webk":     "Telegram Web K",
+	"unigram":  "Unigram",
+}
+
+// DeviceName builds a short human readable device description such as "iPhone, Telegram iOS".
+func DeviceName(userAgent string, telegramPlatform string) string {
+	parts := make([]string, 0, 2)
+	lowerUserAgent := strings.ToLower(userAgent)
+	for _, userAgentDevice := range userAgentDevices {
+		if strings.Contains(lowerUserAgent, userAgentDevice.marker) {
+			parts = append(parts, userAgentDevice.device)
+			break
+		}
+	}
+	if platform, ok := telegramPlatforms[strings.ToLower(telegramPlatform)]; ok {
+		parts = append(parts, platform)
+	} else if len(telegramPlatform) > 0 {
+		parts = append(parts, "Telegram "+telegramPlatform)
+	}
+	if len(parts) == 0 {
+		return "Unknown device"
+	}
+	return strings.Join(parts, ", ")
+}
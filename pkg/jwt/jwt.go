@@ -2,25 +2,77 @@ package jwt
 
 import (
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"go-tonify-backend/pkg/jwt/model"
+	"time"
 )
 
+const keyIDHeader = "kid"
+
 type JWT struct {
-	secretKey string
+	keyring          *Keyring
+	accessExpiresIn  time.Duration
+	refreshExpiresIn time.Duration
+	now              func() time.Time
 }
 
-func NewJWT(secretKey string) *JWT {
+func NewJWT(keyring *Keyring, accessExpiresIn time.Duration, refreshExpiresIn time.Duration) *JWT {
 	return &JWT{
-		secretKey: secretKey,
+		keyring:          keyring,
+		accessExpiresIn:  accessExpiresIn,
+		refreshExpiresIn: refreshExpiresIn,
+		now:              time.Now,
 	}
 }
 
+// GeneratePairJWT issues a pair that starts a new refresh token family.
+// The family is identified by the jti of its first refresh token and
+// is carried by access tokens as the session id.
 func (j *JWT) GeneratePairJWT(accountID int64) (*model.PairToken, error) {
+	refreshID := uuid.NewString()
+	return j.generatePairJWT(accountID, refreshID, refreshID)
+}
+
+// RotatePairJWT issues a pair that continues the family of the given refresh token.
+func (j *JWT) RotatePairJWT(refreshClaimsToken *model.RefreshClaimsToken) (*model.PairToken, error) {
+	return j.generatePairJWT(refreshClaimsToken.ID, uuid.NewString(), refreshClaimsToken.FamilyID)
+}
+
+func (j *JWT) ParseAccessToken(tokenText string) (*model.AccessClaimsToken, error) {
+	var accessClaimsToken model.AccessClaimsToken
+	if err := j.parseToken(tokenText, &accessClaimsToken); err != nil {
+		return nil, err
+	}
+	if accessClaimsToken.Type != model.AccessTokenType {
+		return nil, model.JWTTypeMismatchError
+	}
+	return &accessClaimsToken, nil
+}
+
+func (j *JWT) ParseRefreshToken(tokenText string) (*model.RefreshClaimsToken, error) {
+	var refreshClaimsToken model.RefreshClaimsToken
+	if err := j.parseToken(tokenText, &refreshClaimsToken); err != nil {
+		return nil, err
+	}
+	if refreshClaimsToken.Type != model.RefreshTokenType {
+		return nil, model.JWTTypeMismatchError
+	}
+	return &refreshClaimsToken, nil
+}
+
+func (j *JWT) generatePairJWT(accountID int64, refreshID string, familyID string) (*model.PairToken, error) {
+	now := j.now()
 	accessClaimsToken := model.AccessClaimsToken{
-		ID: accountID,
+		RegisteredClaims: j.registeredClaims(uuid.NewString(), now, j.accessExpiresIn),
+		ID:               accountID,
+		Type:             model.AccessTokenType,
+		SessionID:        familyID,
 	}
 	refreshClaimsToken := model.RefreshClaimsToken{
-		ID: accountID,
+		RegisteredClaims: j.registeredClaims(refreshID, now, j.refreshExpiresIn),
+		ID:               accountID,
+		Type:             model.RefreshTokenType,
+		FamilyID:         familyID,
 	}
 	accessToken, err := j.generateToken(accessClaimsToken)
 	if err != nil {
@@ -31,40 +83,51 @@ func (j *JWT) GeneratePairJWT(accountID int64) (*model.PairToken, error) {
 		return nil, model.CreationJWTError
 	}
 	return &model.PairToken{
-		Access:  accessToken,
-		Refresh: refreshToken,
+		Access:           accessToken,
+		Refresh:          refreshToken,
+		RefreshID:        refreshID,
+		FamilyID:         familyID,
+		RefreshExpiresAt: refreshClaimsToken.ExpiresAt.Time,
 	}, nil
 }
 
-func (j *JWT) ParseAccessToken(tokenText string) (*model.AccessClaimsToken, error) {
-	var accessClaimsToken model.AccessClaimsToken
-	token, err := jwt.ParseWithClaims(tokenText, &accessClaimsToken, func(token *jwt.Token) (interface{}, error) {
-		return []byte(j.secretKey), nil
-	})
-	if err != nil {
-		return nil, err
+func (j *JWT) registeredClaims(id string, now time.Time, expiresIn time.Duration) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		ID:        id,
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(expiresIn)),
 	}
-	if !token.Valid {
-		return nil, model.JWTNotValidError
-	}
-	return &accessClaimsToken, nil
 }
 
-func (j *JWT) ParseRefreshToken(tokenText string) (*model.RefreshClaimsToken, error) {
-	var refreshClaimsToken model.RefreshClaimsToken
-	token, err := jwt.ParseWithClaims(tokenText, &refreshClaimsToken, func(token *jwt.Token) (interface{}, error) {
-		return []byte(j.secretKey), nil
-	})
+func (j *JWT) generateToken(claims jwt.Claims) (string, error) {
+	key := j.keyring.signingKey()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header[keyIDHeader] = key.ID
+	return token.SignedString(key.Secret)
+}
+
+func (j *JWT) parseToken(tokenText string, claims jwt.Claims) error {
+	token, err := jwt.ParseWithClaims(
+		tokenText,
+		claims,
+		func(token *jwt.Token) (interface{}, error) {
+			keyID, ok := token.Header[keyIDHeader].(string)
+			if !ok || len(keyID) == 0 {
+				return j.keyring.legacyVerificationKey(j.now())
+			}
+			return j.keyring.verificationKey(keyID, j.now())
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithTimeFunc(j.now),
+	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if !token.Valid {
-		return nil, model.JWTNotValidError
+		return model.JWTNotValidError
 	}
-	return &refreshClaimsToken, nil
-}
-
-func (j *JWT) generateToken(claims jwt.Claims) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(j.secretKey))
+	return nil
 }
@@ -0,0 +1,123 @@
+package jwt
+
+import (
+	"errors"
+	"github.com/golang-jwt/jwt/v5"
+	"go-tonify-backend/pkg/jwt/model"
+	"testing"
+	"time"
+)
+
+func TestPairJWT(t *testing.T) {
+	keyring := NewKeyring(Key{ID: "primary", Secret: []byte("secret")}, nil, nil, time.Hour)
+	provider := NewJWT(keyring, time.Minute, time.Hour)
+	pairToken, err := provider.GeneratePairJWT(42)
+	if err != nil {
+		t.Fatal("fail to generate pair token", err)
+	}
+	t.Run("parse access token", func(t *testing.T) {
+		claims, err := provider.ParseAccessToken(pairToken.Access)
+		if err != nil {
+			t.Fatal("fail to parse access token", err)
+		}
+		if claims.ID != 42 || claims.RegisteredClaims.ID == "" || claims.ExpiresAt == nil {
+			t.Error("unexpected access claims", claims)
+		}
+	})
+	t.Run("refresh token is not an access token", func(t *testing.T) {
+		if _, err := provider.ParseAccessToken(pairToken.Refresh); err != model.JWTTypeMismatchError {
+			t.Error("expected type mismatch error, got", err)
+		}
+	})
+	t.Run("access token is not a refresh token", func(t *testing.T) {
+		if _, err := provider.ParseRefreshToken(pairToken.Access); err != model.JWTTypeMismatchError {
+			t.Error("expected type mismatch error, got", err)
+		}
+	})
+	t.Run("expired access token", func(t *testing.T) {
+		provider.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+		defer func() { provider.now = time.Now }()
+		if _, err := provider.ParseAccessToken(pairToken.Access); err == nil {
+			t.Error("expected expired access token to be rejected")
+		}
+	})
+}
+
+func TestKeyRotation(t *testing.T) {
+	oldKeyring := NewKeyring(Key{ID: "old", Secret: []byte("old-secret")}, nil, nil, 0)
+	pairToken, err := NewJWT(oldKeyring, time.Hour, time.Hour).GeneratePairJWT(7)
+	if err != nil {
+		t.Fatal("fail to generate pair token", err)
+	}
+	retiredAt := time.Now()
+	retiredKey := Key{ID: "old", Secret: []byte("old-secret"), RetiredAt: &retiredAt}
+	tests := []struct {
+		name        string
+		gracePeriod time.Duration
+		retired     []Key
+		expectedErr error
+	}{
+		{name: "retired key within grace period", gracePeriod: time.Hour, retired: []Key{retiredKey}},
+		{name: "retired key after grace period", gracePeriod: 0, retired: []Key{retiredKey}, expectedErr: model.RetiredKeyError},
+		{name: "unknown key", gracePeriod: time.Hour, expectedErr: model.UnknownKeyIDError},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			keyring := NewKeyring(Key{ID: "new", Secret: []byte("new-secret")}, test.retired, nil, test.gracePeriod)
+			provider := NewJWT(keyring, time.Hour, time.Hour)
+			provider.now = func() time.Time { return retiredAt.Add(time.Minute) }
+			_, err := provider.ParseAccessToken(pairToken.Access)
+			if test.expectedErr == nil && err != nil {
+				t.Error("expected token to be accepted, got", err)
+			}
+			if test.expectedErr != nil && (err == nil || !errors.Is(err, test.expectedErr)) {
+				t.Error("expected", test.expectedErr, "got", err)
+			}
+		})
+	}
+}
+
+func TestLegacyKey(t *testing.T) {
+	rolledOutAt := time.Now()
+	signLegacyToken := func(expiresAt *jwt.NumericDate) string {
+		claims := model.AccessClaimsToken{
+			RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: expiresAt},
+			ID:               7,
+			Type:             model.AccessTokenType,
+		}
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		if err != nil {
+			t.Fatal("fail to generate legacy token", err)
+		}
+		return token
+	}
+	legacyToken := signLegacyToken(jwt.NewNumericDate(rolledOutAt.Add(24 * time.Hour)))
+	legacyKey := &Key{Secret: []byte("secret"), RetiredAt: &rolledOutAt}
+	tests := []struct {
+		name        string
+		token       string
+		legacy      *Key
+		now         time.Time
+		expectedErr error
+	}{
+		{name: "kid-less token within grace period", token: legacyToken, legacy: legacyKey, now: rolledOutAt.Add(time.Minute)},
+		{name: "kid-less token after grace period", token: legacyToken, legacy: legacyKey, now: rolledOutAt.Add(2 * time.Hour), expectedErr: model.RetiredKeyError},
+		{name: "kid-less token without legacy key", token: legacyToken, now: rolledOutAt.Add(time.Minute), expectedErr: model.MissingKeyIDError},
+		{name: "legacy key without rollout time", token: legacyToken, legacy: &Key{Secret: []byte("secret")}, now: rolledOutAt.Add(time.Minute), expectedErr: model.MissingKeyIDError},
+		{name: "kid-less token without expiration", token: signLegacyToken(nil), legacy: legacyKey, now: rolledOutAt.Add(time.Minute), expectedErr: jwt.ErrTokenRequiredClaimMissing},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			keyring := NewKeyring(Key{ID: "primary", Secret: []byte("secret")}, nil, test.legacy, time.Hour)
+			provider := NewJWT(keyring, time.Hour, time.Hour)
+			provider.now = func() time.Time { return test.now }
+			claims, err := provider.ParseAccessToken(test.token)
+			if test.expectedErr == nil && (err != nil || claims.ID != 7) {
+				t.Error("expected token to be accepted, got", err)
+			}
+			if test.expectedErr != nil && (err == nil || !errors.Is(err, test.expectedErr)) {
+				t.Error("expected", test.expectedErr, "got", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package jwt
+
+import (
+	"go-tonify-backend/pkg/jwt/model"
+	"time"
+)
+
+// Key is a secret tagged with the kid header value written into tokens signed by it.
+// A key with RetiredAt set is no longer used for signing.
+type Key struct {
+	ID        string
+	Secret    []byte
+	RetiredAt *time.Time
+}
+
+// Keyring signs with the active key and still verifies tokens signed by retired keys
+// until their grace period runs out, so a secret rotation does not invalidate live sessions.
+// The legacy key verifies the tokens issued before kids were written into the header.
+type Keyring struct {
+	active      Key
+	retired     map[string]Key
+	legacy      *Key
+	gracePeriod time.Duration
+}
+
+func NewKeyring(active Key, retired []Key, legacy *Key, gracePeriod time.Duration) *Keyring {
+	retiredKeys := make(map[string]Key, len(retired))
+	for _, key := range retired {
+		retiredKeys[key.ID] = key
+	}
+	return &Keyring{
+		active:      active,
+		retired:     retiredKeys,
+		legacy:      legacy,
+		gracePeriod: gracePeriod,
+	}
+}
+
+func (k *Keyring) signingKey() Key {
+	return k.active
+}
+
+func (k *Keyring) verificationKey(keyID string, now time.Time) ([]byte, error) {
+	if keyID == k.active.ID {
+		return k.active.Secret, nil
+	}
+	key, ok := k.retired[keyID]
+	if !ok {
+		return nil, model.UnknownKeyIDError
+	}
+	if key.RetiredAt != nil && now.After(key.RetiredAt.Add(k.gracePeriod)) {
+		return nil, model.RetiredKeyError
+	}
+	return key.Secret, nil
+}
+
+// legacyVerificationKey fails closed: without a legacy key or its retirement time kid-less tokens are rejected.
+func (k *Keyring) legacyVerificationKey(now time.Time) ([]byte, error) {
+	if k.legacy == nil || k.legacy.RetiredAt == nil {
+		return nil, model.MissingKeyIDError
+	}
+	if now.After(k.legacy.RetiredAt.Add(k.gracePeriod)) {
+		return nil, model.RetiredKeyError
+	}
+	return k.legacy.Secret, nil
+}
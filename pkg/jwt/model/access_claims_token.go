@@ -4,5 +4,7 @@ import "github.com/golang-jwt/jwt/v5"
 
 type AccessClaimsToken struct {
 	jwt.RegisteredClaims
-	ID int64
+	ID        int64
+	Type      TokenType `json:"typ"`
+	SessionID string    `json:"sid"`
 }
@@ -3,6 +3,10 @@ package model
 import "errors"
 
 var (
-	JWTNotValidError = errors.New("jwt isn't valid")
-	CreationJWTError = errors.New("creation jwt error")
+	JWTNotValidError     = errors.New("jwt isn't valid")
+	CreationJWTError     = errors.New("creation jwt error")
+	JWTTypeMismatchError = errors.New("jwt has unexpected type")
+	MissingKeyIDError    = errors.New("jwt header has no key id")
+	UnknownKeyIDError    = errors.New("jwt is signed by an unknown key")
+	RetiredKeyError      = errors.New("jwt is signed by a retired key")
 )
@@ -1,6 +1,11 @@
 package model
 
+import "time"
+
 type PairToken struct {
-	Access  string `json:"access_token"`
-	Refresh string `json:"refresh_token"`
+	Access           string    `json:"access_token"`
+	Refresh          string    `json:"refresh_token"`
+	RefreshID        string    `json:"-"`
+	FamilyID         string    `json:"-"`
+	RefreshExpiresAt time.Time `json:"-"`
 }
@@ -4,5 +4,7 @@ import "github.com/golang-jwt/jwt/v5"
 
 type RefreshClaimsToken struct {
 	jwt.RegisteredClaims
-	ID int64
+	ID       int64
+	Type     TokenType `json:"typ"`
+	FamilyID string    `json:"fam"`
 }
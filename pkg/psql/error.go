@@ -0,0 +1,17 @@
+package psql
+
+import (
+	"errors"
+	"github.com/lib/pq"
+)
+
+const uniqueViolationCode = "23505"
+
+// IsUniqueViolation reports whether err is a violation of the unique constraint or index with the given name.
+func IsUniqueViolation(err error, constraint string) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+	return pqErr.Code == uniqueViolationCode && pqErr.Constraint == constraint
+}
@@ -0,0 +1,32 @@
+package psql
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/lib/pq"
+	"testing"
+)
+
+func TestIsUniqueViolation(t *testing.T) {
+	uniqueViolation := &pq.Error{Code: "23505", Constraint: "account_nickname_unique_idx"}
+	testCases := []struct {
+		name       string
+		err        error
+		constraint string
+		expected   bool
+	}{
+		{name: "unique violation", err: uniqueViolation, constraint: "account_nickname_unique_idx", expected: true},
+		{name: "wrapped unique violation", err: fmt.Errorf("create account: %w", uniqueViolation), constraint: "account_nickname_unique_idx", expected: true},
+		{name: "other constraint", err: uniqueViolation, constraint: "account_telegram_id_key", expected: false},
+		{name: "other code", err: &pq.Error{Code: "23503", Constraint: "account_nickname_unique_idx"}, constraint: "account_nickname_unique_idx", expected: false},
+		{name: "not a postgres error", err: sql.ErrNoRows, constraint: "account_nickname_unique_idx", expected: false},
+		{name: "nil error", err: nil, constraint: "account_nickname_unique_idx", expected: false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if actual := IsUniqueViolation(testCase.err, testCase.constraint); actual != testCase.expected {
+				t.Errorf("expected %v, got %v", testCase.expected, actual)
+			}
+		})
+	}
+}
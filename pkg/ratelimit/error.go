@@ -0,0 +1,7 @@
+package ratelimit
+
+import "errors"
+
+var (
+	InvalidLimitError = errors.New("rate limit must have positive requests and period")
+)
@@ -0,0 +1,87 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often idle buckets are dropped from memory.
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	period    time.Duration
+}
+
+type memory struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	sweptAt time.Time
+	now     func() time.Time
+}
+
+func NewMemoryLimiter() Limiter {
+	return &memory{
+		buckets: make(map[string]*bucket),
+		sweptAt: time.Now(),
+		now:     time.Now,
+	}
+}
+
+func (m *memory) Allow(_ context.Context, key string, limit Limit) (*Result, error) {
+	if limit.Disabled() {
+		return nil, InvalidLimitError
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	m.sweep(now)
+	capacity := float64(limit.Requests)
+	rate := limit.rate()
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{
+			tokens:    capacity,
+			updatedAt: now,
+		}
+		m.buckets[key] = b
+	}
+	if elapsed := now.Sub(b.updatedAt); elapsed > 0 {
+		b.tokens = math.Min(capacity, b.tokens+elapsed.Seconds()*rate)
+	}
+	b.updatedAt = now
+	b.period = limit.Period
+	result := Result{
+		Limit: limit.Requests,
+	}
+	if b.tokens >= 1 {
+		b.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = secondsToDuration((1 - b.tokens) / rate)
+	}
+	result.Remaining = int(math.Floor(b.tokens))
+	result.ResetAfter = secondsToDuration((capacity - b.tokens) / rate)
+	return &result, nil
+}
+
+// sweep drops buckets that have been idle long enough to be full again,
+// a new bucket for the same key starts full as well.
+func (m *memory) sweep(now time.Time) {
+	if now.Sub(m.sweptAt) < sweepInterval {
+		return
+	}
+	for key, b := range m.buckets {
+		if now.Sub(b.updatedAt) >= b.period {
+			delete(m.buckets, key)
+		}
+	}
+	m.sweptAt = now
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Ceil(seconds * float64(time.Second)))
+}
@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryLimiter(t *testing.T) {
+	now := time.Now()
+	limiter := NewMemoryLimiter().(*memory)
+	limiter.now = func() time.Time { return now }
+	limit := Limit{Requests: 3, Period: 3 * time.Second}
+	ctx := context.Background()
+	t.Run("burst up to the limit", func(t *testing.T) {
+		for i := 2; i >= 0; i-- {
+			result, err := limiter.Allow(ctx, "account:1", limit)
+			if err != nil {
+				t.Fatal("fail to allow request", err)
+			}
+			if !result.Allowed || result.Remaining != i || result.Limit != 3 {
+				t.Error("unexpected result", result)
+			}
+		}
+	})
+	t.Run("reject when the bucket is empty", func(t *testing.T) {
+		result, err := limiter.Allow(ctx, "account:1", limit)
+		if err != nil {
+			t.Fatal("fail to allow request", err)
+		}
+		if result.Allowed || result.RetryAfter != time.Second || result.ResetAfter != 3*time.Second {
+			t.Error("unexpected result", result)
+		}
+	})
+	t.Run("keys do not share a bucket", func(t *testing.T) {
+		result, err := limiter.Allow(ctx, "account:2", limit)
+		if err != nil {
+			t.Fatal("fail to allow request", err)
+		}
+		if !result.Allowed || result.Remaining != 2 {
+			t.Error("unexpected result", result)
+		}
+	})
+	t.Run("refill over time", func(t *testing.T) {
+		now = now.Add(time.Second)
+		result, err := limiter.Allow(ctx, "account:1", limit)
+		if err != nil {
+			t.Fatal("fail to allow request", err)
+		}
+		if !result.Allowed || result.Remaining != 0 {
+			t.Error("unexpected result", result)
+		}
+	})
+	t.Run("sweep idle buckets", func(t *testing.T) {
+		now = now.Add(sweepInterval)
+		if _, err := limiter.Allow(ctx, "account:3", limit); err != nil {
+			t.Fatal("fail to allow request", err)
+		}
+		if len(limiter.buckets) != 1 {
+			t.Error("expected idle buckets to be dropped, got", len(limiter.buckets))
+		}
+	})
+	t.Run("disabled limit", func(t *testing.T) {
+		if _, err := limiter.Allow(ctx, "account:1", Limit{}); err != InvalidLimitError {
+			t.Error("expected invalid limit error, got", err)
+		}
+	})
+}
@@ -0,0 +1,37 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limit allows Requests per Period, a full bucket lets a client burst all of them at once.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+}
+
+func (l Limit) Disabled() bool {
+	return l.Requests <= 0 || l.Period <= 0
+}
+
+// rate returns the number of tokens restored per second.
+func (l Limit) rate() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// RetryAfter is the time until the next request is allowed, zero when Allowed.
+	RetryAfter time.Duration
+	// ResetAfter is the time until the bucket is full again.
+	ResetAfter time.Duration
+}
+
+// Limiter is a token bucket limiter, a shared backend has to implement it
+// to limit clients across several instances of the server.
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (*Result, error)
+}
@@ -1,5 +1,6 @@
 package bot
 
 const (
-	SendPhotoMethod string = "sendPhoto"
+	SendPhotoMethod   string = "sendPhoto"
+	SendMessageMethod string = "sendMessage"
 )
@@ -0,0 +1,62 @@
+package telegram
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"go-tonify-backend/pkg/telegram/model"
+	"strconv"
+	"time"
+)
+
+// LoginWidget verifies payloads of the Telegram Login Widget, its secret key is SHA256 of the bot token.
+type LoginWidget struct {
+	Token string
+	// MaxAge rejects payloads whose auth_date is older, zero disables the freshness check.
+	MaxAge time.Duration
+
+	now func() time.Time
+}
+
+func (l *LoginWidget) Validate(telegramLoginWidget *model.TelegramLoginWidget) error {
+	if len(l.Token) == 0 {
+		return model.MissingBotTokenError
+	}
+	secretKey := sha256.Sum256([]byte(l.Token))
+	generatedHash, err := GetSHA256Signature([]byte(DataCheckString(loginWidgetFields(telegramLoginWidget))), secretKey[:])
+	if err != nil {
+		return err
+	}
+	receivedHash, err := hex.DecodeString(telegramLoginWidget.Hash)
+	if err != nil {
+		return model.TelegramInitDataHashMismatchError
+	}
+	if !hmac.Equal(generatedHash, receivedHash) {
+		return model.TelegramInitDataHashMismatchError
+	}
+	initData := InitData{
+		MaxAge: l.MaxAge,
+		now:    l.now,
+	}
+	return initData.validateAuthDate(telegramLoginWidget.AuthDate)
+}
+
+// loginWidgetFields keeps only the fields the widget has sent, optional ones are omitted when empty.
+func loginWidgetFields(telegramLoginWidget *model.TelegramLoginWidget) map[string]string {
+	fields := map[string]string{
+		"id":        strconv.FormatInt(telegramLoginWidget.ID, 10),
+		"auth_date": strconv.FormatUint(uint64(telegramLoginWidget.AuthDate), 10),
+	}
+	optionalFields := map[string]string{
+		"first_name": telegramLoginWidget.FirstName,
+		"last_name":  telegramLoginWidget.LastName,
+		"username":   telegramLoginWidget.Username,
+		"photo_url":  telegramLoginWidget.PhotoURL,
+	}
+	for key, value := range optionalFields {
+		if len(value) > 0 {
+			fields[key] = value
+		}
+	}
+	return fields
+}
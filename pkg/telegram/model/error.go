@@ -2,4 +2,13 @@ package model
 
 import "errors"
 
-var TelegramInitDataDecodeError = errors.New("telegram init data decode error")
+var (
+	TelegramInitDataDecodeError            = errors.New("telegram init data decode error")
+	TelegramInitDataHashMismatchError      = errors.New("telegram init data hash mismatch")
+	TelegramInitDataExpiredError           = errors.New("telegram init data has expired")
+	TelegramInitDataSignatureMismatchError = errors.New("telegram init data signature mismatch")
+	InvalidPublicKeyError                  = errors.New("invalid ed25519 public key")
+	MissingBotTokenError                   = errors.New("telegram bot token is missing")
+	UnknownValidationModeError             = errors.New("unknown telegram init data validation mode")
+	TelegramInitDataFromFutureError        = errors.New("telegram init data is issued in the future")
+)
@@ -0,0 +1,9 @@
+package model
+
+type TelegramChat struct {
+	ID       int64  `json:"id"`
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Username string `json:"username"`
+	PhotoURL string `json:"photo_url"`
+}
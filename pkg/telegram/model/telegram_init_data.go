@@ -4,6 +4,15 @@ type TelegramInitData struct {
 	QueryID             string
 	TelegramUserPayload string
 	TelegramUser        TelegramUser
+	Receiver            *TelegramUser
+	Chat                *TelegramChat
+	ChatType            string
+	ChatInstance        string
+	StartParam          string
+	CanSendAfter        uint
 	AuthDate            uint
+	Signature           string
 	Hash                string
+	// Fields keeps every received field except hash, the data-check-string is built from them.
+	Fields map[string]string
 }
@@ -0,0 +1,11 @@
+package model
+
+type TelegramLoginWidget struct {
+	ID        int64
+	FirstName string
+	LastName  string
+	Username  string
+	PhotoURL  string
+	AuthDate  uint
+	Hash      string
+}
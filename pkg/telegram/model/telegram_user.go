@@ -1,10 +1,14 @@
 package model
 
 type TelegramUser struct {
-	ID           int64  `json:"id"`
-	FirstName    string `json:"first_name"`
-	LastName     string `json:"last_name"`
-	Username     string `json:"username"`
-	LanguageCode string `json:"language_code"`
-	IsPremium    bool   `json:"is_premium"`
+	ID                    int64  `json:"id"`
+	IsBot                 bool   `json:"is_bot"`
+	FirstName             string `json:"first_name"`
+	LastName              string `json:"last_name"`
+	Username              string `json:"username"`
+	LanguageCode          string `json:"language_code"`
+	IsPremium             bool   `json:"is_premium"`
+	AddedToAttachmentMenu bool   `json:"added_to_attachment_menu"`
+	AllowsWriteToPM       bool   `json:"allows_write_to_pm"`
+	PhotoURL              string `json:"photo_url"`
 }
@@ -1,18 +1,33 @@
 package telegram
 
 import (
+	"crypto/ed25519"
 	"crypto/hmac"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
-	"fmt"
 	"go-tonify-backend/pkg/telegram/model"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 )
 
+// allowedClockSkew tolerates auth_date slightly ahead of the server clock.
+const allowedClockSkew = time.Minute
+
 type InitData struct {
+	Mode  ValidationMode
 	Token string
+	// BotID and PublicKey are used by Ed25519ValidationMode.
+	BotID     int64
+	PublicKey ed25519.PublicKey
+	// MaxAge rejects init data whose auth_date is older, zero disables the freshness check.
+	MaxAge time.Duration
+
+	now func() time.Time
 }
 
 func (i *InitData) Decode(data string) (*model.TelegramInitData, error) {
@@ -20,63 +35,156 @@ func (i *InitData) Decode(data string) (*model.TelegramInitData, error) {
 	if err != nil {
 		return nil, err
 	}
-	queryIDs := values["query_id"]
-	if len(queryIDs) != 1 {
-		return nil, model.TelegramInitDataDecodeError
+	var telegramInitData = model.TelegramInitData{
+		Fields: make(map[string]string, len(values)),
+	}
+	for key, value := range values {
+		if len(value) != 1 {
+			return nil, model.TelegramInitDataDecodeError
+		}
+		if key == "hash" {
+			telegramInitData.Hash = value[0]
+			continue
+		}
+		telegramInitData.Fields[key] = value[0]
 	}
-	queryID := queryIDs[0]
-	users := values["user"]
-	if len(users) != 1 {
+	if len(telegramInitData.Hash) == 0 {
 		return nil, model.TelegramInitDataDecodeError
 	}
-	payloadUser := users[0]
-	var telegramUser model.TelegramUser
-	if err := json.Unmarshal([]byte(payloadUser), &telegramUser); err != nil {
+	fields := telegramInitData.Fields
+	payloadUser, ok := fields["user"]
+	if !ok {
 		return nil, model.TelegramInitDataDecodeError
 	}
-	authDates := values["auth_date"]
-	if len(authDates) != 1 {
+	if err := json.Unmarshal([]byte(payloadUser), &telegramInitData.TelegramUser); err != nil {
 		return nil, model.TelegramInitDataDecodeError
 	}
-	authDate, err := strconv.Atoi(authDates[0])
+	telegramInitData.TelegramUserPayload = payloadUser
+	authDate, err := strconv.ParseUint(fields["auth_date"], 10, 64)
 	if err != nil {
 		return nil, model.TelegramInitDataDecodeError
 	}
-	hashes := values["hash"]
-	if len(hashes) != 1 {
-		return nil, model.TelegramInitDataDecodeError
+	telegramInitData.AuthDate = uint(authDate)
+	if payloadReceiver, ok := fields["receiver"]; ok {
+		var receiver model.TelegramUser
+		if err := json.Unmarshal([]byte(payloadReceiver), &receiver); err != nil {
+			return nil, model.TelegramInitDataDecodeError
+		}
+		telegramInitData.Receiver = &receiver
+	}
+	if payloadChat, ok := fields["chat"]; ok {
+		var chat model.TelegramChat
+		if err := json.Unmarshal([]byte(payloadChat), &chat); err != nil {
+			return nil, model.TelegramInitDataDecodeError
+		}
+		telegramInitData.Chat = &chat
+	}
+	if canSendAfterText, ok := fields["can_send_after"]; ok {
+		canSendAfter, err := strconv.ParseUint(canSendAfterText, 10, 64)
+		if err != nil {
+			return nil, model.TelegramInitDataDecodeError
+		}
+		telegramInitData.CanSendAfter = uint(canSendAfter)
+	}
+	telegramInitData.QueryID = fields["query_id"]
+	telegramInitData.ChatType = fields["chat_type"]
+	telegramInitData.ChatInstance = fields["chat_instance"]
+	telegramInitData.StartParam = fields["start_param"]
+	telegramInitData.Signature = fields["signature"]
+	return &telegramInitData, nil
+}
+
+func (i *InitData) Validate(telegramInitData *model.TelegramInitData) error {
+	var err error
+	switch i.Mode {
+	case Ed25519ValidationMode:
+		err = i.validateSignature(telegramInitData)
+	case HMACValidationMode, "":
+		err = i.validateHash(telegramInitData)
+	default:
+		err = model.UnknownValidationModeError
+	}
+	if err != nil {
+		return err
 	}
-	hash := hashes[0]
-	return &model.TelegramInitData{
-		QueryID:             queryID,
-		TelegramUserPayload: payloadUser,
-		TelegramUser:        telegramUser,
-		AuthDate:            uint(authDate),
-		Hash:                hash,
-	}, nil
+	return i.validateAuthDate(telegramInitData.AuthDate)
 }
 
-func (i *InitData) Validate(telegramInitData *model.TelegramInitData) (bool, error) {
-	dataCheckString := fmt.Sprintf(
-		"auth_date=%d\nquery_id=%s\nuser=%s",
-		telegramInitData.AuthDate,
-		telegramInitData.QueryID,
-		telegramInitData.TelegramUserPayload,
-	)
+func (i *InitData) validateHash(telegramInitData *model.TelegramInitData) error {
 	telegramKeyWebAppData := []byte("WebAppData")
 	secretKey, err := GetSHA256Signature([]byte(i.Token), telegramKeyWebAppData)
 	if err != nil {
-		return false, err
+		return err
 	}
-	generatedHash, err := GetSHA256Signature([]byte(dataCheckString), secretKey)
+	generatedHash, err := GetSHA256Signature([]byte(DataCheckString(telegramInitData.Fields)), secretKey)
 	if err != nil {
-		return false, err
+		return err
 	}
-	generatedHexHash := hex.EncodeToString(generatedHash)
-	if generatedHexHash == telegramInitData.Hash {
-		return true, nil
+	receivedHash, err := hex.DecodeString(telegramInitData.Hash)
+	if err != nil {
+		return model.TelegramInitDataHashMismatchError
+	}
+	if !hmac.Equal(generatedHash, receivedHash) {
+		return model.TelegramInitDataHashMismatchError
+	}
+	return nil
+}
+
+func (i *InitData) validateSignature(telegramInitData *model.TelegramInitData) error {
+	if len(i.PublicKey) != ed25519.PublicKeySize {
+		return model.InvalidPublicKeyError
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(telegramInitData.Signature, "="))
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return model.TelegramInitDataSignatureMismatchError
+	}
+	message := ThirdPartyDataCheckString(i.BotID, telegramInitData.Fields)
+	if !ed25519.Verify(i.PublicKey, []byte(message), signature) {
+		return model.TelegramInitDataSignatureMismatchError
+	}
+	return nil
+}
+
+func (i *InitData) validateAuthDate(authDate uint) error {
+	now := time.Now()
+	if i.now != nil {
+		now = i.now()
+	}
+	issuedAt := time.Unix(int64(authDate), 0)
+	if issuedAt.After(now.Add(allowedClockSkew)) {
+		return model.TelegramInitDataFromFutureError
+	}
+	if i.MaxAge > 0 && now.Sub(issuedAt) > i.MaxAge {
+		return model.TelegramInitDataExpiredError
+	}
+	return nil
+}
+
+// DataCheckString joins "key=value" pairs sorted by key with a line feed.
+func DataCheckString(fields map[string]string) string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+fields[key])
+	}
+	return strings.Join(pairs, "\n")
+}
+
+// ThirdPartyDataCheckString prefixes the data-check-string with "<bot_id>:WebAppData",
+// the signature field itself is not signed.
+func ThirdPartyDataCheckString(botID int64, fields map[string]string) string {
+	signedFields := make(map[string]string, len(fields))
+	for key, value := range fields {
+		if key == "signature" {
+			continue
+		}
+		signedFields[key] = value
 	}
-	return true, nil
+	return strconv.FormatInt(botID, 10) + ":WebAppData\n" + DataCheckString(signedFields)
 }
 
 func GetSHA256Signature(msg, key []byte) ([]byte, error) {
@@ -1,7 +1,16 @@
 package telegram
 
 import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"go-tonify-backend/pkg/telegram/model"
+	"net/url"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestDecodeTelegramInitData(t *testing.T) {
@@ -16,3 +25,409 @@ func TestDecodeTelegramInitData(t *testing.T) {
 		}
 	})
 }
+
+const testBotToken = "7012345678:AAF_test-bot-token"
+
+const testUserPayload = `{"id":355654520,"first_name":"Sergey","last_name":"Konar","username":"sergey_konar","language_code":"ru","is_premium":true,"allows_write_to_pm":true}`
+
+// signInitData builds init data the way Telegram does: all fields are signed, hash is appended.
+func signInitData(t *testing.T, token string, fields map[string]string) string {
+	t.Helper()
+	secretKey, err := GetSHA256Signature([]byte(token), []byte("WebAppData"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := GetSHA256Signature([]byte(DataCheckString(fields)), secretKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := url.Values{}
+	for key, value := range fields {
+		values.Set(key, value)
+	}
+	values.Set("hash", hex.EncodeToString(hash))
+	return values.Encode()
+}
+
+func TestValidateTelegramInitData(t *testing.T) {
+	now := time.Unix(1732181719, 0)
+	authDate := strconv.FormatInt(now.Add(-time.Minute).Unix(), 10)
+	tests := []struct {
+		name   string
+		token  string
+		fields map[string]string
+		tamper func(string) string
+		maxAge time.Duration
+		err    error
+	}{
+		{
+			name:  "inline mode with query id",
+			token: testBotToken,
+			fields: map[string]string{
+				"query_id":  "AAF42zIVAAAAAHjbMhWPTccE",
+				"user":      testUserPayload,
+				"auth_date": authDate,
+			},
+		},
+		{
+			name:  "chat launch without query id",
+			token: testBotToken,
+			fields: map[string]string{
+				"user":          testUserPayload,
+				"chat_instance": "-4315698765432109876",
+				"chat_type":     "sender",
+				"start_param":   "ref_42",
+				"auth_date":     authDate,
+			},
+		},
+		{
+			name:  "attachment menu with receiver and chat",
+			token: testBotToken,
+			fields: map[string]string{
+				"user":           testUserPayload,
+				"receiver":       `{"id":123456789,"first_name":"Anna","username":"anna"}`,
+				"chat":           `{"id":-1001234567890,"type":"group","title":"Tonify"}`,
+				"chat_type":      "group",
+				"chat_instance":  "8428209589180549439",
+				"can_send_after": "10",
+				"signature":      "fzsMe4hquuUM85C9YukEGStFeTJKJkBYe3caJhimZExWDOKjuivsB-0rPcEHs_6lPIlATa7DLUqtM0qIeOmtDQ",
+				"auth_date":      authDate,
+			},
+		},
+		{
+			name:  "signed with another bot token",
+			token: "7012345678:another-token",
+			fields: map[string]string{
+				"user":      testUserPayload,
+				"auth_date": authDate,
+			},
+			err: model.TelegramInitDataHashMismatchError,
+		},
+		{
+			name:  "tampered field",
+			token: testBotToken,
+			fields: map[string]string{
+				"user":        testUserPayload,
+				"start_param": "ref_42",
+				"auth_date":   authDate,
+			},
+			tamper: func(data string) string {
+				return strings.Replace(data, "ref_42", "ref_43", 1)
+			},
+			err: model.TelegramInitDataHashMismatchError,
+		},
+		{
+			name:  "malformed hash",
+			token: testBotToken,
+			fields: map[string]string{
+				"user":      testUserPayload,
+				"auth_date": authDate,
+			},
+			tamper: func(data string) string {
+				values, _ := url.ParseQuery(data)
+				values.Set("hash", "zz")
+				return values.Encode()
+			},
+			err: model.TelegramInitDataHashMismatchError,
+		},
+		{
+			name:  "older than max age",
+			token: testBotToken,
+			fields: map[string]string{
+				"user":      testUserPayload,
+				"auth_date": strconv.FormatInt(now.Add(-2*time.Hour).Unix(), 10),
+			},
+			maxAge: time.Hour,
+			err:    model.TelegramInitDataExpiredError,
+		},
+		{
+			name:  "within max age",
+			token: testBotToken,
+			fields: map[string]string{
+				"user":      testUserPayload,
+				"auth_date": strconv.FormatInt(now.Add(-30*time.Minute).Unix(), 10),
+			},
+			maxAge: time.Hour,
+		},
+		{
+			name:  "issued in the future",
+			token: testBotToken,
+			fields: map[string]string{
+				"user":      testUserPayload,
+				"auth_date": strconv.FormatInt(now.Add(time.Hour).Unix(), 10),
+			},
+			err: model.TelegramInitDataFromFutureError,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := signInitData(t, test.token, test.fields)
+			if test.tamper != nil {
+				data = test.tamper(data)
+			}
+			initData := InitData{
+				Token:  testBotToken,
+				MaxAge: test.maxAge,
+				now:    func() time.Time { return now },
+			}
+			telegramInitData, err := initData.Decode(data)
+			if err != nil {
+				t.Fatal("fail to decode", err)
+			}
+			if err := initData.Validate(telegramInitData); err != test.err {
+				t.Errorf("expected %v, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestDecodeTelegramInitDataFields(t *testing.T) {
+	data := signInitData(t, testBotToken, map[string]string{
+		"user":           testUserPayload,
+		"receiver":       `{"id":123456789,"first_name":"Anna"}`,
+		"chat":           `{"id":-1001234567890,"type":"group","title":"Tonify"}`,
+		"chat_type":      "group",
+		"chat_instance":  "8428209589180549439",
+		"start_param":    "ref_42",
+		"can_send_after": "10",
+		"auth_date":      "1732181719",
+	})
+	initData := InitData{Token: testBotToken}
+	telegramInitData, err := initData.Decode(data)
+	if err != nil {
+		t.Fatal("fail to decode", err)
+	}
+	if telegramInitData.TelegramUser.ID != 355654520 || !telegramInitData.TelegramUser.AllowsWriteToPM {
+		t.Errorf("unexpected user %+v", telegramInitData.TelegramUser)
+	}
+	if telegramInitData.Receiver == nil || telegramInitData.Receiver.ID != 123456789 {
+		t.Errorf("unexpected receiver %+v", telegramInitData.Receiver)
+	}
+	if telegramInitData.Chat == nil || telegramInitData.Chat.Title != "Tonify" {
+		t.Errorf("unexpected chat %+v", telegramInitData.Chat)
+	}
+	if telegramInitData.ChatType != "group" || telegramInitData.StartParam != "ref_42" ||
+		telegramInitData.CanSendAfter != 10 || telegramInitData.AuthDate != 1732181719 {
+		t.Errorf("unexpected init data %+v", telegramInitData)
+	}
+	if _, ok := telegramInitData.Fields["hash"]; ok {
+		t.Error("hash must not be a part of the data-check-string")
+	}
+}
+
+func TestDecodeMalformedTelegramInitData(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "missing hash", data: "user=%7B%22id%22%3A1%7D&auth_date=1732181719"},
+		{name: "missing user", data: "auth_date=1732181719&hash=ab"},
+		{name: "missing auth date", data: "user=%7B%22id%22%3A1%7D&hash=ab"},
+		{name: "duplicated field", data: "user=%7B%22id%22%3A1%7D&auth_date=1&auth_date=2&hash=ab"},
+		{name: "malformed receiver", data: "user=%7B%22id%22%3A1%7D&receiver=oops&auth_date=1&hash=ab"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			initData := InitData{Token: testBotToken}
+			if _, err := initData.Decode(test.data); err != model.TelegramInitDataDecodeError {
+				t.Errorf("expected decode error, got %v", err)
+			}
+		})
+	}
+}
+
+const testBotID = 7012345678
+
+// signThirdPartyInitData signs init data with the given Ed25519 key the way Telegram fills the signature field.
+func signThirdPartyInitData(t *testing.T, privateKey ed25519.PrivateKey, botID int64, fields map[string]string) string {
+	t.Helper()
+	signature := ed25519.Sign(privateKey, []byte(ThirdPartyDataCheckString(botID, fields)))
+	signedFields := make(map[string]string, len(fields)+1)
+	for key, value := range fields {
+		signedFields[key] = value
+	}
+	signedFields["signature"] = base64.RawURLEncoding.EncodeToString(signature)
+	return signInitData(t, "token unknown to the validator", signedFields)
+}
+
+func TestValidateThirdPartyTelegramInitData(t *testing.T) {
+	now := time.Unix(1732181719, 0)
+	authDate := strconv.FormatInt(now.Add(-time.Minute).Unix(), 10)
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, anotherPrivateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fields := map[string]string{
+		"user":          testUserPayload,
+		"chat_instance": "-4315698765432109876",
+		"chat_type":     "sender",
+		"auth_date":     authDate,
+	}
+	tests := []struct {
+		name      string
+		data      string
+		botID     int64
+		publicKey ed25519.PublicKey
+		maxAge    time.Duration
+		err       error
+	}{
+		{
+			name:      "valid signature",
+			data:      signThirdPartyInitData(t, privateKey, testBotID, fields),
+			botID:     testBotID,
+			publicKey: publicKey,
+		},
+		{
+			name:      "signed for another bot",
+			data:      signThirdPartyInitData(t, privateKey, testBotID+1, fields),
+			botID:     testBotID,
+			publicKey: publicKey,
+			err:       model.TelegramInitDataSignatureMismatchError,
+		},
+		{
+			name:      "signed with another key",
+			data:      signThirdPartyInitData(t, anotherPrivateKey, testBotID, fields),
+			botID:     testBotID,
+			publicKey: publicKey,
+			err:       model.TelegramInitDataSignatureMismatchError,
+		},
+		{
+			name:      "missing signature",
+			data:      signInitData(t, testBotToken, fields),
+			botID:     testBotID,
+			publicKey: publicKey,
+			err:       model.TelegramInitDataSignatureMismatchError,
+		},
+		{
+			name:      "malformed public key",
+			data:      signThirdPartyInitData(t, privateKey, testBotID, fields),
+			botID:     testBotID,
+			publicKey: publicKey[:16],
+			err:       model.InvalidPublicKeyError,
+		},
+		{
+			name:      "older than max age",
+			data:      signThirdPartyInitData(t, privateKey, testBotID, fields),
+			botID:     testBotID,
+			publicKey: publicKey,
+			maxAge:    time.Second,
+			err:       model.TelegramInitDataExpiredError,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			initData := InitData{
+				Mode:      Ed25519ValidationMode,
+				BotID:     test.botID,
+				PublicKey: test.publicKey,
+				MaxAge:    test.maxAge,
+				now:       func() time.Time { return now },
+			}
+			telegramInitData, err := initData.Decode(test.data)
+			if err != nil {
+				t.Fatal("fail to decode", err)
+			}
+			if err := initData.Validate(telegramInitData); err != test.err {
+				t.Errorf("expected %v, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestTelegramPublicKeys(t *testing.T) {
+	for _, publicKey := range []string{ProductionPublicKey, TestPublicKey} {
+		key, err := hex.DecodeString(publicKey)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			t.Errorf("malformed public key %s", publicKey)
+		}
+	}
+}
+
+func signLoginWidget(t *testing.T, token string, telegramLoginWidget model.TelegramLoginWidget) model.TelegramLoginWidget {
+	t.Helper()
+	secretKey := sha256.Sum256([]byte(token))
+	hash, err := GetSHA256Signature([]byte(DataCheckString(loginWidgetFields(&telegramLoginWidget))), secretKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	telegramLoginWidget.Hash = hex.EncodeToString(hash)
+	return telegramLoginWidget
+}
+
+func TestValidateTelegramLoginWidget(t *testing.T) {
+	now := time.Unix(1732181719, 0)
+	authDate := uint(now.Add(-time.Minute).Unix())
+	payload := model.TelegramLoginWidget{
+		ID:        355654520,
+		FirstName: "Sergey",
+		Username:  "sergey_konar",
+		PhotoURL:  "https://t.me/i/userpic/320/UKloFp3wkmk8Uz2Z74Z6lufjlDKJIjl7eHuMjaRzCpI.jpg",
+		AuthDate:  authDate,
+	}
+	tests := []struct {
+		name   string
+		token  string
+		widget model.TelegramLoginWidget
+		tamper func(*model.TelegramLoginWidget)
+		maxAge time.Duration
+		err    error
+	}{
+		{
+			name:   "valid payload",
+			token:  testBotToken,
+			widget: signLoginWidget(t, testBotToken, payload),
+		},
+		{
+			name:   "signed as mini app init data",
+			token:  testBotToken,
+			widget: payload,
+			tamper: func(widget *model.TelegramLoginWidget) {
+				secretKey, _ := GetSHA256Signature([]byte(testBotToken), []byte("WebAppData"))
+				hash, _ := GetSHA256Signature([]byte(DataCheckString(loginWidgetFields(widget))), secretKey)
+				widget.Hash = hex.EncodeToString(hash)
+			},
+			err: model.TelegramInitDataHashMismatchError,
+		},
+		{
+			name:   "tampered id",
+			token:  testBotToken,
+			widget: signLoginWidget(t, testBotToken, payload),
+			tamper: func(widget *model.TelegramLoginWidget) {
+				widget.ID++
+			},
+			err: model.TelegramInitDataHashMismatchError,
+		},
+		{
+			name:   "older than max age",
+			token:  testBotToken,
+			widget: signLoginWidget(t, testBotToken, payload),
+			maxAge: time.Second,
+			err:    model.TelegramInitDataExpiredError,
+		},
+		{
+			name:   "missing bot token",
+			widget: signLoginWidget(t, testBotToken, payload),
+			err:    model.MissingBotTokenError,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			widget := test.widget
+			if test.tamper != nil {
+				test.tamper(&widget)
+			}
+			loginWidget := LoginWidget{
+				Token:  test.token,
+				MaxAge: test.maxAge,
+				now:    func() time.Time { return now },
+			}
+			if err := loginWidget.Validate(&widget); err != test.err {
+				t.Errorf("expected %v, got %v", test.err, err)
+			}
+		})
+	}
+}
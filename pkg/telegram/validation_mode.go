@@ -0,0 +1,16 @@
+package telegram
+
+type ValidationMode string
+
+const (
+	// HMACValidationMode checks the hash field, it requires the bot token.
+	HMACValidationMode ValidationMode = "hmac"
+	// Ed25519ValidationMode checks the signature field with the Telegram public key and the bot id only.
+	Ed25519ValidationMode ValidationMode = "ed25519"
+)
+
+// Public keys Telegram signs the signature field of init data with.
+const (
+	ProductionPublicKey = "e7bf03a2fa4602af4580703d88dda5bb59f32ed8b02a56c187fe7d34caed242d"
+	TestPublicKey       = "40055058a4ee38156a06562e52eece92a771bcd8346a8c4615cb7376eddf72ec"
+)
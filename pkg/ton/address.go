@@ -0,0 +1,39 @@
+package ton
+
+import (
+	"encoding/hex"
+	"go-tonify-backend/pkg/ton/model"
+	"strconv"
+	"strings"
+)
+
+type Address struct {
+	Workchain int32
+	Hash      [32]byte
+}
+
+// ParseRawAddress parses the raw form of an address, <workchain>:<64 hex digits of the account hash>.
+func ParseRawAddress(rawAddress string) (*Address, error) {
+	workchainText, hashText, ok := strings.Cut(rawAddress, ":")
+	if !ok {
+		return nil, model.MalformedAddressError
+	}
+	workchain, err := strconv.ParseInt(workchainText, 10, 32)
+	if err != nil {
+		return nil, model.MalformedAddressError
+	}
+	hash, err := hex.DecodeString(hashText)
+	if err != nil || len(hash) != 32 {
+		return nil, model.MalformedAddressError
+	}
+	address := Address{
+		Workchain: int32(workchain),
+	}
+	copy(address.Hash[:], hash)
+	return &address, nil
+}
+
+// String returns the raw form of the address with the hash in lower case.
+func (a Address) String() string {
+	return strconv.FormatInt(int64(a.Workchain), 10) + ":" + hex.EncodeToString(a.Hash[:])
+}
@@ -0,0 +1,196 @@
+package ton
+
+import (
+	"bytes"
+	"encoding/binary"
+	"go-tonify-backend/pkg/ton/model"
+	"hash/crc32"
+	"math/bits"
+)
+
+var bocMagic = []byte{0xb5, 0xee, 0x9c, 0x72}
+
+type bocReader struct {
+	data   []byte
+	offset int
+}
+
+func (r *bocReader) read(n int) ([]byte, error) {
+	if n < 0 || r.offset+n > len(r.data) {
+		return nil, model.MalformedBOCError
+	}
+	chunk := r.data[r.offset : r.offset+n]
+	r.offset += n
+	return chunk, nil
+}
+
+func (r *bocReader) readUint(n int) (int, error) {
+	chunk, err := r.read(n)
+	if err != nil {
+		return 0, err
+	}
+	var value uint64
+	for _, b := range chunk {
+		value = value<<8 | uint64(b)
+	}
+	if value > uint64(len(r.data)) {
+		return 0, model.MalformedBOCError
+	}
+	return int(value), nil
+}
+
+type rawCell struct {
+	data      []byte
+	bitLength int
+	refs      []int
+}
+
+// ParseBOC decodes a serialized bag of cells and returns its root cells.
+func ParseBOC(data []byte) ([]*Cell, error) {
+	if len(data) < len(bocMagic)+2 || !bytes.Equal(data[:len(bocMagic)], bocMagic) {
+		return nil, model.MalformedBOCError
+	}
+	reader := bocReader{data: data, offset: len(bocMagic)}
+	flags, err := reader.read(1)
+	if err != nil {
+		return nil, err
+	}
+	hasIndex := flags[0]&0x80 != 0
+	hasCRC32C := flags[0]&0x40 != 0
+	refSize := int(flags[0] & 0x07)
+	if refSize == 0 || refSize > 4 {
+		return nil, model.MalformedBOCError
+	}
+	offsetSize, err := reader.readUint(1)
+	if err != nil {
+		return nil, err
+	}
+	if offsetSize == 0 || offsetSize > 8 {
+		return nil, model.MalformedBOCError
+	}
+	if hasCRC32C {
+		checksum := binary.LittleEndian.Uint32(data[len(data)-4:])
+		if crc32.Checksum(data[:len(data)-4], crc32.MakeTable(crc32.Castagnoli)) != checksum {
+			return nil, model.BOCChecksumMismatchError
+		}
+		reader.data = data[:len(data)-4]
+	}
+	cellsNumber, err := reader.readUint(refSize)
+	if err != nil {
+		return nil, err
+	}
+	rootsNumber, err := reader.readUint(refSize)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := reader.readUint(refSize); err != nil {
+		return nil, err
+	}
+	cellsSize, err := reader.readUint(offsetSize)
+	if err != nil {
+		return nil, err
+	}
+	if rootsNumber == 0 || rootsNumber > cellsNumber {
+		return nil, model.MalformedBOCError
+	}
+	rootIndexes := make([]int, 0, rootsNumber)
+	for i := 0; i < rootsNumber; i++ {
+		rootIndex, err := reader.readUint(refSize)
+		if err != nil {
+			return nil, err
+		}
+		if rootIndex >= cellsNumber {
+			return nil, model.MalformedBOCError
+		}
+		rootIndexes = append(rootIndexes, rootIndex)
+	}
+	if hasIndex {
+		if _, err := reader.read(cellsNumber * offsetSize); err != nil {
+			return nil, err
+		}
+	}
+	cellsData, err := reader.read(cellsSize)
+	if err != nil {
+		return nil, err
+	}
+	if reader.offset != len(reader.data) {
+		return nil, model.MalformedBOCError
+	}
+	cellsReader := bocReader{data: cellsData}
+	rawCells := make([]rawCell, 0, cellsNumber)
+	for i := 0; i < cellsNumber; i++ {
+		cell, err := readRawCell(&cellsReader, refSize, i, cellsNumber)
+		if err != nil {
+			return nil, err
+		}
+		rawCells = append(rawCells, *cell)
+	}
+	if cellsReader.offset != len(cellsData) {
+		return nil, model.MalformedBOCError
+	}
+	// references always point to cells further in the bag, so the cells are linked from the end.
+	cells := make([]*Cell, cellsNumber)
+	for i := cellsNumber - 1; i >= 0; i-- {
+		refs := make([]*Cell, 0, len(rawCells[i].refs))
+		for _, refIndex := range rawCells[i].refs {
+			refs = append(refs, cells[refIndex])
+		}
+		cells[i] = NewCell(rawCells[i].data, rawCells[i].bitLength, refs...)
+	}
+	roots := make([]*Cell, 0, rootsNumber)
+	for _, rootIndex := range rootIndexes {
+		roots = append(roots, cells[rootIndex])
+	}
+	return roots, nil
+}
+
+func readRawCell(reader *bocReader, refSize int, index int, cellsNumber int) (*rawCell, error) {
+	descriptors, err := reader.read(2)
+	if err != nil {
+		return nil, err
+	}
+	d1, d2 := descriptors[0], descriptors[1]
+	refsNumber := int(d1 & 0x07)
+	isExotic := d1&0x08 != 0
+	level := d1 >> 5
+	if isExotic || level != 0 {
+		return nil, model.UnsupportedCellError
+	}
+	if refsNumber > maxCellRefs {
+		return nil, model.MalformedBOCError
+	}
+	dataSize := (int(d2) + 1) / 2
+	cellData, err := reader.read(dataSize)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, dataSize)
+	copy(data, cellData)
+	bitLength := dataSize * 8
+	if d2%2 == 1 {
+		lastByte := data[dataSize-1]
+		if lastByte == 0 {
+			return nil, model.MalformedBOCError
+		}
+		// the lowest set bit is the completion tag, it isn't a part of the data.
+		trailingZeros := bits.TrailingZeros8(lastByte)
+		bitLength -= trailingZeros + 1
+		data[dataSize-1] = lastByte &^ (1 << trailingZeros)
+	}
+	refs := make([]int, 0, refsNumber)
+	for i := 0; i < refsNumber; i++ {
+		refIndex, err := reader.readUint(refSize)
+		if err != nil {
+			return nil, err
+		}
+		if refIndex <= index || refIndex >= cellsNumber {
+			return nil, model.MalformedBOCError
+		}
+		refs = append(refs, refIndex)
+	}
+	return &rawCell{
+		data:      data,
+		bitLength: bitLength,
+		refs:      refs,
+	}, nil
+}
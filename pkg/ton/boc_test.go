@@ -0,0 +1,137 @@
+package ton
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"go-tonify-backend/pkg/ton/model"
+	"hash/crc32"
+	"testing"
+)
+
+// emptyCellBOC is the well-known serialization of an empty cell with a crc32c checksum.
+const emptyCellBOC = "te6cckEBAQEAAgAAAEysuc0="
+
+const emptyCellHash = "96a296d224f285c67bee93c30f8a309157f0daa35dc5b87e410b78630a09cfc7"
+
+// serializeBOC writes a single root bag of cells without an index, the way wallets send a state init.
+func serializeBOC(t *testing.T, root *Cell, withCRC32C bool) []byte {
+	t.Helper()
+	var (
+		cells   []*Cell
+		visited = make(map[*Cell]bool)
+		visit   func(cell *Cell)
+	)
+	visit = func(cell *Cell) {
+		if visited[cell] {
+			return
+		}
+		visited[cell] = true
+		for _, ref := range cell.Refs() {
+			visit(ref)
+		}
+		cells = append(cells, cell)
+	}
+	visit(root)
+	for i, j := 0, len(cells)-1; i < j; i, j = i+1, j-1 {
+		cells[i], cells[j] = cells[j], cells[i]
+	}
+	indexes := make(map[*Cell]int, len(cells))
+	for index, cell := range cells {
+		indexes[cell] = index
+	}
+	var cellsData []byte
+	for _, cell := range cells {
+		cellsData = append(cellsData, cell.descriptors()...)
+		cellsData = append(cellsData, cell.paddedData()...)
+		for _, ref := range cell.Refs() {
+			cellsData = append(cellsData, byte(indexes[ref]))
+		}
+	}
+	flags := byte(0x01)
+	if withCRC32C {
+		flags |= 0x40
+	}
+	boc := append([]byte{}, bocMagic...)
+	boc = append(boc, flags, 2, byte(len(cells)), 1, 0)
+	boc = binary.BigEndian.AppendUint16(boc, uint16(len(cellsData)))
+	boc = append(boc, 0)
+	boc = append(boc, cellsData...)
+	if withCRC32C {
+		boc = binary.LittleEndian.AppendUint32(boc, crc32.Checksum(boc, crc32.MakeTable(crc32.Castagnoli)))
+	}
+	return boc
+}
+
+func TestParseBOC(t *testing.T) {
+	t.Run("empty cell", func(t *testing.T) {
+		data, err := base64.StdEncoding.DecodeString(emptyCellBOC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roots, err := ParseBOC(data)
+		if err != nil {
+			t.Fatal("fail to parse boc", err)
+		}
+		if len(roots) != 1 || roots[0].BitLength() != 0 || len(roots[0].Refs()) != 0 {
+			t.Fatal("unexpected roots", roots)
+		}
+		hash := roots[0].Hash()
+		if hex.EncodeToString(hash[:]) != emptyCellHash {
+			t.Error("unexpected hash", hex.EncodeToString(hash[:]))
+		}
+	})
+	t.Run("cells with incomplete bytes and shared references", func(t *testing.T) {
+		leaf := NewCell([]byte{0xa0}, 3)
+		middle := NewCell([]byte{0xde, 0xad, 0xbe, 0xef}, 32, leaf)
+		root := NewCell([]byte{0x30}, 5, middle, leaf)
+		for _, withCRC32C := range []bool{false, true} {
+			roots, err := ParseBOC(serializeBOC(t, root, withCRC32C))
+			if err != nil {
+				t.Fatal("fail to parse boc", err)
+			}
+			parsed := roots[0]
+			if parsed.Hash() != root.Hash() {
+				t.Error("hash mismatch after round trip")
+			}
+			if parsed.BitLength() != 5 || len(parsed.Refs()) != 2 || parsed.Refs()[1].BitLength() != 3 {
+				t.Error("unexpected cell layout")
+			}
+			bits, err := parsed.Refs()[0].Bits(4, 16)
+			if err != nil || hex.EncodeToString(bits) != "eadb" {
+				t.Error("unexpected bits", hex.EncodeToString(bits), err)
+			}
+		}
+	})
+	t.Run("checksum mismatch", func(t *testing.T) {
+		data, _ := base64.StdEncoding.DecodeString(emptyCellBOC)
+		data[len(data)-1] ^= 0xff
+		if _, err := ParseBOC(data); !errors.Is(err, model.BOCChecksumMismatchError) {
+			t.Error("expected checksum mismatch, got", err)
+		}
+	})
+	t.Run("malformed", func(t *testing.T) {
+		for _, data := range [][]byte{nil, []byte("not a boc"), bocMagic, append(append([]byte{}, bocMagic...), 0x01, 0x01, 0x01)} {
+			if _, err := ParseBOC(data); !errors.Is(err, model.MalformedBOCError) {
+				t.Error("expected malformed boc, got", err)
+			}
+		}
+	})
+}
+
+func TestParseRawAddress(t *testing.T) {
+	rawAddress := "-1:" + emptyCellHash
+	address, err := ParseRawAddress(rawAddress)
+	if err != nil {
+		t.Fatal("fail to parse address", err)
+	}
+	if address.Workchain != -1 || address.String() != rawAddress {
+		t.Error("unexpected address", address.String())
+	}
+	for _, malformed := range []string{"", "0", "0:abc", "x:" + emptyCellHash, "0:" + emptyCellHash + "00"} {
+		if _, err := ParseRawAddress(malformed); !errors.Is(err, model.MalformedAddressError) {
+			t.Error("expected malformed address", malformed, err)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package ton
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"go-tonify-backend/pkg/ton/model"
+)
+
+const maxCellRefs = 4
+
+// Cell is an ordinary TON cell: up to 1023 bits of data and up to 4 references.
+type Cell struct {
+	// data keeps the bits from the most significant bit of the first byte, the unused tail bits are zero.
+	data      []byte
+	bitLength int
+	refs      []*Cell
+}
+
+func NewCell(data []byte, bitLength int, refs ...*Cell) *Cell {
+	return &Cell{
+		data:      data,
+		bitLength: bitLength,
+		refs:      refs,
+	}
+}
+
+func (c *Cell) BitLength() int {
+	return c.bitLength
+}
+
+func (c *Cell) Refs() []*Cell {
+	return c.refs
+}
+
+// Bits reads length bits starting at offset, the result is aligned to the most significant bit.
+func (c *Cell) Bits(offset int, length int) ([]byte, error) {
+	if offset < 0 || length < 0 || offset+length > c.bitLength {
+		return nil, model.CellUnderflowError
+	}
+	result := make([]byte, (length+7)/8)
+	for i := 0; i < length; i++ {
+		position := offset + i
+		if c.data[position/8]&(0x80>>(position%8)) != 0 {
+			result[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return result, nil
+}
+
+// Bit reads the single bit at offset.
+func (c *Cell) Bit(offset int) (bool, error) {
+	bits, err := c.Bits(offset, 1)
+	if err != nil {
+		return false, err
+	}
+	return bits[0] != 0, nil
+}
+
+// Hash is the representation hash of the cell, it identifies the cell and, for a state init, the contract address.
+func (c *Cell) Hash() [32]byte {
+	representation := make([]byte, 0, 2+len(c.data)+len(c.refs)*(2+sha256.Size))
+	representation = append(representation, c.descriptors()...)
+	representation = append(representation, c.paddedData()...)
+	for _, ref := range c.refs {
+		representation = binary.BigEndian.AppendUint16(representation, ref.depth())
+	}
+	for _, ref := range c.refs {
+		refHash := ref.Hash()
+		representation = append(representation, refHash[:]...)
+	}
+	return sha256.Sum256(representation)
+}
+
+func (c *Cell) depth() uint16 {
+	var depth uint16
+	for _, ref := range c.refs {
+		if refDepth := ref.depth() + 1; refDepth > depth {
+			depth = refDepth
+		}
+	}
+	return depth
+}
+
+// descriptors returns d1 (the number of references of an ordinary level 0 cell)
+// and d2 (floor(bits/8) + ceil(bits/8)).
+func (c *Cell) descriptors() []byte {
+	d1 := byte(len(c.refs))
+	d2 := byte(c.bitLength/8 + (c.bitLength+7)/8)
+	return []byte{d1, d2}
+}
+
+// paddedData appends the completion tag, a single 1 bit, when the data doesn't fill the last byte.
+func (c *Cell) paddedData() []byte {
+	data := make([]byte, (c.bitLength+7)/8)
+	copy(data, c.data)
+	if c.bitLength%8 != 0 {
+		data[len(data)-1] |= 0x80 >> (c.bitLength % 8)
+	}
+	return data
+}
@@ -0,0 +1,19 @@
+package model
+
+import "errors"
+
+var (
+	MalformedBOCError          = errors.New("malformed bag of cells")
+	BOCChecksumMismatchError   = errors.New("bag of cells checksum mismatch")
+	UnsupportedCellError       = errors.New("exotic cells are not supported")
+	CellUnderflowError         = errors.New("cell has not enough bits or references")
+	MalformedAddressError      = errors.New("malformed raw address")
+	MalformedStateInitError    = errors.New("malformed state init")
+	MalformedProofError        = errors.New("malformed ton proof")
+	ProofDomainMismatchError   = errors.New("ton proof domain is not allowed")
+	ProofExpiredError          = errors.New("ton proof has expired")
+	ProofFromFutureError       = errors.New("ton proof is issued in the future")
+	StateInitMismatchError     = errors.New("state init does not belong to the address")
+	PublicKeyNotFoundError     = errors.New("wallet public key is not found in the state init")
+	ProofSignatureInvalidError = errors.New("ton proof signature is invalid")
+)
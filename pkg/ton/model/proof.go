@@ -0,0 +1,18 @@
+package model
+
+type ProofDomain struct {
+	LengthBytes uint32
+	Value       string
+}
+
+// Proof is the ton_proof item a wallet returns through TON Connect together with the wallet account.
+type Proof struct {
+	// Address is the raw wallet address, <workchain>:<hex hash>.
+	Address   string
+	Domain    ProofDomain
+	Timestamp uint64
+	Payload   string
+	// Signature and StateInit are base64 encoded.
+	Signature string
+	StateInit string
+}
@@ -0,0 +1,123 @@
+package ton
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"go-tonify-backend/pkg/ton/model"
+	"strings"
+	"time"
+)
+
+const (
+	proofItemPrefix = "ton-proof-item-v2/"
+	proofPrefix     = "ton-connect"
+)
+
+// allowedClockSkew tolerates a proof timestamp slightly ahead of the server clock.
+const allowedClockSkew = time.Minute
+
+// ProofVerifier checks ton_proof items of TON Connect, see
+// https://docs.ton.org/develop/dapps/ton-connect/sign for the message layout.
+type ProofVerifier struct {
+	// Domains lists the app domains a proof may be issued for.
+	Domains []string
+	// MaxAge rejects proofs whose timestamp is older, zero disables the freshness check.
+	MaxAge time.Duration
+
+	now func() time.Time
+}
+
+// Verify checks that the proof is fresh, is issued for an allowed domain, that the state init belongs to the address
+// and that the wallet key stored in the state init signed the proof. It returns the address of the wallet.
+func (v *ProofVerifier) Verify(proof model.Proof) (*Address, error) {
+	address, err := ParseRawAddress(proof.Address)
+	if err != nil {
+		return nil, err
+	}
+	if int(proof.Domain.LengthBytes) != len(proof.Domain.Value) {
+		return nil, model.MalformedProofError
+	}
+	if !v.isAllowedDomain(proof.Domain.Value) {
+		return nil, model.ProofDomainMismatchError
+	}
+	if err := v.validateTimestamp(proof.Timestamp); err != nil {
+		return nil, err
+	}
+	signature, err := base64.StdEncoding.DecodeString(proof.Signature)
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return nil, model.MalformedProofError
+	}
+	stateInitBOC, err := base64.StdEncoding.DecodeString(proof.StateInit)
+	if err != nil {
+		return nil, model.MalformedStateInitError
+	}
+	roots, err := ParseBOC(stateInitBOC)
+	if err != nil {
+		return nil, err
+	}
+	if len(roots) != 1 {
+		return nil, model.MalformedStateInitError
+	}
+	if roots[0].Hash() != address.Hash {
+		return nil, model.StateInitMismatchError
+	}
+	stateInit, err := ParseStateInit(roots[0])
+	if err != nil {
+		return nil, err
+	}
+	publicKeys := stateInit.WalletPublicKeyCandidates()
+	if len(publicKeys) == 0 {
+		return nil, model.PublicKeyNotFoundError
+	}
+	signedHash := ProofSignedHash(*address, proof.Domain.Value, proof.Timestamp, proof.Payload)
+	for _, publicKey := range publicKeys {
+		if ed25519.Verify(publicKey, signedHash, signature) {
+			return address, nil
+		}
+	}
+	return nil, model.ProofSignatureInvalidError
+}
+
+// ProofSignedHash returns sha256(0xffff ++ "ton-connect" ++ sha256(message)), the wallet signs it with its key.
+// The message is "ton-proof-item-v2/" ++ workchain (big endian) ++ address hash ++ domain length (little endian)
+// ++ domain ++ timestamp (little endian) ++ payload.
+func ProofSignedHash(address Address, domain string, timestamp uint64, payload string) []byte {
+	message := []byte(proofItemPrefix)
+	message = binary.BigEndian.AppendUint32(message, uint32(address.Workchain))
+	message = append(message, address.Hash[:]...)
+	message = binary.LittleEndian.AppendUint32(message, uint32(len(domain)))
+	message = append(message, domain...)
+	message = binary.LittleEndian.AppendUint64(message, timestamp)
+	message = append(message, payload...)
+	messageHash := sha256.Sum256(message)
+	signedMessage := append([]byte{0xff, 0xff}, proofPrefix...)
+	signedMessage = append(signedMessage, messageHash[:]...)
+	signedHash := sha256.Sum256(signedMessage)
+	return signedHash[:]
+}
+
+func (v *ProofVerifier) isAllowedDomain(domain string) bool {
+	for _, allowedDomain := range v.Domains {
+		if strings.EqualFold(domain, allowedDomain) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *ProofVerifier) validateTimestamp(timestamp uint64) error {
+	now := time.Now()
+	if v.now != nil {
+		now = v.now()
+	}
+	issuedAt := time.Unix(int64(timestamp), 0)
+	if issuedAt.After(now.Add(allowedClockSkew)) {
+		return model.ProofFromFutureError
+	}
+	if v.MaxAge > 0 && now.Sub(issuedAt) > v.MaxAge {
+		return model.ProofExpiredError
+	}
+	return nil
+}
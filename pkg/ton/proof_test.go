@@ -0,0 +1,179 @@
+package ton
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"go-tonify-backend/pkg/ton/model"
+	"testing"
+	"time"
+)
+
+const testDomain = "tonify.app"
+
+const testPayload = "5f8b1a0c9e7d4f3a2b6c8d0e1f2a3b4c"
+
+// walletStateInit builds the state init of a wallet: split_depth, special and library are absent.
+func walletStateInit(data *Cell) *Cell {
+	code := NewCell([]byte{0xff, 0x00, 0xf4, 0xa4}, 32)
+	return NewCell([]byte{0x30}, 5, code, data)
+}
+
+// walletV4Data is seqno, subwallet id, public key and an empty plugins dictionary.
+func walletV4Data(publicKey ed25519.PublicKey) *Cell {
+	data := binary.BigEndian.AppendUint32(nil, 0)
+	data = binary.BigEndian.AppendUint32(data, 698983191)
+	data = append(data, publicKey...)
+	data = append(data, 0x00)
+	return NewCell(data, 64+256+1)
+}
+
+// walletV5Data is the signature flag, seqno, wallet id, public key and an empty extensions dictionary.
+func walletV5Data(publicKey ed25519.PublicKey) *Cell {
+	bits := binary.BigEndian.AppendUint32(nil, 0)
+	bits = binary.BigEndian.AppendUint32(bits, 2147483409)
+	bits = append(bits, publicKey...)
+	data := make([]byte, len(bits)+1)
+	data[0] = 0x80
+	for i, b := range bits {
+		data[i] |= b >> 1
+		data[i+1] = b << 7
+	}
+	return NewCell(data, 1+64+256+1)
+}
+
+func signProof(t *testing.T, privateKey ed25519.PrivateKey, stateInit *Cell, timestamp time.Time) model.Proof {
+	t.Helper()
+	address := Address{Workchain: 0, Hash: stateInit.Hash()}
+	signedHash := ProofSignedHash(address, testDomain, uint64(timestamp.Unix()), testPayload)
+	return model.Proof{
+		Address: address.String(),
+		Domain: model.ProofDomain{
+			LengthBytes: uint32(len(testDomain)),
+			Value:       testDomain,
+		},
+		Timestamp: uint64(timestamp.Unix()),
+		Payload:   testPayload,
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, signedHash)),
+		StateInit: base64.StdEncoding.EncodeToString(serializeBOC(t, stateInit, true)),
+	}
+}
+
+func TestVerifyProof(t *testing.T) {
+	now := time.Unix(1732181719, 0)
+	seed := sha256.Sum256([]byte("tonify test wallet"))
+	privateKey := ed25519.NewKeyFromSeed(seed[:])
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	otherSeed := sha256.Sum256([]byte("tonify other wallet"))
+	otherPrivateKey := ed25519.NewKeyFromSeed(otherSeed[:])
+	v4StateInit := walletStateInit(walletV4Data(publicKey))
+	tests := []struct {
+		name   string
+		proof  func() model.Proof
+		maxAge time.Duration
+		err    error
+	}{
+		{
+			name:  "wallet v4",
+			proof: func() model.Proof { return signProof(t, privateKey, v4StateInit, now.Add(-time.Minute)) },
+		},
+		{
+			name: "wallet v5",
+			proof: func() model.Proof {
+				return signProof(t, privateKey, walletStateInit(walletV5Data(publicKey)), now.Add(-time.Minute))
+			},
+		},
+		{
+			name: "signed by another key",
+			proof: func() model.Proof {
+				return signProof(t, otherPrivateKey, v4StateInit, now.Add(-time.Minute))
+			},
+			err: model.ProofSignatureInvalidError,
+		},
+		{
+			name: "tampered payload",
+			proof: func() model.Proof {
+				proof := signProof(t, privateKey, v4StateInit, now.Add(-time.Minute))
+				proof.Payload = "another payload"
+				return proof
+			},
+			err: model.ProofSignatureInvalidError,
+		},
+		{
+			name: "state init of another wallet",
+			proof: func() model.Proof {
+				proof := signProof(t, privateKey, v4StateInit, now.Add(-time.Minute))
+				otherStateInit := walletStateInit(walletV4Data(otherPrivateKey.Public().(ed25519.PublicKey)))
+				proof.StateInit = base64.StdEncoding.EncodeToString(serializeBOC(t, otherStateInit, false))
+				return proof
+			},
+			err: model.StateInitMismatchError,
+		},
+		{
+			name: "domain is not allowed",
+			proof: func() model.Proof {
+				proof := signProof(t, privateKey, v4StateInit, now.Add(-time.Minute))
+				proof.Domain = model.ProofDomain{LengthBytes: 8, Value: "evil.app"}
+				return proof
+			},
+			err: model.ProofDomainMismatchError,
+		},
+		{
+			name: "domain length mismatch",
+			proof: func() model.Proof {
+				proof := signProof(t, privateKey, v4StateInit, now.Add(-time.Minute))
+				proof.Domain.LengthBytes++
+				return proof
+			},
+			err: model.MalformedProofError,
+		},
+		{
+			name:   "expired",
+			proof:  func() model.Proof { return signProof(t, privateKey, v4StateInit, now.Add(-time.Hour)) },
+			maxAge: 15 * time.Minute,
+			err:    model.ProofExpiredError,
+		},
+		{
+			name:  "issued in the future",
+			proof: func() model.Proof { return signProof(t, privateKey, v4StateInit, now.Add(time.Hour)) },
+			err:   model.ProofFromFutureError,
+		},
+		{
+			name: "malformed signature",
+			proof: func() model.Proof {
+				proof := signProof(t, privateKey, v4StateInit, now.Add(-time.Minute))
+				proof.Signature = "c2lnbmF0dXJl"
+				return proof
+			},
+			err: model.MalformedProofError,
+		},
+		{
+			name: "malformed address",
+			proof: func() model.Proof {
+				proof := signProof(t, privateKey, v4StateInit, now.Add(-time.Minute))
+				proof.Address = "EQBvW8Z5huBkMJYdnfAEM5JqTNkuWX3diqYENkWsIL0XggGG"
+				return proof
+			},
+			err: model.MalformedAddressError,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verifier := ProofVerifier{
+				Domains: []string{"localhost", testDomain},
+				MaxAge:  test.maxAge,
+				now:     func() time.Time { return now },
+			}
+			proof := test.proof()
+			address, err := verifier.Verify(proof)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expected error %v, got %v", test.err, err)
+			}
+			if test.err == nil && address.String() != proof.Address {
+				t.Error("unexpected address", address.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package ton
+
+import (
+	"crypto/ed25519"
+	"go-tonify-backend/pkg/ton/model"
+)
+
+// walletPublicKeyOffsets are bit offsets of the public key in the data of the standard wallets:
+// seqno for v1 and v2, seqno and subwallet id for v3 and v4, signature flag, seqno and wallet id for v5.
+var walletPublicKeyOffsets = []int{32, 64, 65}
+
+type StateInit struct {
+	Code *Cell
+	Data *Cell
+}
+
+// ParseStateInit reads the StateInit of a contract:
+// split_depth:(Maybe (## 5)) special:(Maybe TickTock) code:(Maybe ^Cell) data:(Maybe ^Cell) library:(Maybe ^Cell).
+func ParseStateInit(cell *Cell) (*StateInit, error) {
+	offset, refIndex := 0, 0
+	readMaybe := func(fieldLength int) (bool, error) {
+		present, err := cell.Bit(offset)
+		if err != nil {
+			return false, model.MalformedStateInitError
+		}
+		offset++
+		if present {
+			offset += fieldLength
+		}
+		return present, nil
+	}
+	readMaybeRef := func() (*Cell, error) {
+		present, err := readMaybe(0)
+		if err != nil || !present {
+			return nil, err
+		}
+		if refIndex >= len(cell.Refs()) {
+			return nil, model.MalformedStateInitError
+		}
+		ref := cell.Refs()[refIndex]
+		refIndex++
+		return ref, nil
+	}
+	if _, err := readMaybe(5); err != nil {
+		return nil, err
+	}
+	if _, err := readMaybe(2); err != nil {
+		return nil, err
+	}
+	code, err := readMaybeRef()
+	if err != nil {
+		return nil, err
+	}
+	data, err := readMaybeRef()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := readMaybeRef(); err != nil {
+		return nil, err
+	}
+	if offset != cell.BitLength() || refIndex != len(cell.Refs()) {
+		return nil, model.MalformedStateInitError
+	}
+	return &StateInit{
+		Code: code,
+		Data: data,
+	}, nil
+}
+
+// WalletPublicKeyCandidates returns the 256 bit values found at the public key offsets of the standard wallets,
+// the one that verifies a signature is the wallet public key.
+func (s *StateInit) WalletPublicKeyCandidates() []ed25519.PublicKey {
+	candidates := make([]ed25519.PublicKey, 0, len(walletPublicKeyOffsets))
+	if s.Data == nil {
+		return candidates
+	}
+	for _, offset := range walletPublicKeyOffsets {
+		publicKey, err := s.Data.Bits(offset, ed25519.PublicKeySize*8)
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, publicKey)
+	}
+	return candidates
+}